@@ -5,20 +5,29 @@ import (
 	empty "google.golang.org/protobuf/types/known/emptypb"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"github.com/squzy/squzy/internal/helpers"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
 	"time"
 )
 
 type Handlers interface {
 	GetAgentList(ctx context.Context) ([]*apiPb.AgentItem, error)
 	GetAgentByID(ctx context.Context, id string) (*apiPb.AgentItem, error)
-	GetSchedulerList(ctx context.Context) ([]*apiPb.Scheduler, error)
+	GetSchedulerList(ctx context.Context, selector string) ([]*apiPb.Scheduler, error)
 	GetSchedulerByID(ctx context.Context, id string) (*apiPb.Scheduler, error)
 	GetSchedulerHistoryByID(ctx context.Context, rq *apiPb.GetSchedulerInformationRequest) (*apiPb.GetSchedulerInformationResponse, error)
 	GetAgentHistoryByID(ctx context.Context, rq *apiPb.GetAgentInformationRequest) (*apiPb.GetAgentInformationResponse, error)
 	RunScheduler(ctx context.Context, id string) error
 	StopScheduler(ctx context.Context, id string) error
 	RemoveScheduler(ctx context.Context, id string) error
+	BulkSchedulerAction(ctx context.Context, selector string, action apiPb.BulkActionRequest_Action) (*apiPb.BulkActionResponse, error)
 	AddScheduler(ctx context.Context, scheduler *apiPb.AddRequest) (*apiPb.AddResponse, error)
+	TestScheduler(ctx context.Context, scheduler *apiPb.AddRequest) (*apiPb.TestSchedulerResponse, error)
+	UpdateScheduler(ctx context.Context, id string, scheduler *apiPb.AddRequest) error
+	ExportSchedulers(ctx context.Context, format apiPb.DocumentFormat) ([]byte, error)
+	ApplySchedulers(ctx context.Context, rq *apiPb.ApplySchedulersRequest) ([]*apiPb.SchedulerChange, error)
+	GetMaintenanceWindowList(ctx context.Context) ([]*apiPb.MaintenanceWindow, error)
+	AddMaintenanceWindow(ctx context.Context, window *apiPb.MaintenanceWindow) (*apiPb.AddMaintenanceWindowResponse, error)
+	RemoveMaintenanceWindow(ctx context.Context, id string) error
 	RegisterApplication(ctx context.Context, rq *apiPb.ApplicationInfo) (*apiPb.InitializeApplicationResponse, error)
 	SaveTransaction(ctx context.Context, rq *apiPb.TransactionInfo) (*empty.Empty, error)
 	GetSchedulerUptime(ctx context.Context, rq *apiPb.GetSchedulerUptimeRequest) (*apiPb.GetSchedulerUptimeResponse, error)
@@ -245,6 +254,54 @@ func (h *handlers) AddScheduler(ctx context.Context, scheduler *apiPb.AddRequest
 	return h.monitoringClient.Add(c, scheduler)
 }
 
+func (h *handlers) TestScheduler(ctx context.Context, scheduler *apiPb.AddRequest) (*apiPb.TestSchedulerResponse, error) {
+	c, cancel := helpers.TimeoutContext(ctx, testSchedulerTimeout(scheduler))
+	defer cancel()
+	return h.monitoringClient.TestScheduler(c, scheduler)
+}
+
+// Check with retries could take much longer than usual request
+func testSchedulerTimeout(scheduler *apiPb.AddRequest) time.Duration {
+	policy := &scheduler_config_storage.RetryPolicy{
+		Attempts:          scheduler.GetRetryPolicy().GetAttempts(),
+		Backoff:           scheduler.GetRetryPolicy().GetBackoff(),
+		BackoffMultiplier: scheduler.GetRetryPolicy().GetBackoffMultiplier(),
+	}
+	return policy.MaxDuration(helpers.DurationNotNegative(scheduler.GetTimeout())) + defaultRequestTimeout
+}
+
+func (h *handlers) UpdateScheduler(ctx context.Context, id string, scheduler *apiPb.AddRequest) error {
+	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
+	defer cancel()
+	_, err := h.monitoringClient.Update(c, &apiPb.UpdateRequest{
+		Id:        id,
+		Scheduler: scheduler,
+	})
+	return err
+}
+
+func (h *handlers) ExportSchedulers(ctx context.Context, format apiPb.DocumentFormat) ([]byte, error) {
+	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
+	defer cancel()
+	res, err := h.monitoringClient.ExportSchedulers(c, &apiPb.ExportSchedulersRequest{
+		Format: format,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Document, nil
+}
+
+func (h *handlers) ApplySchedulers(ctx context.Context, rq *apiPb.ApplySchedulersRequest) ([]*apiPb.SchedulerChange, error) {
+	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
+	defer cancel()
+	res, err := h.monitoringClient.ApplySchedulers(c, rq)
+	if err != nil {
+		return nil, err
+	}
+	return res.Changes, nil
+}
+
 func (h *handlers) StopScheduler(ctx context.Context, id string) error {
 	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
 	defer cancel()
@@ -263,16 +320,54 @@ func (h *handlers) RemoveScheduler(ctx context.Context, id string) error {
 	return err
 }
 
-func (h *handlers) GetSchedulerList(ctx context.Context) ([]*apiPb.Scheduler, error) {
+func (h *handlers) GetSchedulerList(ctx context.Context, selector string) ([]*apiPb.Scheduler, error) {
 	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
 	defer cancel()
-	list, err := h.monitoringClient.GetSchedulerList(c, &empty.Empty{})
+	list, err := h.monitoringClient.GetSchedulerList(c, &apiPb.GetSchedulerListRequest{
+		Selector: selector,
+	})
 	if err != nil {
 		return nil, err
 	}
 	return list.Lists, nil
 }
 
+func (h *handlers) BulkSchedulerAction(ctx context.Context, selector string, action apiPb.BulkActionRequest_Action) (*apiPb.BulkActionResponse, error) {
+	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
+	defer cancel()
+	return h.monitoringClient.BulkAction(c, &apiPb.BulkActionRequest{
+		Selector: selector,
+		Action:   action,
+	})
+}
+
+func (h *handlers) GetMaintenanceWindowList(ctx context.Context) ([]*apiPb.MaintenanceWindow, error) {
+	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
+	defer cancel()
+	res, err := h.monitoringClient.GetMaintenanceWindowList(c, &apiPb.GetMaintenanceWindowListRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return res.Windows, nil
+}
+
+func (h *handlers) AddMaintenanceWindow(ctx context.Context, window *apiPb.MaintenanceWindow) (*apiPb.AddMaintenanceWindowResponse, error) {
+	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
+	defer cancel()
+	return h.monitoringClient.AddMaintenanceWindow(c, &apiPb.AddMaintenanceWindowRequest{
+		Window: window,
+	})
+}
+
+func (h *handlers) RemoveMaintenanceWindow(ctx context.Context, id string) error {
+	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
+	defer cancel()
+	_, err := h.monitoringClient.RemoveMaintenanceWindow(c, &apiPb.RemoveMaintenanceWindowRequest{
+		Id: id,
+	})
+	return err
+}
+
 func (h *handlers) GetSchedulerByID(ctx context.Context, id string) (*apiPb.Scheduler, error) {
 	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
 	defer cancel()
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
 	"testing"
+	"time"
 )
 
 type mockNoticationError struct {
@@ -427,7 +428,7 @@ func (a agentMockError) SendMetrics(ctx context.Context, opts ...grpc.CallOption
 type mockMonitoringError struct {
 }
 
-func (m mockMonitoringError) GetSchedulerList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*apiPb.GetSchedulerListResponse, error) {
+func (m mockMonitoringError) GetSchedulerList(ctx context.Context, in *apiPb.GetSchedulerListRequest, opts ...grpc.CallOption) (*apiPb.GetSchedulerListResponse, error) {
 	return nil, errors.New("")
 }
 
@@ -451,10 +452,50 @@ func (m mockMonitoringError) Stop(ctx context.Context, in *apiPb.StopRequest, op
 	return nil, errors.New("")
 }
 
+func (m mockMonitoringError) Update(ctx context.Context, in *apiPb.UpdateRequest, opts ...grpc.CallOption) (*apiPb.UpdateResponse, error) {
+	return nil, errors.New("")
+}
+
+func (m mockMonitoringError) ExportSchedulers(ctx context.Context, in *apiPb.ExportSchedulersRequest, opts ...grpc.CallOption) (*apiPb.ExportSchedulersResponse, error) {
+	return nil, errors.New("")
+}
+
+func (m mockMonitoringError) ApplySchedulers(ctx context.Context, in *apiPb.ApplySchedulersRequest, opts ...grpc.CallOption) (*apiPb.ApplySchedulersResponse, error) {
+	return nil, errors.New("")
+}
+
+func (m mockMonitoringError) BulkAction(ctx context.Context, in *apiPb.BulkActionRequest, opts ...grpc.CallOption) (*apiPb.BulkActionResponse, error) {
+	return nil, errors.New("")
+}
+
+func (m mockMonitoringError) AddMaintenanceWindow(ctx context.Context, in *apiPb.AddMaintenanceWindowRequest, opts ...grpc.CallOption) (*apiPb.AddMaintenanceWindowResponse, error) {
+	return nil, errors.New("")
+}
+
+func (m mockMonitoringError) RemoveMaintenanceWindow(ctx context.Context, in *apiPb.RemoveMaintenanceWindowRequest, opts ...grpc.CallOption) (*apiPb.RemoveMaintenanceWindowResponse, error) {
+	return nil, errors.New("")
+}
+
+func (m mockMonitoringError) GetMaintenanceWindowList(ctx context.Context, in *apiPb.GetMaintenanceWindowListRequest, opts ...grpc.CallOption) (*apiPb.GetMaintenanceWindowListResponse, error) {
+	return nil, errors.New("")
+}
+
+func (m mockMonitoringError) TestScheduler(ctx context.Context, in *apiPb.AddRequest, opts ...grpc.CallOption) (*apiPb.TestSchedulerResponse, error) {
+	return nil, errors.New("")
+}
+
+func (m mockMonitoringError) ProbeTasks(ctx context.Context, in *apiPb.ProbeTasksRequest, opts ...grpc.CallOption) (apiPb.SchedulersExecutor_ProbeTasksClient, error) {
+	panic("implement me")
+}
+
+func (m mockMonitoringError) ProbeResult(ctx context.Context, in *apiPb.ProbeResultRequest, opts ...grpc.CallOption) (*apiPb.ProbeResultResponse, error) {
+	panic("implement me")
+}
+
 type mockMonitoringOk struct {
 }
 
-func (m mockMonitoringOk) GetSchedulerList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*apiPb.GetSchedulerListResponse, error) {
+func (m mockMonitoringOk) GetSchedulerList(ctx context.Context, in *apiPb.GetSchedulerListRequest, opts ...grpc.CallOption) (*apiPb.GetSchedulerListResponse, error) {
 	return &apiPb.GetSchedulerListResponse{}, nil
 }
 
@@ -478,6 +519,48 @@ func (m mockMonitoringOk) Stop(ctx context.Context, in *apiPb.StopRequest, opts
 	return &apiPb.StopResponse{}, nil
 }
 
+func (m mockMonitoringOk) Update(ctx context.Context, in *apiPb.UpdateRequest, opts ...grpc.CallOption) (*apiPb.UpdateResponse, error) {
+	return &apiPb.UpdateResponse{}, nil
+}
+
+func (m mockMonitoringOk) ExportSchedulers(ctx context.Context, in *apiPb.ExportSchedulersRequest, opts ...grpc.CallOption) (*apiPb.ExportSchedulersResponse, error) {
+	return &apiPb.ExportSchedulersResponse{Document: []byte("checks: []")}, nil
+}
+
+func (m mockMonitoringOk) ApplySchedulers(ctx context.Context, in *apiPb.ApplySchedulersRequest, opts ...grpc.CallOption) (*apiPb.ApplySchedulersResponse, error) {
+	return &apiPb.ApplySchedulersResponse{}, nil
+}
+
+func (m mockMonitoringOk) BulkAction(ctx context.Context, in *apiPb.BulkActionRequest, opts ...grpc.CallOption) (*apiPb.BulkActionResponse, error) {
+	return &apiPb.BulkActionResponse{}, nil
+}
+
+func (m mockMonitoringOk) AddMaintenanceWindow(ctx context.Context, in *apiPb.AddMaintenanceWindowRequest, opts ...grpc.CallOption) (*apiPb.AddMaintenanceWindowResponse, error) {
+	return &apiPb.AddMaintenanceWindowResponse{}, nil
+}
+
+func (m mockMonitoringOk) RemoveMaintenanceWindow(ctx context.Context, in *apiPb.RemoveMaintenanceWindowRequest, opts ...grpc.CallOption) (*apiPb.RemoveMaintenanceWindowResponse, error) {
+	return &apiPb.RemoveMaintenanceWindowResponse{}, nil
+}
+
+func (m mockMonitoringOk) GetMaintenanceWindowList(ctx context.Context, in *apiPb.GetMaintenanceWindowListRequest, opts ...grpc.CallOption) (*apiPb.GetMaintenanceWindowListResponse, error) {
+	return &apiPb.GetMaintenanceWindowListResponse{
+		Windows: []*apiPb.MaintenanceWindow{{}},
+	}, nil
+}
+
+func (m mockMonitoringOk) TestScheduler(ctx context.Context, in *apiPb.AddRequest, opts ...grpc.CallOption) (*apiPb.TestSchedulerResponse, error) {
+	return &apiPb.TestSchedulerResponse{}, nil
+}
+
+func (m mockMonitoringOk) ProbeTasks(ctx context.Context, in *apiPb.ProbeTasksRequest, opts ...grpc.CallOption) (apiPb.SchedulersExecutor_ProbeTasksClient, error) {
+	panic("implement me")
+}
+
+func (m mockMonitoringOk) ProbeResult(ctx context.Context, in *apiPb.ProbeResultRequest, opts ...grpc.CallOption) (*apiPb.ProbeResultResponse, error) {
+	panic("implement me")
+}
+
 func TestNew(t *testing.T) {
 	t.Run("Should: not return error", func(t *testing.T) {
 		s := New(nil, nil, nil, nil, nil, nil)
@@ -498,6 +581,30 @@ func TestHandlers_AddScheduler(t *testing.T) {
 	})
 }
 
+func TestHandlers_TestScheduler(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringOk{}, nil, nil, nil, nil)
+		_, err := s.TestScheduler(context.Background(), &apiPb.AddRequest{})
+		assert.Nil(t, err)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringError{}, nil, nil, nil, nil)
+		_, err := s.TestScheduler(context.Background(), &apiPb.AddRequest{})
+		assert.NotNil(t, err)
+	})
+	t.Run("Should: wait for every attempt of check", func(t *testing.T) {
+		timeout := testSchedulerTimeout(&apiPb.AddRequest{
+			Timeout: 20,
+			RetryPolicy: &apiPb.RetryPolicy{
+				Attempts:          3,
+				Backoff:           1000,
+				BackoffMultiplier: 2,
+			},
+		})
+		assert.Equal(t, time.Second*(20*3+1+2)+defaultRequestTimeout, timeout)
+	})
+}
+
 func TestHandlers_GetAgentByID(t *testing.T) {
 	t.Run("Should: not return error", func(t *testing.T) {
 		s := New(&agentMockOk{}, nil, nil, nil, nil, nil)
@@ -566,12 +673,12 @@ func TestHandlers_GetSchedulerByID(t *testing.T) {
 func TestHandlers_GetSchedulerList(t *testing.T) {
 	t.Run("Should: not return error", func(t *testing.T) {
 		s := New(nil, &mockMonitoringOk{}, nil, nil, nil, nil)
-		_, err := s.GetSchedulerList(context.Background())
+		_, err := s.GetSchedulerList(context.Background(), "team=payments")
 		assert.Nil(t, err)
 	})
 	t.Run("Should: return error", func(t *testing.T) {
 		s := New(nil, &mockMonitoringError{}, nil, nil, nil, nil)
-		_, err := s.GetSchedulerList(context.Background())
+		_, err := s.GetSchedulerList(context.Background(), "team=payments")
 		assert.NotNil(t, err)
 	})
 }
@@ -615,6 +722,97 @@ func TestHandlers_StopScheduler(t *testing.T) {
 	})
 }
 
+func TestHandlers_UpdateScheduler(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringOk{}, nil, nil, nil, nil)
+		err := s.UpdateScheduler(context.Background(), "nil", &apiPb.AddRequest{})
+		assert.Nil(t, err)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringError{}, nil, nil, nil, nil)
+		err := s.UpdateScheduler(context.Background(), "nil", &apiPb.AddRequest{})
+		assert.NotNil(t, err)
+	})
+}
+
+func TestHandlers_BulkSchedulerAction(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringOk{}, nil, nil, nil, nil)
+		_, err := s.BulkSchedulerAction(context.Background(), "team=payments", apiPb.BulkActionRequest_RUN)
+		assert.Nil(t, err)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringError{}, nil, nil, nil, nil)
+		_, err := s.BulkSchedulerAction(context.Background(), "team=payments", apiPb.BulkActionRequest_RUN)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestHandlers_GetMaintenanceWindowList(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringOk{}, nil, nil, nil, nil)
+		list, err := s.GetMaintenanceWindowList(context.Background())
+		assert.Nil(t, err)
+		assert.Len(t, list, 1)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringError{}, nil, nil, nil, nil)
+		_, err := s.GetMaintenanceWindowList(context.Background())
+		assert.NotNil(t, err)
+	})
+}
+
+func TestHandlers_AddMaintenanceWindow(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringOk{}, nil, nil, nil, nil)
+		_, err := s.AddMaintenanceWindow(context.Background(), &apiPb.MaintenanceWindow{})
+		assert.Nil(t, err)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringError{}, nil, nil, nil, nil)
+		_, err := s.AddMaintenanceWindow(context.Background(), &apiPb.MaintenanceWindow{})
+		assert.NotNil(t, err)
+	})
+}
+
+func TestHandlers_RemoveMaintenanceWindow(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringOk{}, nil, nil, nil, nil)
+		assert.Nil(t, s.RemoveMaintenanceWindow(context.Background(), "id"))
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringError{}, nil, nil, nil, nil)
+		assert.NotNil(t, s.RemoveMaintenanceWindow(context.Background(), "id"))
+	})
+}
+
+func TestHandlers_ExportSchedulers(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringOk{}, nil, nil, nil, nil)
+		doc, err := s.ExportSchedulers(context.Background(), apiPb.DocumentFormat_DOCUMENT_FORMAT_YAML)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("checks: []"), doc)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringError{}, nil, nil, nil, nil)
+		_, err := s.ExportSchedulers(context.Background(), apiPb.DocumentFormat_DOCUMENT_FORMAT_YAML)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestHandlers_ApplySchedulers(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringOk{}, nil, nil, nil, nil)
+		_, err := s.ApplySchedulers(context.Background(), &apiPb.ApplySchedulersRequest{})
+		assert.Nil(t, err)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringError{}, nil, nil, nil, nil)
+		_, err := s.ApplySchedulers(context.Background(), &apiPb.ApplySchedulersRequest{})
+		assert.NotNil(t, err)
+	})
+}
+
 func TestHandlers_GetApplicationById(t *testing.T) {
 	t.Run("Should: not return error", func(t *testing.T) {
 		s := New(nil, nil, nil, &mockAmOk{}, nil, nil)
@@ -10,6 +10,7 @@ import (
 	wrappers "google.golang.org/protobuf/types/known/wrapperspb"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -132,16 +133,62 @@ type ListRulesByOwnerIdRequest struct {
 }
 
 type Scheduler struct {
+	Interval int32  `json:"interval" binding:"required_without=Cron"`
+	Cron     string `json:"cron"`
+	Check
+}
+
+// Check is not saved, so interval and cron are not needed
+type TestScheduler struct {
+	Check
+}
+
+// Everything of scheduler except its schedule
+type Check struct {
 	Type                apiPb.SchedulerType        `json:"type"`
-	Interval            int32                      `json:"interval" binding:"required"`
 	Timeout             int32                      `json:"timeout"`
 	Name                string                     `json:"name"`
+	Labels              map[string]string          `json:"labels,omitempty"`
 	HTTPConfig          *apiPb.HttpConfig          `json:"httpConfig,omitempty"`
 	TCPConfig           *apiPb.TcpConfig           `json:"tcpConfig,omitempty"`
 	HTTPValueConfig     *apiPb.HttpJsonValueConfig `json:"httpValueConfig,omitempty"`
 	GRPCConfig          *apiPb.GrpcConfig          `json:"grpcConfig,omitempty"`
 	SiteMapConfig       *apiPb.SiteMapConfig       `json:"siteMapConfig,omitempty"`
 	SSLExpirationConfig *apiPb.SslExpirationConfig `json:"sslExpirationConfig,omitempty"`
+	DNSConfig           *apiPb.DnsConfig           `json:"dnsConfig,omitempty"`
+	UDPConfig           *apiPb.TcpConfig           `json:"udpConfig,omitempty"`
+	RedisConfig         *apiPb.RedisConfig         `json:"redisConfig,omitempty"`
+	HTTPScenarioConfig  *apiPb.HttpScenarioConfig  `json:"httpScenarioConfig,omitempty"`
+	RetryPolicy         *apiPb.RetryPolicy         `json:"retryPolicy,omitempty"`
+	ParentIDs           []string                   `json:"parentIds,omitempty"`
+	Locations           []string                   `json:"locations,omitempty"`
+	MinFailedLocations  int32                      `json:"minFailedLocations,omitempty"`
+	FailureInterval     int32                      `json:"failureInterval,omitempty"`
+	RecoverAfter        int32                      `json:"recoverAfter,omitempty"`
+	FlapDetection       *apiPb.FlapDetection       `json:"flapDetection,omitempty"`
+}
+
+type SchedulersSelector struct {
+	// Label selector like "team=payments,env!=prod,critical,!deprecated"
+	Selector string `form:"selector"`
+}
+
+// One-off window needs start and end, recurring one needs cron and duration in seconds
+type MaintenanceWindow struct {
+	Name         string                `json:"name"`
+	SchedulerIds []string              `json:"schedulerIds"`
+	Selector     string                `json:"selector"`
+	Start        *time.Time            `json:"start"`
+	End          *time.Time            `json:"end"`
+	Cron         string                `json:"cron"`
+	Duration     int32                 `json:"duration"`
+	Mode         apiPb.MaintenanceMode `json:"mode"`
+}
+
+type SchedulersDocument struct {
+	// json or yaml, yaml by default
+	Format string `form:"format"`
+	DryRun bool   `form:"dryRun"`
 }
 
 type Application struct {
@@ -787,16 +834,66 @@ func (r *router) GetEngine() *gin.Engine {
 			}
 		}
 
+		maintenance := v1.Group("maintenance")
+		{
+			maintenance.GET("", func(context *gin.Context) {
+				list, err := r.handlers.GetMaintenanceWindowList(context)
+				if err != nil {
+					errWrap(context, http.StatusInternalServerError, err)
+					return
+				}
+				successWrap(context, http.StatusOK, list)
+			})
+			maintenance.POST("", func(context *gin.Context) {
+				request := new(MaintenanceWindow)
+				err := context.ShouldBindJSON(request)
+				if err != nil {
+					errWrap(context, http.StatusUnprocessableEntity, err)
+					return
+				}
+				res, err := r.handlers.AddMaintenanceWindow(context, maintenanceWindowToProto(request))
+				if err != nil {
+					errWrap(context, http.StatusUnprocessableEntity, err)
+					return
+				}
+				successWrap(context, http.StatusCreated, res)
+			})
+			maintenance.DELETE(":windowId", func(context *gin.Context) {
+				err := r.handlers.RemoveMaintenanceWindow(context, context.Param("windowId"))
+				if err != nil {
+					errWrap(context, http.StatusNotFound, err)
+					return
+				}
+				successWrap(context, http.StatusAccepted, nil)
+			})
+		}
+
 		schedulers := v1.Group("schedulers")
 		{
 			schedulers.GET("", func(context *gin.Context) {
-				list, err := r.handlers.GetSchedulerList(context)
+				rq := &SchedulersSelector{}
+				err := context.ShouldBindQuery(rq)
+				if err != nil {
+					errWrap(context, http.StatusUnprocessableEntity, err)
+					return
+				}
+				list, err := r.handlers.GetSchedulerList(context, rq.Selector)
 				if err != nil {
 					errWrap(context, http.StatusInternalServerError, err)
 					return
 				}
 				successWrap(context, http.StatusOK, list)
 			})
+			// Bulk actions by label selector
+			schedulers.PUT("run", func(context *gin.Context) {
+				r.bulkSchedulerAction(context, apiPb.BulkActionRequest_RUN)
+			})
+			schedulers.PUT("stop", func(context *gin.Context) {
+				r.bulkSchedulerAction(context, apiPb.BulkActionRequest_STOP)
+			})
+			schedulers.DELETE("", func(context *gin.Context) {
+				r.bulkSchedulerAction(context, apiPb.BulkActionRequest_REMOVE)
+			})
 			schedulers.POST("", func(context *gin.Context) {
 				request := new(Scheduler)
 				err := context.ShouldBindJSON(request)
@@ -804,81 +901,11 @@ func (r *router) GetEngine() *gin.Engine {
 					errWrap(context, http.StatusUnprocessableEntity, err)
 					return
 				}
-				var addReq *apiPb.AddRequest
-
-				switch request.Type {
-				case apiPb.SchedulerType_TCP:
-					if request.TCPConfig == nil {
-						errWrap(context, http.StatusUnprocessableEntity, errMissingConfig)
-						return
-					}
-					addReq = &apiPb.AddRequest{
-						Config: &apiPb.AddRequest_Tcp{
-							Tcp: request.TCPConfig,
-						},
-					}
-				case apiPb.SchedulerType_SSL_EXPIRATION:
-					if request.SSLExpirationConfig == nil {
-						errWrap(context, http.StatusUnprocessableEntity, errMissingConfig)
-						return
-					}
-					addReq = &apiPb.AddRequest{
-						Config: &apiPb.AddRequest_SslExpiration{
-							SslExpiration: request.SSLExpirationConfig,
-						},
-					}
-				case apiPb.SchedulerType_GRPC:
-					if request.GRPCConfig == nil {
-						errWrap(context, http.StatusUnprocessableEntity, errMissingConfig)
-						return
-					}
-					addReq = &apiPb.AddRequest{
-						Config: &apiPb.AddRequest_Grpc{
-							Grpc: request.GRPCConfig,
-						},
-					}
-
-				case apiPb.SchedulerType_HTTP:
-					if request.HTTPConfig == nil {
-						errWrap(context, http.StatusUnprocessableEntity, errMissingConfig)
-						return
-					}
-					addReq = &apiPb.AddRequest{
-						Config: &apiPb.AddRequest_Http{
-							Http: request.HTTPConfig,
-						},
-					}
-
-				case apiPb.SchedulerType_SITE_MAP:
-					if request.SiteMapConfig == nil {
-						errWrap(context, http.StatusUnprocessableEntity, errMissingConfig)
-						return
-					}
-					addReq = &apiPb.AddRequest{
-						Config: &apiPb.AddRequest_Sitemap{
-							Sitemap: request.SiteMapConfig,
-						},
-					}
-
-				case apiPb.SchedulerType_HTTP_JSON_VALUE:
-					if request.HTTPValueConfig == nil {
-						errWrap(context, http.StatusUnprocessableEntity, errMissingConfig)
-						return
-					}
-					addReq = &apiPb.AddRequest{
-						Config: &apiPb.AddRequest_HttpValue{
-							HttpValue: request.HTTPValueConfig,
-						},
-					}
-
-				default:
-					errWrap(context, http.StatusUnprocessableEntity, errNotFoundConfigType)
+				addReq, err := schedulerToAddRequest(request)
+				if err != nil {
+					errWrap(context, http.StatusUnprocessableEntity, err)
 					return
 				}
-
-				addReq.Interval = request.Interval
-				addReq.Timeout = request.Timeout
-				addReq.Name = request.Name
 				res, err := r.handlers.AddScheduler(context, addReq)
 				if err != nil {
 					errWrap(context, http.StatusUnprocessableEntity, err)
@@ -886,6 +913,68 @@ func (r *router) GetEngine() *gin.Engine {
 				}
 				successWrap(context, http.StatusCreated, res)
 			})
+			// Run check once, nothing is saved
+			schedulers.POST("test", func(context *gin.Context) {
+				request := new(TestScheduler)
+				err := context.ShouldBindJSON(request)
+				if err != nil {
+					errWrap(context, http.StatusUnprocessableEntity, err)
+					return
+				}
+				addReq, err := checkToAddRequest(&request.Check)
+				if err != nil {
+					errWrap(context, http.StatusUnprocessableEntity, err)
+					return
+				}
+				res, err := r.handlers.TestScheduler(context, addReq)
+				if err != nil {
+					errWrap(context, http.StatusUnprocessableEntity, err)
+					return
+				}
+				successWrap(context, http.StatusOK, res)
+			})
+			schedulers.GET("export", func(context *gin.Context) {
+				rq := &SchedulersDocument{}
+				err := context.ShouldBindQuery(rq)
+				if err != nil {
+					errWrap(context, http.StatusUnprocessableEntity, err)
+					return
+				}
+				format := documentFormat(rq.Format)
+				doc, err := r.handlers.ExportSchedulers(context, format)
+				if err != nil {
+					errWrap(context, http.StatusInternalServerError, err)
+					return
+				}
+				contentType := "application/x-yaml"
+				if format == apiPb.DocumentFormat_DOCUMENT_FORMAT_JSON {
+					contentType = "application/json"
+				}
+				context.Data(http.StatusOK, contentType, doc)
+			})
+			schedulers.POST("apply", func(context *gin.Context) {
+				rq := &SchedulersDocument{}
+				err := context.ShouldBindQuery(rq)
+				if err != nil {
+					errWrap(context, http.StatusUnprocessableEntity, err)
+					return
+				}
+				doc, err := context.GetRawData()
+				if err != nil {
+					errWrap(context, http.StatusUnprocessableEntity, err)
+					return
+				}
+				changes, err := r.handlers.ApplySchedulers(context, &apiPb.ApplySchedulersRequest{
+					Format:   documentFormat(rq.Format),
+					Document: doc,
+					DryRun:   rq.DryRun,
+				})
+				if err != nil {
+					errWrap(context, http.StatusUnprocessableEntity, err)
+					return
+				}
+				successWrap(context, http.StatusOK, changes)
+			})
 			scheduler := schedulers.Group(":schedulerId")
 			{
 				// Get by ID
@@ -898,6 +987,27 @@ func (r *router) GetEngine() *gin.Engine {
 					}
 					successWrap(context, http.StatusOK, scheduler)
 				})
+				// Update by ID
+				scheduler.PUT("", func(context *gin.Context) {
+					schedulerID := context.Param("schedulerId")
+					request := new(Scheduler)
+					err := context.ShouldBindJSON(request)
+					if err != nil {
+						errWrap(context, http.StatusUnprocessableEntity, err)
+						return
+					}
+					updateReq, err := schedulerToAddRequest(request)
+					if err != nil {
+						errWrap(context, http.StatusUnprocessableEntity, err)
+						return
+					}
+					err = r.handlers.UpdateScheduler(context, schedulerID, updateReq)
+					if err != nil {
+						errWrap(context, http.StatusUnprocessableEntity, err)
+						return
+					}
+					successWrap(context, http.StatusAccepted, nil)
+				})
 				// Run by ID
 				scheduler.PUT("run", func(context *gin.Context) {
 					schedulerID := context.Param("schedulerId")
@@ -995,6 +1105,175 @@ func (r *router) GetEngine() *gin.Engine {
 	return engine
 }
 
+func documentFormat(format string) apiPb.DocumentFormat {
+	if strings.ToLower(format) == "json" {
+		return apiPb.DocumentFormat_DOCUMENT_FORMAT_JSON
+	}
+	return apiPb.DocumentFormat_DOCUMENT_FORMAT_YAML
+}
+
+func maintenanceWindowToProto(rq *MaintenanceWindow) *apiPb.MaintenanceWindow {
+	window := &apiPb.MaintenanceWindow{
+		Name:         rq.Name,
+		SchedulerIds: rq.SchedulerIds,
+		Selector:     rq.Selector,
+		Cron:         rq.Cron,
+		Duration:     rq.Duration,
+		Mode:         rq.Mode,
+	}
+	if rq.Start != nil {
+		window.Start = timestamp.New(*rq.Start)
+	}
+	if rq.End != nil {
+		window.End = timestamp.New(*rq.End)
+	}
+	return window
+}
+
+func (r *router) bulkSchedulerAction(context *gin.Context, action apiPb.BulkActionRequest_Action) {
+	rq := &SchedulersSelector{}
+	err := context.ShouldBindQuery(rq)
+	if err != nil {
+		errWrap(context, http.StatusUnprocessableEntity, err)
+		return
+	}
+	res, err := r.handlers.BulkSchedulerAction(context, rq.Selector, action)
+	if err != nil {
+		errWrap(context, http.StatusUnprocessableEntity, err)
+		return
+	}
+	successWrap(context, http.StatusAccepted, res)
+}
+
+func schedulerToAddRequest(request *Scheduler) (*apiPb.AddRequest, error) {
+	addReq, err := checkToAddRequest(&request.Check)
+	if err != nil {
+		return nil, err
+	}
+	addReq.Interval = request.Interval
+	addReq.Cron = request.Cron
+	return addReq, nil
+}
+
+func checkToAddRequest(request *Check) (*apiPb.AddRequest, error) {
+	var addReq *apiPb.AddRequest
+
+	switch request.Type {
+	case apiPb.SchedulerType_TCP:
+		if request.TCPConfig == nil {
+			return nil, errMissingConfig
+		}
+		addReq = &apiPb.AddRequest{
+			Config: &apiPb.AddRequest_Tcp{
+				Tcp: request.TCPConfig,
+			},
+		}
+	case apiPb.SchedulerType_SSL_EXPIRATION:
+		if request.SSLExpirationConfig == nil {
+			return nil, errMissingConfig
+		}
+		addReq = &apiPb.AddRequest{
+			Config: &apiPb.AddRequest_SslExpiration{
+				SslExpiration: request.SSLExpirationConfig,
+			},
+		}
+	case apiPb.SchedulerType_GRPC:
+		if request.GRPCConfig == nil {
+			return nil, errMissingConfig
+		}
+		addReq = &apiPb.AddRequest{
+			Config: &apiPb.AddRequest_Grpc{
+				Grpc: request.GRPCConfig,
+			},
+		}
+
+	case apiPb.SchedulerType_HTTP:
+		if request.HTTPConfig == nil {
+			return nil, errMissingConfig
+		}
+		addReq = &apiPb.AddRequest{
+			Config: &apiPb.AddRequest_Http{
+				Http: request.HTTPConfig,
+			},
+		}
+
+	case apiPb.SchedulerType_SITE_MAP:
+		if request.SiteMapConfig == nil {
+			return nil, errMissingConfig
+		}
+		addReq = &apiPb.AddRequest{
+			Config: &apiPb.AddRequest_Sitemap{
+				Sitemap: request.SiteMapConfig,
+			},
+		}
+
+	case apiPb.SchedulerType_HTTP_JSON_VALUE:
+		if request.HTTPValueConfig == nil {
+			return nil, errMissingConfig
+		}
+		addReq = &apiPb.AddRequest{
+			Config: &apiPb.AddRequest_HttpValue{
+				HttpValue: request.HTTPValueConfig,
+			},
+		}
+
+	case apiPb.SchedulerType_DNS:
+		if request.DNSConfig == nil {
+			return nil, errMissingConfig
+		}
+		addReq = &apiPb.AddRequest{
+			Config: &apiPb.AddRequest_Dns{
+				Dns: request.DNSConfig,
+			},
+		}
+
+	case apiPb.SchedulerType_UDP:
+		if request.UDPConfig == nil {
+			return nil, errMissingConfig
+		}
+		addReq = &apiPb.AddRequest{
+			Config: &apiPb.AddRequest_Udp{
+				Udp: request.UDPConfig,
+			},
+		}
+
+	case apiPb.SchedulerType_REDIS:
+		if request.RedisConfig == nil {
+			return nil, errMissingConfig
+		}
+		addReq = &apiPb.AddRequest{
+			Config: &apiPb.AddRequest_Redis{
+				Redis: request.RedisConfig,
+			},
+		}
+
+	case apiPb.SchedulerType_HTTP_SCENARIO:
+		if request.HTTPScenarioConfig == nil {
+			return nil, errMissingConfig
+		}
+		addReq = &apiPb.AddRequest{
+			Config: &apiPb.AddRequest_HttpScenario{
+				HttpScenario: request.HTTPScenarioConfig,
+			},
+		}
+
+	default:
+		return nil, errNotFoundConfigType
+	}
+
+	addReq.Timeout = request.Timeout
+	addReq.Name = request.Name
+	addReq.Labels = request.Labels
+	addReq.RetryPolicy = request.RetryPolicy
+	addReq.ParentIds = request.ParentIDs
+	addReq.Locations = request.Locations
+	addReq.MinFailedLocations = request.MinFailedLocations
+	addReq.FailureInterval = request.FailureInterval
+	addReq.RecoverAfter = request.RecoverAfter
+	addReq.FlapDetection = request.FlapDetection
+	return addReq, nil
+}
+
 func GetSchedulerListSorting(direction apiPb.SortDirection, sortBy apiPb.SortSchedulerList) *apiPb.SortingSchedulerList {
 	if sortBy == apiPb.SortSchedulerList_SORT_SCHEDULER_LIST_UNSPECIFIED {
 		return nil
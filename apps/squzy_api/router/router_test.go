@@ -154,10 +154,30 @@ func (m mockOk) GetAgentByID(ctx context.Context, id string) (*apiPb.AgentItem,
 	return &apiPb.AgentItem{}, nil
 }
 
-func (m mockOk) GetSchedulerList(ctx context.Context) ([]*apiPb.Scheduler, error) {
+func (m mockOk) GetSchedulerList(ctx context.Context, selector string) ([]*apiPb.Scheduler, error) {
 	return []*apiPb.Scheduler{}, nil
 }
 
+func (m mockOk) BulkSchedulerAction(ctx context.Context, selector string, action apiPb.BulkActionRequest_Action) (*apiPb.BulkActionResponse, error) {
+	return &apiPb.BulkActionResponse{}, nil
+}
+
+func (m mockOk) TestScheduler(ctx context.Context, scheduler *apiPb.AddRequest) (*apiPb.TestSchedulerResponse, error) {
+	return &apiPb.TestSchedulerResponse{}, nil
+}
+
+func (m mockOk) GetMaintenanceWindowList(ctx context.Context) ([]*apiPb.MaintenanceWindow, error) {
+	return []*apiPb.MaintenanceWindow{}, nil
+}
+
+func (m mockOk) AddMaintenanceWindow(ctx context.Context, window *apiPb.MaintenanceWindow) (*apiPb.AddMaintenanceWindowResponse, error) {
+	return &apiPb.AddMaintenanceWindowResponse{}, nil
+}
+
+func (m mockOk) RemoveMaintenanceWindow(ctx context.Context, id string) error {
+	return nil
+}
+
 func (m mockOk) GetSchedulerByID(ctx context.Context, id string) (*apiPb.Scheduler, error) {
 	return &apiPb.Scheduler{}, nil
 }
@@ -170,6 +190,18 @@ func (m mockOk) StopScheduler(ctx context.Context, id string) error {
 	return nil
 }
 
+func (m mockOk) UpdateScheduler(ctx context.Context, id string, scheduler *apiPb.AddRequest) error {
+	return nil
+}
+
+func (m mockOk) ExportSchedulers(ctx context.Context, format apiPb.DocumentFormat) ([]byte, error) {
+	return []byte("checks: []"), nil
+}
+
+func (m mockOk) ApplySchedulers(ctx context.Context, rq *apiPb.ApplySchedulersRequest) ([]*apiPb.SchedulerChange, error) {
+	return []*apiPb.SchedulerChange{}, nil
+}
+
 func (m mockOk) RemoveScheduler(ctx context.Context, id string) error {
 	return nil
 }
@@ -317,10 +349,30 @@ func (m mockError) GetAgentByID(ctx context.Context, id string) (*apiPb.AgentIte
 	return nil, errors.New("")
 }
 
-func (m mockError) GetSchedulerList(ctx context.Context) ([]*apiPb.Scheduler, error) {
+func (m mockError) GetSchedulerList(ctx context.Context, selector string) ([]*apiPb.Scheduler, error) {
+	return nil, errors.New("")
+}
+
+func (m mockError) BulkSchedulerAction(ctx context.Context, selector string, action apiPb.BulkActionRequest_Action) (*apiPb.BulkActionResponse, error) {
+	return nil, errors.New("")
+}
+
+func (m mockError) TestScheduler(ctx context.Context, scheduler *apiPb.AddRequest) (*apiPb.TestSchedulerResponse, error) {
+	return nil, errors.New("")
+}
+
+func (m mockError) GetMaintenanceWindowList(ctx context.Context) ([]*apiPb.MaintenanceWindow, error) {
+	return nil, errors.New("")
+}
+
+func (m mockError) AddMaintenanceWindow(ctx context.Context, window *apiPb.MaintenanceWindow) (*apiPb.AddMaintenanceWindowResponse, error) {
 	return nil, errors.New("")
 }
 
+func (m mockError) RemoveMaintenanceWindow(ctx context.Context, id string) error {
+	return errors.New("")
+}
+
 func (m mockError) GetSchedulerByID(ctx context.Context, id string) (*apiPb.Scheduler, error) {
 	return nil, errors.New("")
 }
@@ -329,6 +381,18 @@ func (m mockError) RunScheduler(ctx context.Context, id string) error {
 	return errors.New("")
 }
 
+func (m mockError) UpdateScheduler(ctx context.Context, id string, scheduler *apiPb.AddRequest) error {
+	return errors.New("")
+}
+
+func (m mockError) ExportSchedulers(ctx context.Context, format apiPb.DocumentFormat) ([]byte, error) {
+	return nil, errors.New("")
+}
+
+func (m mockError) ApplySchedulers(ctx context.Context, rq *apiPb.ApplySchedulersRequest) ([]*apiPb.SchedulerChange, error) {
+	return nil, errors.New("")
+}
+
 func (m mockError) StopScheduler(ctx context.Context, id string) error {
 	return errors.New("")
 }
@@ -398,6 +462,99 @@ func TestRouter_GetEngine(t *testing.T) {
 				Method:       http.MethodPut,
 				ExpectedCode: http.StatusNotFound,
 			},
+			{
+				Path:         "/v1/schedulers/export",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusInternalServerError,
+			},
+			{
+				Path:         "/v1/schedulers/export?dryRun=abc",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusUnprocessableEntity,
+			},
+			{
+				Path:         "/v1/schedulers/apply?dryRun=abc",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusUnprocessableEntity,
+			},
+			{
+				Path:         "/v1/schedulers/apply?dryRun=true",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusUnprocessableEntity,
+				Body:         bytes.NewBuffer([]byte("checks: []")),
+			},
+			{
+				Path:         "/v1/schedulers/run?selector=team=payments",
+				Method:       http.MethodPut,
+				ExpectedCode: http.StatusUnprocessableEntity,
+			},
+			{
+				Path:         "/v1/maintenance",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusInternalServerError,
+			},
+			{
+				Path:         "/v1/maintenance",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusUnprocessableEntity,
+			},
+			{
+				Path:         "/v1/maintenance",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusUnprocessableEntity,
+				Body:         bytes.NewBuffer([]byte(`{"selector": "team=payments", "cron": "0 3 * * *", "duration": 1800}`)),
+			},
+			{
+				Path:         "/v1/maintenance/window",
+				Method:       http.MethodDelete,
+				ExpectedCode: http.StatusNotFound,
+			},
+			{
+				Path:         "/v1/schedulers/stop?selector=team=payments",
+				Method:       http.MethodPut,
+				ExpectedCode: http.StatusUnprocessableEntity,
+			},
+			{
+				Path:         "/v1/schedulers?selector=team=payments",
+				Method:       http.MethodDelete,
+				ExpectedCode: http.StatusUnprocessableEntity,
+			},
+			{
+				Path:         "/v1/schedulers/scheduler",
+				Method:       http.MethodPut,
+				ExpectedCode: http.StatusUnprocessableEntity,
+			},
+			{
+				Path:         "/v1/schedulers/scheduler",
+				Method:       http.MethodPut,
+				ExpectedCode: http.StatusUnprocessableEntity,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 20,
+							"type": 1
+						}
+					`,
+				)),
+			},
+			{
+				Path:         "/v1/schedulers/scheduler",
+				Method:       http.MethodPut,
+				ExpectedCode: http.StatusUnprocessableEntity,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 20,
+							"timeout": 10,
+							"type": 1,
+							"tcpConfig": {
+								"host": "localhost",
+								"port": 32
+							}
+						}
+					`,
+				)),
+			},
 			{
 				Path:         "/v1/schedulers",
 				Method:       http.MethodPost,
@@ -521,6 +678,30 @@ func TestRouter_GetEngine(t *testing.T) {
 					`,
 				)),
 			},
+			{
+				Path:         "/v1/schedulers/test",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusUnprocessableEntity,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 10,
+							"timeout": 10,
+							"type": 1,
+							"tcpConfig": {
+								"host": "localhost",
+								"port": 32
+							}
+						}
+					`,
+				)),
+			},
+			{
+				Path:         "/v1/schedulers/test",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusUnprocessableEntity,
+				Body:         bytes.NewBuffer([]byte(`{"interval": 10, "type": 1000}`)),
+			},
 			{
 				Path:         "/v1/schedulers",
 				Method:       http.MethodPost,
@@ -1079,6 +1260,78 @@ func TestRouter_GetEngine(t *testing.T) {
 				Method:       http.MethodPut,
 				ExpectedCode: http.StatusAccepted,
 			},
+			{
+				Path:         "/v1/schedulers/export",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusOK,
+			},
+			{
+				Path:         "/v1/schedulers/export?format=json",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusOK,
+			},
+			{
+				Path:         "/v1/schedulers/apply?format=yaml&dryRun=true",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusOK,
+				Body:         bytes.NewBuffer([]byte("checks: []")),
+			},
+			{
+				Path:         "/v1/schedulers?selector=team%3Dpayments,!deprecated",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusOK,
+			},
+			{
+				Path:         "/v1/schedulers/run?selector=team%3Dpayments",
+				Method:       http.MethodPut,
+				ExpectedCode: http.StatusAccepted,
+			},
+			{
+				Path:         "/v1/maintenance",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusOK,
+			},
+			{
+				Path:         "/v1/maintenance",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusCreated,
+				Body: bytes.NewBuffer([]byte(
+					`{"name": "deploy", "schedulerIds": ["id"], "start": "2026-01-05T10:00:00Z", "end": "2026-01-05T11:00:00Z", "mode": 2}`,
+				)),
+			},
+			{
+				Path:         "/v1/maintenance/window",
+				Method:       http.MethodDelete,
+				ExpectedCode: http.StatusAccepted,
+			},
+			{
+				Path:         "/v1/schedulers/stop?selector=team%3Dpayments",
+				Method:       http.MethodPut,
+				ExpectedCode: http.StatusAccepted,
+			},
+			{
+				Path:         "/v1/schedulers?selector=team%3Dpayments",
+				Method:       http.MethodDelete,
+				ExpectedCode: http.StatusAccepted,
+			},
+			{
+				Path:         "/v1/schedulers/scheduler",
+				Method:       http.MethodPut,
+				ExpectedCode: http.StatusAccepted,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 20,
+							"timeout": 10,
+							"type": 1,
+							"tcpConfig": {
+								"host": "localhost",
+								"port": 32
+							}
+						}
+					`,
+				)),
+			},
 			{
 				Path:         "/v1/schedulers",
 				Method:       http.MethodPost,
@@ -1097,6 +1350,128 @@ func TestRouter_GetEngine(t *testing.T) {
 					`,
 				)),
 			},
+			{
+				Path:         "/v1/schedulers/test",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusOK,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"timeout": 10,
+							"type": 1,
+							"tcpConfig": {
+								"host": "localhost",
+								"port": 32
+							}
+						}
+					`,
+				)),
+			},
+			{
+				Path:         "/v1/schedulers",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusCreated,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 10,
+							"timeout": 10,
+							"type": 1,
+							"tcpConfig": {
+								"host": "localhost",
+								"port": 32
+							},
+							"retryPolicy": {
+								"attempts": 3,
+								"backoff": 200,
+								"backoffMultiplier": 2,
+								"retryableErrors": ["TIMEOUT"]
+							}
+						}
+					`,
+				)),
+			},
+			{
+				Path:         "/v1/schedulers",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusCreated,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 10,
+							"timeout": 10,
+							"type": 1,
+							"tcpConfig": {
+								"host": "localhost",
+								"port": 32
+							},
+							"parentIds": ["5f0f3a3d1c9d440000a1b2c3"]
+						}
+					`,
+				)),
+			},
+			{
+				Path:         "/v1/schedulers",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusCreated,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 10,
+							"timeout": 10,
+							"type": 1,
+							"tcpConfig": {
+								"host": "localhost",
+								"port": 32
+							},
+							"locations": ["eu", "us"],
+							"minFailedLocations": 2
+						}
+					`,
+				)),
+			},
+			{
+				Path:         "/v1/schedulers",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusCreated,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 300,
+							"timeout": 10,
+							"type": 1,
+							"tcpConfig": {
+								"host": "localhost",
+								"port": 32
+							},
+							"failureInterval": 15,
+							"recoverAfter": 3
+						}
+					`,
+				)),
+			},
+			{
+				Path:         "/v1/schedulers",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusCreated,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 10,
+							"timeout": 10,
+							"type": 1,
+							"tcpConfig": {
+								"host": "localhost",
+								"port": 32
+							},
+							"flapDetection": {
+								"window": 10,
+								"threshold": 30
+							}
+						}
+					`,
+				)),
+			},
 			{
 				Path:         "/v1/schedulers",
 				Method:       http.MethodPost,
@@ -1181,6 +1556,111 @@ func TestRouter_GetEngine(t *testing.T) {
 					`,
 				)),
 			},
+			{
+				Path:         "/v1/schedulers",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusCreated,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 10,
+							"timeout": 10,
+							"type": 11,
+							"dnsConfig": {
+								"resolver": "8.8.8.8",
+								"name": "squzy.dev",
+								"recordType": 1
+							}
+						}
+					`,
+				)),
+			},
+			{
+				Path:         "/v1/schedulers",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusCreated,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 10,
+							"timeout": 10,
+							"type": 12,
+							"udpConfig": {
+								"host": "localhost",
+								"port": 11211,
+								"send": "stats",
+								"expect": "END"
+							}
+						}
+					`,
+				)),
+			},
+			{
+				Path:         "/v1/schedulers",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusCreated,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 10,
+							"timeout": 10,
+							"type": 13,
+							"redisConfig": {
+								"host": "localhost",
+								"password": "secret",
+								"maxKeys": 1000
+							}
+						}
+					`,
+				)),
+			},
+			{
+				Path:         "/v1/schedulers",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusCreated,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 60,
+							"timeout": 10,
+							"type": 14,
+							"httpScenarioConfig": {
+								"steps": [
+									{
+										"name": "login",
+										"method": "POST",
+										"url": "https://squzy.dev/login",
+										"extract": [
+											{"name": "token", "source": 1, "expression": "data.token"}
+										]
+									},
+									{
+										"name": "me",
+										"method": "GET",
+										"url": "https://squzy.dev/me",
+										"headers": {"Authorization": "Bearer {{token}}"}
+									}
+								]
+							}
+						}
+					`,
+				)),
+			},
+			{
+				Path:         "/v1/schedulers",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusCreated,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"cron": "0 9 * * 1-5",
+							"timeout": 10,
+							"type": 1,
+							"tcpConfig": {}
+						}
+					`,
+				)),
+			},
 			{
 				Path:         "/v1/schedulers/schdeduler/history?dateFrom=2020-05-17T19:17:05.899Z&dateTo=2020-05-17T19:17:05.899Z&page=2&limit=4",
 				Method:       http.MethodGet,
@@ -2,6 +2,7 @@ package expression
 
 import (
 	"context"
+	"github.com/squzy/squzy/internal/flapping"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	structpb "google.golang.org/protobuf/types/known/structpb"
 	"time"
@@ -115,6 +116,14 @@ func (e *expressionStruct) getSnapshotEnv(schedulerId string) map[string]interfa
 		"float64ToInt64": func(v float64) int64 {
 			return int64(v)
 		},
+		// Percent of state changes between snapshots, like StateChange(Last(21))
+		"StateChange": func(snapshots []*apiPb.SchedulerSnapshot) float64 {
+			return flapping.StateChange(flapping.Codes(snapshots))
+		},
+		// Same check as squzy monitoring does with flap detection, 0 means default threshold
+		"IsFlapping": func(snapshots []*apiPb.SchedulerSnapshot, threshold float64) bool {
+			return flapping.IsFlapping(flapping.Codes(snapshots), threshold)
+		},
 		"getValue": func(snapshot *apiPb.SchedulerSnapshot) *structpb.Value {
 			return snapshot.GetMeta().GetValue()
 		},
@@ -134,7 +143,10 @@ func (e *expressionStruct) getSnapshotEnv(schedulerId string) map[string]interfa
 		"Minute": time.Minute,
 		"Second": time.Second,
 		//Transaction status keys
-		"Ok":    apiPb.SchedulerCode_OK,
-		"Error": apiPb.SchedulerCode_ERROR,
+		"Ok":          apiPb.SchedulerCode_OK,
+		"Error":       apiPb.SchedulerCode_ERROR,
+		"Maintenance": apiPb.SchedulerCode_MAINTENANCE,
+		"Skipped":     apiPb.SchedulerCode_SKIPPED,
+		"Warning":     apiPb.SchedulerCode_WARNING,
 	}
 }
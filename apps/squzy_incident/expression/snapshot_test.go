@@ -60,6 +60,15 @@ func TestExpressionStruct_getSnapshotEnv(t *testing.T) {
 		assert.True(t, res)
 		assert.Nil(t, err)
 	})
+	//Flapping
+	t.Run("Should: return state change", func(t *testing.T) {
+		res, err := exprCorr.ProcessRule(
+			apiPb.ComponentOwnerType_COMPONENT_OWNER_TYPE_SCHEDULER,
+			"12345",
+			`StateChange(Last(21)) == 0 && !IsFlapping(Last(21), 50) && !IsFlapping(Last(21), 0)`)
+		assert.True(t, res)
+		assert.Nil(t, err)
+	})
 	//UnixNanoNow
 	t.Run("Should: no panic", func(t *testing.T) {
 		res, err := exprCorr.ProcessRule(
@@ -162,6 +162,12 @@ func (s *server) ValidateRule(ctx context.Context, request *apiPb.ValidateRuleRe
 }
 
 func (s *server) ProcessRecordFromStorage(ctx context.Context, request *apiPb.StorageRecord) (*empty.Empty, error) {
+	// Scheduler is in maintenance window, incidents are expected.
+	// Skipped check failed because of parent, incident is opened only for parent
+	switch request.GetSnapshot().GetSnapshot().GetCode() {
+	case apiPb.SchedulerCode_MAINTENANCE, apiPb.SchedulerCode_SKIPPED:
+		return &empty.Empty{}, nil
+	}
 	ownerType, ownerId, err := getOwnerTypeAndId(request)
 	if err != nil {
 		return nil, err
@@ -576,6 +576,32 @@ func TestServer_RemoveRule(t *testing.T) {
 }
 
 func TestServer_ProcessRecordFromStorage(t *testing.T) {
+	t.Run("Should: skip snapshot in maintenance", func(t *testing.T) {
+		_, err := sErr.ProcessRecordFromStorage(ctx, &apiPb.StorageRecord{
+			Record: &apiPb.StorageRecord_Snapshot{
+				Snapshot: &apiPb.SchedulerSnapshotWithId{
+					Id: primitive.NewObjectID().Hex(),
+					Snapshot: &apiPb.SchedulerSnapshot{
+						Code: apiPb.SchedulerCode_MAINTENANCE,
+					},
+				},
+			},
+		})
+		assert.NoError(t, err)
+	})
+	t.Run("Should: skip snapshot skipped by dependency", func(t *testing.T) {
+		_, err := sErr.ProcessRecordFromStorage(ctx, &apiPb.StorageRecord{
+			Record: &apiPb.StorageRecord_Snapshot{
+				Snapshot: &apiPb.SchedulerSnapshotWithId{
+					Id: primitive.NewObjectID().Hex(),
+					Snapshot: &apiPb.SchedulerSnapshot{
+						Code: apiPb.SchedulerCode_SKIPPED,
+					},
+				},
+			},
+		})
+		assert.NoError(t, err)
+	})
 	t.Run("Should: return error", func(t *testing.T) {
 		_, err := s.ProcessRecordFromStorage(ctx, &apiPb.StorageRecord{
 			Record: &apiPb.StorageRecord_AgentMetric{},
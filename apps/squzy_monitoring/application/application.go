@@ -2,45 +2,65 @@ package application
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	"github.com/squzy/squzy/apps/squzy_monitoring/server"
 	"github.com/squzy/squzy/internal/cache"
-	"github.com/squzy/squzy/internal/helpers"
 	job_executor "github.com/squzy/squzy/internal/job-executor"
 	"github.com/squzy/squzy/internal/logger"
+	"github.com/squzy/squzy/internal/maintenance"
+	"github.com/squzy/squzy/internal/probe"
 	"github.com/squzy/squzy/internal/scheduler"
 	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
 	scheduler_storage "github.com/squzy/squzy/internal/scheduler-storage"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"go.mongodb.org/mongo-driver/mongo"
 	"google.golang.org/grpc"
 	"net"
+	"time"
+)
+
+const (
+	// Probe streams are not closed by graceful stop, so they are cut after it
+	shutdownTimeout = time.Second * 10
 )
 
 type app struct {
-	cache            cache.Cache
-	schedulerStorage scheduler_storage.SchedulerStorage
-	jobExecutor      job_executor.JobExecutor
-	configStorage    scheduler_config_storage.Storage
+	schedulerStorage   scheduler_storage.SchedulerStorage
+	dispatcher         scheduler.Dispatcher
+	configStorage      scheduler_config_storage.Storage
+	maintenanceStorage maintenance.Storage
+	tester             job_executor.Tester
+	probes             probe.Hub
+	syncInterval       time.Duration
 }
 
+// New create application, syncInterval is how often schedulers resynced with DB, 0 means only on start
 func New(
 	schedulerStorage scheduler_storage.SchedulerStorage,
 	jobExecutor job_executor.JobExecutor,
 	configStorage scheduler_config_storage.Storage,
 	cache cache.Cache,
+	maintenanceStorage maintenance.Storage,
+	tester job_executor.Tester,
+	probes probe.Hub,
+	syncInterval time.Duration,
 ) *app {
 	return &app{
-		cache:            cache,
-		schedulerStorage: schedulerStorage,
-		jobExecutor:      jobExecutor,
-		configStorage:    configStorage,
+		schedulerStorage:   schedulerStorage,
+		dispatcher:         scheduler.NewDispatcher(jobExecutor, cache),
+		configStorage:      configStorage,
+		maintenanceStorage: maintenanceStorage,
+		tester:             tester,
+		probes:             probes,
+		syncInterval:       syncInterval,
 	}
 }
 
 func (s *app) SyncOne(config *scheduler_config_storage.SchedulerConfig) error {
-	sched, err := scheduler.New(config.ID, helpers.DurationFromSecond(config.Interval), s.jobExecutor, s.cache)
+	sched, err := scheduler.NewFromConfig(config, s.dispatcher)
 	if err != nil {
 		logger.Errorf("SchedulerId: %s cant synced, error in config", config.ID.Hex())
 		return err
@@ -76,11 +96,109 @@ func (s *app) sync() error {
 	return nil
 }
 
-func (s *app) Run(port int32) error {
+// Resync make in memory schedulers same as configs in DB, so changes made directly in DB
+// or by another replica are picked up without restart
+func (s *app) Resync() error {
+	ctx := context.Background()
+	configs, err := s.configStorage.GetAllForSync(ctx)
+	if err != nil {
+		return err
+	}
+	stored := make(map[string]bool, len(configs))
+	for _, config := range configs {
+		stored[config.ID.Hex()] = true
+		s.reconcile(ctx, config)
+	}
+	for _, sched := range s.schedulerStorage.GetAll() {
+		if stored[sched.GetID()] {
+			continue
+		}
+		// Scheduler could be added after configs were read
+		config, errGet := s.configStorage.Get(ctx, sched.GetIDBson())
+		if errGet != nil && !errors.Is(errGet, mongo.ErrNoDocuments) {
+			continue
+		}
+		if config != nil && config.Status != apiPb.SchedulerStatus_REMOVED {
+			continue
+		}
+		_ = s.schedulerStorage.Remove(sched.GetID())
+		logger.Infof("SchedulerId: %s resynced and REMOVE", sched.GetID())
+	}
+	return nil
+}
+
+func (s *app) reconcile(ctx context.Context, config *scheduler_config_storage.SchedulerConfig) {
+	id := config.ID.Hex()
+	sched, err := s.schedulerStorage.Get(id)
+	if err != nil {
+		_ = s.SyncOne(config)
+		return
+	}
+	if isSynced(sched, config) {
+		return
+	}
+	// Config could be changed by API after list was read, so act on fresh one
+	config, err = s.configStorage.Get(ctx, config.ID)
+	if err != nil || config.Status == apiPb.SchedulerStatus_REMOVED || isSynced(sched, config) {
+		return
+	}
+	if sched.GetSchedule() != schedule(config) {
+		_ = s.schedulerStorage.Remove(id)
+		_ = s.SyncOne(config)
+		return
+	}
+	if config.Status == apiPb.SchedulerStatus_STOPPED {
+		sched.Stop()
+		logger.Infof("SchedulerId: %s resynced and STOP", id)
+		return
+	}
+	err = sched.Run()
+	if err != nil {
+		logger.Errorf("SchedulerId: %s cant resynced, %s", id, err.Error())
+		return
+	}
+	logger.Infof("SchedulerId: %s resynced and RUN", id)
+}
+
+func isSynced(sched scheduler.Scheduler, config *scheduler_config_storage.SchedulerConfig) bool {
+	return sched.IsRun() == (config.Status == apiPb.SchedulerStatus_RUNNED) &&
+		sched.GetSchedule() == schedule(config)
+}
+
+// Return empty string for wrong config
+func schedule(config *scheduler_config_storage.SchedulerConfig) string {
+	sched, err := scheduler.NewFromConfig(config, nil)
+	if err != nil {
+		return ""
+	}
+	return sched.GetSchedule()
+}
+
+func (s *app) resyncLoop(ctx context.Context) {
+	ticker := time.NewTicker(s.syncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := s.Resync()
+			if err != nil {
+				logger.Errorf("Resync failed: %s", err.Error())
+			}
+		}
+	}
+}
+
+// Run serves until ctx is cancelled, then requests in progress are finished and nil is returned
+func (s *app) Run(ctx context.Context, port int32) error {
 	err := s.sync()
 	if err != nil {
 		return err
 	}
+	if s.syncInterval > 0 {
+		go s.resyncLoop(ctx)
+	}
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
 	if err != nil {
 		return err
@@ -99,10 +217,40 @@ func (s *app) Run(port int32) error {
 		grpcServer,
 		server.New(
 			s.schedulerStorage,
-			s.jobExecutor,
+			s.dispatcher,
 			s.configStorage,
-			s.cache,
+			s.maintenanceStorage,
+			s.tester,
+			s.probes,
 		),
 	)
-	return grpcServer.Serve(lis)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- grpcServer.Serve(lis)
+	}()
+	select {
+	case err = <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+	stopServer(grpcServer)
+	// No new checks are handed to pool after it, so pool could be stopped right after Run
+	s.dispatcher.Stop()
+	logger.Info("Server stopped")
+	return nil
+}
+
+func stopServer(grpcServer *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	timer := time.NewTimer(shutdownTimeout)
+	defer timer.Stop()
+	select {
+	case <-stopped:
+	case <-timer.C:
+		grpcServer.Stop()
+	}
 }
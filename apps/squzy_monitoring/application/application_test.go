@@ -3,13 +3,17 @@ package application
 import (
 	"context"
 	"errors"
+	"github.com/squzy/squzy/internal/labels"
 	"github.com/squzy/squzy/internal/scheduler"
 	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	scheduler_storage "github.com/squzy/squzy/internal/scheduler-storage"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"net"
+	"sync"
 	"testing"
 	"time"
 )
@@ -35,6 +39,10 @@ func (m mockStorageError) Remove(string) error {
 	panic("implement me")
 }
 
+func (m mockStorageError) GetAll() []scheduler.Scheduler {
+	panic("implement me")
+}
+
 type mockConfigStorageOk struct {
 }
 
@@ -69,6 +77,26 @@ func (m mockConfigStorageError) GetAllForSync(ctx context.Context) ([]*scheduler
 	return nil, errors.New("asf")
 }
 
+func (m mockConfigStorageError) SetLastCode(ctx context.Context, schedulerID primitive.ObjectID, code apiPb.SchedulerCode) error {
+	return errors.New("")
+}
+
+func (m mockConfigStorageError) SetRecoverLeft(ctx context.Context, schedulerID primitive.ObjectID, left int32) error {
+	return errors.New("")
+}
+
+func (m mockConfigStorageError) PushRecentCode(ctx context.Context, schedulerID primitive.ObjectID, code apiPb.SchedulerCode, window int32) error {
+	return errors.New("")
+}
+
+func (m mockConfigStorageError) Update(ctx context.Context, config *scheduler_config_storage.SchedulerConfig) error {
+	panic("implement me")
+}
+
+func (m mockConfigStorageError) GetBySelector(ctx context.Context, selector labels.Selector) ([]*scheduler_config_storage.SchedulerConfig, error) {
+	panic("implement me")
+}
+
 func (m mockConfigStorageOk) Get(ctx context.Context, schedulerId primitive.ObjectID) (*scheduler_config_storage.SchedulerConfig, error) {
 	panic("implement me")
 }
@@ -112,6 +140,26 @@ func (m mockConfigStorageOk) GetAllForSync(ctx context.Context) ([]*scheduler_co
 	}, nil
 }
 
+func (m mockConfigStorageOk) SetLastCode(ctx context.Context, schedulerID primitive.ObjectID, code apiPb.SchedulerCode) error {
+	return nil
+}
+
+func (m mockConfigStorageOk) SetRecoverLeft(ctx context.Context, schedulerID primitive.ObjectID, left int32) error {
+	return nil
+}
+
+func (m mockConfigStorageOk) PushRecentCode(ctx context.Context, schedulerID primitive.ObjectID, code apiPb.SchedulerCode, window int32) error {
+	return nil
+}
+
+func (m mockConfigStorageOk) Update(ctx context.Context, config *scheduler_config_storage.SchedulerConfig) error {
+	panic("implement me")
+}
+
+func (m mockConfigStorageOk) GetBySelector(ctx context.Context, selector labels.Selector) ([]*scheduler_config_storage.SchedulerConfig, error) {
+	panic("implement me")
+}
+
 type mockStorageOk struct {
 }
 
@@ -127,6 +175,10 @@ func (m mockStorageOk) Remove(string) error {
 	panic("implement me")
 }
 
+func (m mockStorageOk) GetAll() []scheduler.Scheduler {
+	return []scheduler.Scheduler{}
+}
+
 type mockCacheOk struct {
 }
 
@@ -147,6 +199,17 @@ func (m mockCacheOk) DeleteScheduleById(data *apiPb.DeleteScheduleWithIdRequest)
 	return nil
 }
 
+func (m mockCacheOk) ClaimSchedules(data []*apiPb.ClaimScheduleWithIdRequest) ([]*apiPb.ClaimScheduleWithIdResponse, error) {
+	res := make([]*apiPb.ClaimScheduleWithIdResponse, len(data))
+	for i := range data {
+		res[i] = &apiPb.ClaimScheduleWithIdResponse{
+			Claimed:       true,
+			ScheduledNext: data[i].ScheduledNext,
+		}
+	}
+	return res, nil
+}
+
 type mockCacheErr struct {
 }
 
@@ -162,41 +225,64 @@ func (m mockCacheErr) DeleteScheduleById(data *apiPb.DeleteScheduleWithIdRequest
 	return errors.New("DeleteScheduleById")
 }
 
+func (m mockCacheErr) ClaimSchedules(data []*apiPb.ClaimScheduleWithIdRequest) ([]*apiPb.ClaimScheduleWithIdResponse, error) {
+	return nil, errors.New("ClaimSchedules")
+}
+
 func TestNew(t *testing.T) {
 	t.Run("Should: Create new application", func(t *testing.T) {
-		app := New(nil, nil, nil, mockCacheOk{})
+		app := New(nil, nil, nil, mockCacheOk{}, nil, nil, nil, 0)
 		assert.NotEqual(t, nil, app)
 	})
 }
 
 func TestApp_Run(t *testing.T) {
 	t.Run("Should: not return error", func(t *testing.T) {
-		app := New(&mockStorageOk{}, &mockExecuter{}, &mockConfigStorageOk{}, mockCacheOk{})
+		app := New(&mockStorageOk{}, &mockExecuter{}, &mockConfigStorageOk{}, mockCacheOk{}, nil, nil, nil, 0)
 		go func() {
-			_ = app.Run(11111)
+			_ = app.Run(context.Background(), 11111)
 		}()
 		time.Sleep(time.Second)
 		_, err := net.Dial("tcp", "localhost:11111")
 		assert.Equal(t, nil, err)
 	})
 	t.Run("Should: return error because port is wrong", func(t *testing.T) {
-		app := New(&mockStorageOk{}, &mockExecuter{}, &mockConfigStorageOk{}, mockCacheOk{})
-		assert.NotEqual(t, nil, app.Run(1244214))
+		app := New(&mockStorageOk{}, &mockExecuter{}, &mockConfigStorageOk{}, mockCacheOk{}, nil, nil, nil, 0)
+		assert.NotEqual(t, nil, app.Run(context.Background(), 1244214))
 	})
 	t.Run("Should: return err because cant sync with DB", func(t *testing.T) {
-		app := New(&mockStorageOk{}, &mockExecuter{}, &mockConfigStorageError{}, mockCacheOk{})
+		app := New(&mockStorageOk{}, &mockExecuter{}, &mockConfigStorageError{}, mockCacheOk{}, nil, nil, nil, 0)
 		go func() {
-			_ = app.Run(11111)
+			_ = app.Run(context.Background(), 11111)
 		}()
 		time.Sleep(time.Second)
 		_, err := net.Dial("tcp", "localhost:11111")
 		assert.Equal(t, nil, err)
 	})
+	t.Run("Should: stop when context is cancelled", func(t *testing.T) {
+		configStorage := &mockConfigStorageSync{configs: map[primitive.ObjectID]*scheduler_config_storage.SchedulerConfig{}}
+		app := New(scheduler_storage.New(), &mockExecuter{}, configStorage, mockCacheOk{}, nil, nil, nil, time.Millisecond*10)
+		ctx, cancel := context.WithCancel(context.Background())
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- app.Run(ctx, 11113)
+		}()
+		time.Sleep(time.Millisecond * 100)
+		cancel()
+		select {
+		case err := <-errCh:
+			assert.Nil(t, err)
+		case <-time.After(time.Second * 5):
+			assert.Fail(t, "application was not stopped")
+		}
+		_, err := net.Dial("tcp", "localhost:11113")
+		assert.NotNil(t, err)
+	})
 }
 
 func TestApp_SyncOne(t *testing.T) {
 	t.Run("Should: return error because config wrong", func(t *testing.T) {
-		app := New(&mockStorageOk{}, &mockExecuter{}, nil, nil)
+		app := New(&mockStorageOk{}, &mockExecuter{}, nil, nil, nil, nil, nil, 0)
 		err := app.SyncOne(&scheduler_config_storage.SchedulerConfig{
 			ID:       primitive.ObjectID{},
 			Type:     0,
@@ -207,7 +293,7 @@ func TestApp_SyncOne(t *testing.T) {
 		assert.NotEqual(t, nil, err)
 	})
 	t.Run("Should: return error because cant set in storage", func(t *testing.T) {
-		app := New(&mockStorageError{}, &mockExecuter{}, nil, nil)
+		app := New(&mockStorageError{}, &mockExecuter{}, nil, nil, nil, nil, nil, 0)
 		err := app.SyncOne(&scheduler_config_storage.SchedulerConfig{
 			ID:       primitive.ObjectID{},
 			Type:     0,
@@ -218,7 +304,7 @@ func TestApp_SyncOne(t *testing.T) {
 		assert.NotEqual(t, nil, err)
 	})
 	t.Run("Should: return nil because status stopped", func(t *testing.T) {
-		app := New(&mockStorageOk{}, &mockExecuter{}, nil, nil)
+		app := New(&mockStorageOk{}, &mockExecuter{}, nil, nil, nil, nil, nil, 0)
 		err := app.SyncOne(&scheduler_config_storage.SchedulerConfig{
 			ID:       primitive.ObjectID{},
 			Type:     0,
@@ -229,7 +315,7 @@ func TestApp_SyncOne(t *testing.T) {
 		assert.Equal(t, nil, err)
 	})
 	t.Run("Should: return nil because status runned", func(t *testing.T) {
-		app := New(&mockStorageOk{}, &mockExecuter{}, nil, mockCacheOk{})
+		app := New(&mockStorageOk{}, &mockExecuter{}, nil, mockCacheOk{}, nil, nil, nil, 0)
 		err := app.SyncOne(&scheduler_config_storage.SchedulerConfig{
 			ID:       primitive.ObjectID{},
 			Type:     0,
@@ -240,7 +326,7 @@ func TestApp_SyncOne(t *testing.T) {
 		assert.Equal(t, nil, err)
 	})
 	t.Run("Should: return err because cache returns error", func(t *testing.T) {
-		app := New(&mockStorageOk{}, &mockExecuter{}, nil, mockCacheErr{})
+		app := New(&mockStorageOk{}, &mockExecuter{}, nil, mockCacheErr{}, nil, nil, nil, 0)
 		err := app.SyncOne(&scheduler_config_storage.SchedulerConfig{
 			ID:       primitive.ObjectID{},
 			Type:     0,
@@ -251,3 +337,225 @@ func TestApp_SyncOne(t *testing.T) {
 		assert.ErrorContains(t, err, "GetScheduleById")
 	})
 }
+
+type mockConfigStorageSync struct {
+	mockConfigStorageOk
+	mutex   sync.Mutex
+	configs map[primitive.ObjectID]*scheduler_config_storage.SchedulerConfig
+	// Visible only for Get, like added after configs were read
+	unlisted map[primitive.ObjectID]bool
+}
+
+func (m *mockConfigStorageSync) set(config *scheduler_config_storage.SchedulerConfig) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.configs[config.ID] = config
+}
+
+func (m *mockConfigStorageSync) Get(ctx context.Context, schedulerId primitive.ObjectID) (*scheduler_config_storage.SchedulerConfig, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	config, ok := m.configs[schedulerId]
+	if !ok {
+		return nil, mongo.ErrNoDocuments
+	}
+	return config, nil
+}
+
+func (m *mockConfigStorageSync) GetAllForSync(ctx context.Context) ([]*scheduler_config_storage.SchedulerConfig, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	res := []*scheduler_config_storage.SchedulerConfig{}
+	for _, config := range m.configs {
+		if config.Status != apiPb.SchedulerStatus_REMOVED && !m.unlisted[config.ID] {
+			res = append(res, config)
+		}
+	}
+	return res, nil
+}
+
+func (m *mockConfigStorageSync) SetLastCode(ctx context.Context, schedulerID primitive.ObjectID, code apiPb.SchedulerCode) error {
+	return nil
+}
+
+func (m *mockConfigStorageSync) SetRecoverLeft(ctx context.Context, schedulerID primitive.ObjectID, left int32) error {
+	return nil
+}
+
+func (m *mockConfigStorageSync) PushRecentCode(ctx context.Context, schedulerID primitive.ObjectID, code apiPb.SchedulerCode, window int32) error {
+	return nil
+}
+
+func TestApp_Resync(t *testing.T) {
+	id := primitive.NewObjectID()
+	newSync := func(configs ...*scheduler_config_storage.SchedulerConfig) (*app, scheduler_storage.SchedulerStorage, *mockConfigStorageSync) {
+		configStorage := &mockConfigStorageSync{configs: map[primitive.ObjectID]*scheduler_config_storage.SchedulerConfig{}}
+		for _, config := range configs {
+			configStorage.set(config)
+		}
+		storage := scheduler_storage.New()
+		return New(storage, &mockExecuter{}, configStorage, mockCacheOk{}, nil, nil, nil, 0), storage, configStorage
+	}
+	t.Run("Should: return error because DB", func(t *testing.T) {
+		app := New(&mockStorageOk{}, &mockExecuter{}, &mockConfigStorageError{}, mockCacheOk{}, nil, nil, nil, 0)
+		assert.NotEqual(t, nil, app.Resync())
+	})
+	t.Run("Should: create scheduler added in DB", func(t *testing.T) {
+		app, storage, _ := newSync(&scheduler_config_storage.SchedulerConfig{
+			ID:       id,
+			Status:   apiPb.SchedulerStatus_RUNNED,
+			Interval: 10,
+		})
+		defer app.dispatcher.Stop()
+		assert.Equal(t, nil, app.Resync())
+		sched, err := storage.Get(id.Hex())
+		assert.Equal(t, nil, err)
+		assert.True(t, sched.IsRun())
+	})
+	t.Run("Should: stop and run scheduler by status in DB", func(t *testing.T) {
+		app, storage, configStorage := newSync(&scheduler_config_storage.SchedulerConfig{
+			ID:       id,
+			Status:   apiPb.SchedulerStatus_RUNNED,
+			Interval: 10,
+		})
+		defer app.dispatcher.Stop()
+		assert.Equal(t, nil, app.Resync())
+		sched, _ := storage.Get(id.Hex())
+		configStorage.set(&scheduler_config_storage.SchedulerConfig{
+			ID:       id,
+			Status:   apiPb.SchedulerStatus_STOPPED,
+			Interval: 10,
+		})
+		assert.Equal(t, nil, app.Resync())
+		assert.False(t, sched.IsRun())
+		configStorage.set(&scheduler_config_storage.SchedulerConfig{
+			ID:       id,
+			Status:   apiPb.SchedulerStatus_RUNNED,
+			Interval: 10,
+		})
+		assert.Equal(t, nil, app.Resync())
+		assert.True(t, sched.IsRun())
+		actual, _ := storage.Get(id.Hex())
+		assert.Equal(t, sched, actual)
+	})
+	t.Run("Should: run and stop scheduler while it is resynced", func(t *testing.T) {
+		app, storage, configStorage := newSync(&scheduler_config_storage.SchedulerConfig{
+			ID:       id,
+			Status:   apiPb.SchedulerStatus_RUNNED,
+			Interval: 10,
+		})
+		defer app.dispatcher.Stop()
+		assert.Equal(t, nil, app.Resync())
+		sched, _ := storage.Get(id.Hex())
+		wg := sync.WaitGroup{}
+		wg.Add(2)
+		// Like Run and Stop of API, they change status in DB too
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				status := apiPb.SchedulerStatus_RUNNED
+				if i%2 == 1 {
+					status = apiPb.SchedulerStatus_STOPPED
+				}
+				configStorage.set(&scheduler_config_storage.SchedulerConfig{
+					ID:       id,
+					Status:   status,
+					Interval: 10,
+				})
+				if status == apiPb.SchedulerStatus_RUNNED {
+					_ = sched.Run()
+				} else {
+					sched.Stop()
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				_ = app.Resync()
+			}
+		}()
+		wg.Wait()
+		assert.Equal(t, nil, app.Resync())
+		assert.False(t, sched.IsRun())
+	})
+	t.Run("Should: restart scheduler because interval changed", func(t *testing.T) {
+		app, storage, configStorage := newSync(&scheduler_config_storage.SchedulerConfig{
+			ID:       id,
+			Status:   apiPb.SchedulerStatus_RUNNED,
+			Interval: 10,
+		})
+		defer app.dispatcher.Stop()
+		assert.Equal(t, nil, app.Resync())
+		configStorage.set(&scheduler_config_storage.SchedulerConfig{
+			ID:     id,
+			Status: apiPb.SchedulerStatus_RUNNED,
+			Cron:   "@hourly",
+		})
+		assert.Equal(t, nil, app.Resync())
+		sched, err := storage.Get(id.Hex())
+		assert.Equal(t, nil, err)
+		assert.Equal(t, "@hourly", sched.GetSchedule())
+		assert.True(t, sched.IsRun())
+	})
+	t.Run("Should: remove scheduler removed in DB", func(t *testing.T) {
+		removedID := primitive.NewObjectID()
+		app, storage, configStorage := newSync(
+			&scheduler_config_storage.SchedulerConfig{
+				ID:       id,
+				Status:   apiPb.SchedulerStatus_RUNNED,
+				Interval: 10,
+			},
+			&scheduler_config_storage.SchedulerConfig{
+				ID:       removedID,
+				Status:   apiPb.SchedulerStatus_STOPPED,
+				Interval: 10,
+			},
+		)
+		defer app.dispatcher.Stop()
+		assert.Equal(t, nil, app.Resync())
+		configStorage.set(&scheduler_config_storage.SchedulerConfig{
+			ID:     removedID,
+			Status: apiPb.SchedulerStatus_REMOVED,
+		})
+		configStorage.mutex.Lock()
+		delete(configStorage.configs, id)
+		configStorage.mutex.Unlock()
+		assert.Equal(t, nil, app.Resync())
+		assert.Len(t, storage.GetAll(), 0)
+	})
+	t.Run("Should: keep scheduler added after configs were read", func(t *testing.T) {
+		app, storage, configStorage := newSync(&scheduler_config_storage.SchedulerConfig{
+			ID:       id,
+			Status:   apiPb.SchedulerStatus_STOPPED,
+			Interval: 10,
+		})
+		defer app.dispatcher.Stop()
+		configStorage.unlisted = map[primitive.ObjectID]bool{id: true}
+		sched, _ := scheduler.New(id, time.Second*10, app.dispatcher)
+		_ = storage.Set(sched)
+		assert.Equal(t, nil, app.Resync())
+		assert.Len(t, storage.GetAll(), 1)
+	})
+}
+
+func TestApp_RunWithResync(t *testing.T) {
+	t.Run("Should: pick up config added in DB", func(t *testing.T) {
+		configStorage := &mockConfigStorageSync{configs: map[primitive.ObjectID]*scheduler_config_storage.SchedulerConfig{}}
+		storage := scheduler_storage.New()
+		app := New(storage, &mockExecuter{}, configStorage, mockCacheOk{}, nil, nil, nil, time.Millisecond*50)
+		go func() {
+			_ = app.Run(context.Background(), 11112)
+		}()
+		time.Sleep(time.Millisecond * 100)
+		id := primitive.NewObjectID()
+		configStorage.set(&scheduler_config_storage.SchedulerConfig{
+			ID:       id,
+			Status:   apiPb.SchedulerStatus_STOPPED,
+			Interval: 10,
+		})
+		time.Sleep(time.Millisecond * 200)
+		_, err := storage.Get(id.Hex())
+		assert.Equal(t, nil, err)
+	})
+}
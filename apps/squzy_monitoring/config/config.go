@@ -2,41 +2,77 @@ package config
 
 import (
 	"github.com/squzy/squzy/internal/helpers"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
 const (
-	ENV_PORT             = "PORT"
-	ENV_STORAGE_TIMEOUT  = "SQUZY_STORAGE_TIMEOUT"
-	ENV_MONGO_DB         = "MONGO_DB"
-	ENV_MONGO_URI        = "MONGO_URI"
-	ENV_MONGO_COLLECTION = "MONGO_COLLECTION"
-	ENV_STORAGE_HOST     = "SQUZY_STORAGE_HOST"
-	ENV_CACHE_ADDR       = "CACHE_ADDR"
-	ENV_CACHE_PASSWORD   = "CACHE_PASSWORD"
-	ENV_CACHE_DB         = "CACHE_DB"
-
-	defaultCacheDb        int32 = 0
-	defaultPort           int32 = 9094
-	defaultStorageTimeout       = time.Second * 5
-	defaultMongoDb              = "squzy_monitoring"
-	defaultCollection           = "schedulers"
+	ENV_PORT                         = "PORT"
+	ENV_STORAGE_TIMEOUT              = "SQUZY_STORAGE_TIMEOUT"
+	ENV_MONGO_DB                     = "MONGO_DB"
+	ENV_MONGO_URI                    = "MONGO_URI"
+	ENV_MONGO_COLLECTION             = "MONGO_COLLECTION"
+	ENV_MONGO_MAINTENANCE_COLLECTION = "MONGO_MAINTENANCE_COLLECTION"
+	ENV_STORAGE_HOST                 = "SQUZY_STORAGE_HOST"
+	ENV_CACHE_ADDR                   = "CACHE_ADDR"
+	ENV_CACHE_PASSWORD               = "CACHE_PASSWORD"
+	ENV_CACHE_DB                     = "CACHE_DB"
+	ENV_CACHE_TYPE                   = "CACHE_TYPE"
+	ENV_CACHE_FILE                   = "CACHE_FILE"
+	// Job executor pool
+	ENV_WORKERS        = "EXECUTOR_WORKERS"
+	ENV_QUEUE_SIZE     = "EXECUTOR_QUEUE_SIZE"
+	ENV_OVERRUN_POLICY = "EXECUTOR_OVERRUN_POLICY"
+	ENV_TYPE_LIMITS    = "EXECUTOR_TYPE_LIMITS"
+	ENV_STATS_INTERVAL = "EXECUTOR_STATS_INTERVAL"
+	ENV_SYNC_INTERVAL  = "SYNC_INTERVAL"
+	ENV_PROBE_TOKEN    = "SQUZY_PROBE_TOKEN"
+
+	defaultCacheDb               int32 = 0
+	defaultCacheType                   = CacheTypeRedis
+	defaultPort                  int32 = 9094
+	defaultStorageTimeout              = time.Second * 5
+	defaultMongoDb                     = "squzy_monitoring"
+	defaultCollection                  = "schedulers"
+	defaultMaintenanceCollection       = "maintenance_windows"
+	defaultWorkers                     = 100
+	defaultQueueSize                   = 1000
+	defaultOverrunPolicy               = "skip"
+	defaultStatsInterval               = time.Minute
+	defaultSyncInterval                = time.Second * 30
 )
 
 const SmallestInterval = time.Millisecond * 500
 
+const (
+	CacheTypeRedis = "redis"
+	// In process cache, only for single replica
+	CacheTypeMemory = "memory"
+)
+
 type cfg struct {
-	port            int32
-	timeout         time.Duration
-	clientAddress   string
-	mongoURI        string
-	mongoDb         string
-	mongoCollection string
-	cacheAddr       string
-	cachePassword   string
-	cacheDB         int32
+	port                  int32
+	timeout               time.Duration
+	clientAddress         string
+	mongoURI              string
+	mongoDb               string
+	mongoCollection       string
+	maintenanceCollection string
+	cacheAddr             string
+	cachePassword         string
+	cacheDB               int32
+	cacheType             string
+	cacheFile             string
+	workers               int
+	queueSize             int
+	overrunPolicy         string
+	typeLimits            map[apiPb.SchedulerType]int
+	statsInterval         time.Duration
+	syncInterval          time.Duration
+	probeToken            string
 }
 
 func (c *cfg) GetPort() int32 {
@@ -63,6 +99,10 @@ func (c *cfg) GetMongoCollection() string {
 	return c.mongoCollection
 }
 
+func (c *cfg) GetMongoMaintenanceCollection() string {
+	return c.maintenanceCollection
+}
+
 func (c *cfg) GetCacheAddr() string {
 	return c.cacheAddr
 }
@@ -75,6 +115,42 @@ func (c *cfg) GetCacheDB() int32 {
 	return c.cacheDB
 }
 
+func (c *cfg) GetCacheType() string {
+	return c.cacheType
+}
+
+func (c *cfg) GetCacheFile() string {
+	return c.cacheFile
+}
+
+func (c *cfg) GetWorkers() int {
+	return c.workers
+}
+
+func (c *cfg) GetQueueSize() int {
+	return c.queueSize
+}
+
+func (c *cfg) GetOverrunPolicy() string {
+	return c.overrunPolicy
+}
+
+func (c *cfg) GetTypeLimits() map[apiPb.SchedulerType]int {
+	return c.typeLimits
+}
+
+func (c *cfg) GetStatsInterval() time.Duration {
+	return c.statsInterval
+}
+
+func (c *cfg) GetSyncInterval() time.Duration {
+	return c.syncInterval
+}
+
+func (c *cfg) GetProbeToken() string {
+	return c.probeToken
+}
+
 type Config interface {
 	GetPort() int32
 	GetClientAddress() string
@@ -82,9 +158,25 @@ type Config interface {
 	GetMongoURI() string
 	GetMongoDb() string
 	GetMongoCollection() string
+	// Collection for maintenance windows
+	GetMongoMaintenanceCollection() string
 	GetCacheAddr() string
 	GetCachePassword() string
 	GetCacheDB() int32
+	// redis or memory
+	GetCacheType() string
+	// Where memory cache keeps schedules between restarts, empty means nowhere
+	GetCacheFile() string
+	GetWorkers() int
+	GetQueueSize() int
+	// skip or queue
+	GetOverrunPolicy() string
+	GetTypeLimits() map[apiPb.SchedulerType]int
+	GetStatsInterval() time.Duration
+	// How often schedulers are resynced with DB, 0 means only on start
+	GetSyncInterval() time.Duration
+	// Shared with probes, probes are rejected while it is empty
+	GetProbeToken() string
 }
 
 func New() Config {
@@ -114,6 +206,10 @@ func New() Config {
 	if collection == "" {
 		collection = defaultCollection
 	}
+	maintenanceCollection := os.Getenv(ENV_MONGO_MAINTENANCE_COLLECTION)
+	if maintenanceCollection == "" {
+		maintenanceCollection = defaultMaintenanceCollection
+	}
 
 	cacheDBValue := os.Getenv(ENV_CACHE_DB)
 	cacheDB := defaultCacheDb
@@ -123,15 +219,86 @@ func New() Config {
 			cacheDB = int32(i)
 		}
 	}
+	cacheType := strings.ToLower(os.Getenv(ENV_CACHE_TYPE))
+	if cacheType != CacheTypeMemory {
+		cacheType = defaultCacheType
+	}
+	workers := defaultWorkers
+	workersValue := os.Getenv(ENV_WORKERS)
+	if workersValue != "" {
+		i, err := strconv.ParseInt(workersValue, 10, 32)
+		if err == nil && i > 0 {
+			workers = int(i)
+		}
+	}
+	queueSize := defaultQueueSize
+	queueSizeValue := os.Getenv(ENV_QUEUE_SIZE)
+	if queueSizeValue != "" {
+		i, err := strconv.ParseInt(queueSizeValue, 10, 32)
+		if err == nil && i > 0 {
+			queueSize = int(i)
+		}
+	}
+	overrunPolicy := os.Getenv(ENV_OVERRUN_POLICY)
+	if overrunPolicy == "" {
+		overrunPolicy = defaultOverrunPolicy
+	}
+	statsInterval := defaultStatsInterval
+	statsIntervalValue := os.Getenv(ENV_STATS_INTERVAL)
+	if statsIntervalValue != "" {
+		i, err := strconv.ParseInt(statsIntervalValue, 10, 32)
+		if err == nil {
+			statsInterval = helpers.DurationFromSecond(int32(i))
+		}
+	}
+	syncInterval := defaultSyncInterval
+	syncIntervalValue := os.Getenv(ENV_SYNC_INTERVAL)
+	if syncIntervalValue != "" {
+		i, err := strconv.ParseInt(syncIntervalValue, 10, 32)
+		if err == nil && i >= 0 {
+			syncInterval = helpers.DurationFromSecond(int32(i))
+		}
+	}
 	return &cfg{
-		clientAddress:   os.Getenv(ENV_STORAGE_HOST),
-		timeout:         timeoutStorage,
-		port:            port,
-		mongoURI:        os.Getenv(ENV_MONGO_URI),
-		mongoDb:         mongoDb,
-		mongoCollection: collection,
-		cacheAddr:       os.Getenv(ENV_CACHE_ADDR),
-		cachePassword:   os.Getenv(ENV_CACHE_PASSWORD),
-		cacheDB:         cacheDB,
+		clientAddress:         os.Getenv(ENV_STORAGE_HOST),
+		timeout:               timeoutStorage,
+		port:                  port,
+		mongoURI:              os.Getenv(ENV_MONGO_URI),
+		mongoDb:               mongoDb,
+		mongoCollection:       collection,
+		maintenanceCollection: maintenanceCollection,
+		cacheAddr:             os.Getenv(ENV_CACHE_ADDR),
+		cachePassword:         os.Getenv(ENV_CACHE_PASSWORD),
+		cacheDB:               cacheDB,
+		cacheType:             cacheType,
+		cacheFile:             os.Getenv(ENV_CACHE_FILE),
+		workers:               workers,
+		queueSize:             queueSize,
+		overrunPolicy:         overrunPolicy,
+		typeLimits:            parseTypeLimits(os.Getenv(ENV_TYPE_LIMITS)),
+		statsInterval:         statsInterval,
+		syncInterval:          syncInterval,
+		probeToken:            os.Getenv(ENV_PROBE_TOKEN),
+	}
+}
+
+// Parse limits like "SITE_MAP=2,CASSANDRA=5", wrong pairs are ignored
+func parseTypeLimits(value string) map[apiPb.SchedulerType]int {
+	limits := map[apiPb.SchedulerType]int{}
+	for _, pair := range strings.Split(value, ",") {
+		kv := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		schedulerType, ok := apiPb.SchedulerType_value[strings.ToUpper(strings.TrimSpace(kv[0]))]
+		if !ok {
+			continue
+		}
+		i, err := strconv.ParseInt(strings.TrimSpace(kv[1]), 10, 32)
+		if err != nil || i < 1 {
+			continue
+		}
+		limits[apiPb.SchedulerType(schedulerType)] = int(i)
 	}
+	return limits
 }
@@ -1,6 +1,7 @@
 package config
 
 import (
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"github.com/stretchr/testify/assert"
 	"os"
 	"testing"
@@ -16,9 +17,19 @@ func TestNew(t *testing.T) {
 		assert.Equal(t, s.GetMongoDb(), defaultMongoDb)
 		assert.Equal(t, s.GetStorageTimeout(), defaultStorageTimeout)
 		assert.Equal(t, s.GetMongoCollection(), defaultCollection)
+		assert.Equal(t, s.GetMongoMaintenanceCollection(), defaultMaintenanceCollection)
 		assert.Equal(t, s.GetCacheAddr(), "")
 		assert.Equal(t, s.GetCachePassword(), "")
 		assert.Equal(t, s.GetCacheDB(), int32(0))
+		assert.Equal(t, s.GetCacheType(), CacheTypeRedis)
+		assert.Equal(t, s.GetCacheFile(), "")
+		assert.Equal(t, s.GetWorkers(), defaultWorkers)
+		assert.Equal(t, s.GetQueueSize(), defaultQueueSize)
+		assert.Equal(t, s.GetOverrunPolicy(), defaultOverrunPolicy)
+		assert.Equal(t, s.GetTypeLimits(), map[apiPb.SchedulerType]int{})
+		assert.Equal(t, s.GetStatsInterval(), defaultStatsInterval)
+		assert.Equal(t, s.GetSyncInterval(), defaultSyncInterval)
+		assert.Equal(t, s.GetProbeToken(), "")
 
 	})
 }
@@ -50,6 +61,14 @@ func TestCfg_GetMongoCollection(t *testing.T) {
 	})
 }
 
+func TestCfg_GetMongoMaintenanceCollection(t *testing.T) {
+	t.Run("Should: return from env", func(t *testing.T) {
+		os.Setenv(ENV_MONGO_MAINTENANCE_COLLECTION, "windows")
+		s := New()
+		assert.Equal(t, s.GetMongoMaintenanceCollection(), "windows")
+	})
+}
+
 func TestCfg_GetMongoDb(t *testing.T) {
 	t.Run("Should: return from env", func(t *testing.T) {
 		os.Setenv(ENV_MONGO_DB, "11124")
@@ -89,3 +108,77 @@ func TestCfg_GetCacheDB(t *testing.T) {
 		assert.Equal(t, s.GetCacheDB(), int32(11124))
 	})
 }
+
+func TestCfg_GetWorkers(t *testing.T) {
+	t.Run("Should: return from env", func(t *testing.T) {
+		os.Setenv(ENV_WORKERS, "15")
+		s := New()
+		assert.Equal(t, s.GetWorkers(), 15)
+	})
+}
+
+func TestCfg_GetQueueSize(t *testing.T) {
+	t.Run("Should: return from env", func(t *testing.T) {
+		os.Setenv(ENV_QUEUE_SIZE, "15")
+		s := New()
+		assert.Equal(t, s.GetQueueSize(), 15)
+	})
+}
+
+func TestCfg_GetOverrunPolicy(t *testing.T) {
+	t.Run("Should: return from env", func(t *testing.T) {
+		os.Setenv(ENV_OVERRUN_POLICY, "queue")
+		s := New()
+		assert.Equal(t, s.GetOverrunPolicy(), "queue")
+	})
+}
+
+func TestCfg_GetStatsInterval(t *testing.T) {
+	t.Run("Should: return from env", func(t *testing.T) {
+		os.Setenv(ENV_STATS_INTERVAL, "15")
+		s := New()
+		assert.Equal(t, s.GetStatsInterval(), time.Second*15)
+	})
+}
+
+func TestCfg_GetCacheType(t *testing.T) {
+	t.Run("Should: return from env", func(t *testing.T) {
+		os.Setenv(ENV_CACHE_TYPE, "Memory")
+		os.Setenv(ENV_CACHE_FILE, "/var/lib/squzy/schedules.json")
+		s := New()
+		assert.Equal(t, s.GetCacheType(), CacheTypeMemory)
+		assert.Equal(t, s.GetCacheFile(), "/var/lib/squzy/schedules.json")
+	})
+	t.Run("Should: return redis for unknown type", func(t *testing.T) {
+		os.Setenv(ENV_CACHE_TYPE, "memcached")
+		s := New()
+		assert.Equal(t, s.GetCacheType(), CacheTypeRedis)
+	})
+}
+
+func TestCfg_GetSyncInterval(t *testing.T) {
+	t.Run("Should: return from env", func(t *testing.T) {
+		os.Setenv(ENV_SYNC_INTERVAL, "0")
+		s := New()
+		assert.Equal(t, s.GetSyncInterval(), time.Duration(0))
+	})
+}
+
+func TestCfg_GetProbeToken(t *testing.T) {
+	t.Run("Should: return from env", func(t *testing.T) {
+		os.Setenv(ENV_PROBE_TOKEN, "token")
+		s := New()
+		assert.Equal(t, s.GetProbeToken(), "token")
+	})
+}
+
+func TestCfg_GetTypeLimits(t *testing.T) {
+	t.Run("Should: return from env and ignore wrong pairs", func(t *testing.T) {
+		os.Setenv(ENV_TYPE_LIMITS, "SITE_MAP=2, cassandra=5,UNKNOWN=1,HTTP=abc,TCP")
+		s := New()
+		assert.Equal(t, s.GetTypeLimits(), map[apiPb.SchedulerType]int{
+			apiPb.SchedulerType_SITE_MAP:  2,
+			apiPb.SchedulerType_CASSANDRA: 5,
+		})
+	})
+}
@@ -14,7 +14,9 @@ import (
 	"github.com/squzy/squzy/internal/job"
 	job_executor "github.com/squzy/squzy/internal/job-executor"
 	"github.com/squzy/squzy/internal/logger"
+	"github.com/squzy/squzy/internal/maintenance"
 	"github.com/squzy/squzy/internal/parsers"
+	"github.com/squzy/squzy/internal/probe"
 	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
 	scheduler_storage "github.com/squzy/squzy/internal/scheduler-storage"
 	"github.com/squzy/squzy/internal/semaphore"
@@ -23,31 +25,60 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/grpc"
+	"io"
+	"os/signal"
+	"syscall"
 	"time"
 )
 
 const (
 	day = time.Hour * 24
+	// How often maintenance windows are reloaded from mongo
+	maintenanceRefresh = time.Second * 10
 )
 
 func main() {
+	err := run()
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+}
+
+// Everything is stopped by defers in reverse order after SIGTERM, memory cache is flushed last
+func run() error {
 	cfg := config.New()
+	stopCtx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer stop()
 	ctx, cancel := helpers.TimeoutContext(context.Background(), 0)
 	defer cancel()
-	cache := getCache(cfg)
+	cache, err := getCache(cfg)
+	if err != nil {
+		return err
+	}
+	if closer, ok := cache.(io.Closer); ok {
+		defer func() {
+			errClose := closer.Close()
+			if errClose != nil {
+				logger.Error(errClose.Error())
+			}
+		}()
+	}
 
 	client, err := mongo.Connect(ctx, options.Client().ApplyURI(cfg.GetMongoURI()))
 	if err != nil {
-		logger.Fatal(err.Error())
+		return err
 	}
 	err = client.Ping(ctx, nil)
 	if err != nil {
-		logger.Fatal(err.Error())
+		return err
 	}
 	defer func() {
 		_ = client.Disconnect(context.Background())
 	}()
 	connector := mongo_helper.New(client.Database(cfg.GetMongoDb()).Collection(cfg.GetMongoCollection()))
+	maintenanceStorage := maintenance.New(
+		mongo_helper.New(client.Database(cfg.GetMongoDb()).Collection(cfg.GetMongoMaintenanceCollection())),
+	)
 	httpPackage := httptools.New(version.GetVersion())
 	grpcTool := grpctools.New()
 	externalStorage := storage.NewExternalStorage(
@@ -63,6 +94,7 @@ func main() {
 		parsers.NewSiteMapParser(),
 	)
 	configStorage := scheduler_config_storage.New(connector)
+	probes := probe.New(cfg.GetProbeToken())
 	jobExecutor := job_executor.NewExecutor(
 		externalStorage,
 		siteMapStorage,
@@ -79,17 +111,41 @@ func main() {
 		job.ExecMongo,
 		job.ExecMysql,
 		job.ExecPostgres,
+		job.ExecDNS,
+		job.ExecUDP,
+		job.ExecRedis,
+		job.ExecHTTPScenario,
+		maintenance.NewChecker(maintenanceStorage, maintenanceRefresh),
+		probes,
 	)
+	pool := job_executor.NewPool(jobExecutor, &job_executor.PoolOptions{
+		Workers:       cfg.GetWorkers(),
+		QueueSize:     cfg.GetQueueSize(),
+		TypeLimits:    cfg.GetTypeLimits(),
+		Overrun:       job_executor.OverrunPolicy(cfg.GetOverrunPolicy()),
+		StatsInterval: cfg.GetStatsInterval(),
+	})
+	// Waits for running checks after app is stopped, before mongo is disconnected
+	defer pool.Stop()
 	app := application.New(
 		scheduler_storage.New(),
-		jobExecutor,
+		pool,
 		configStorage,
 		cache,
+		maintenanceStorage,
+		jobExecutor,
+		probes,
+		cfg.GetSyncInterval(),
 	)
-	logger.Fatal(app.Run(cfg.GetPort()).Error())
+	return app.Run(stopCtx, cfg.GetPort())
 }
 
-func getCache(cfg config.Config) cache.Cache {
+func getCache(cfg config.Config) (cache.Cache, error) {
+	if cfg.GetCacheType() == config.CacheTypeMemory {
+		// Changed schedules are flushed to file every second and on close
+		return cache.NewMemory(cfg.GetCacheFile())
+	}
+
 	var rdb *redis.Client
 
 	rdb = redis.NewClient(&redis.Options{
@@ -98,10 +154,5 @@ func getCache(cfg config.Config) cache.Cache {
 		DB:       int(cfg.GetCacheDB()),
 	})
 
-	c, err := cache.New(rdb)
-	if err != nil {
-		logger.Fatal(err.Error())
-	}
-
-	return c
+	return cache.New(rdb)
 }
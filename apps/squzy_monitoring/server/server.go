@@ -2,34 +2,78 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
-	"github.com/squzy/squzy/internal/cache"
+	"fmt"
+	"github.com/squzy/squzy/internal/flapping"
 	"github.com/squzy/squzy/internal/helpers"
 	job_executor "github.com/squzy/squzy/internal/job-executor"
+	"github.com/squzy/squzy/internal/labels"
+	"github.com/squzy/squzy/internal/maintenance"
+	"github.com/squzy/squzy/internal/probe"
 	"github.com/squzy/squzy/internal/scheduler"
 	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	scheduler_document "github.com/squzy/squzy/internal/scheduler-document"
 	scheduler_storage "github.com/squzy/squzy/internal/scheduler-storage"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"golang.org/x/sync/errgroup"
-	empty "google.golang.org/protobuf/types/known/emptypb"
+	"regexp"
+	"sort"
 )
 
 var (
-	errInvalidTypeError = errors.New("invalid type of config")
+	errInvalidTypeError   = errors.New("invalid type of config")
+	errInvalidActionError = errors.New("invalid bulk action")
+	errEmptySelectorError = errors.New("selector is required for bulk action")
+	errInvalidRetryPolicy = errors.New("invalid retry policy")
+	errDependencyCycle    = errors.New("scheduler could not depend on itself")
+	errParentNotFound     = errors.New("parent scheduler not found")
+	errInvalidLocations   = errors.New("invalid locations")
+	errEmptyLocation      = errors.New("location is required")
+	errInvalidRecovery    = errors.New("invalid failure interval")
+	errInvalidFlapping    = errors.New("invalid flap detection")
+	errInvalidWarning     = errors.New("invalid warning threshold")
+	errInvalidDNS         = errors.New("invalid dns config")
+	errInvalidExchange    = errors.New("invalid send/expect config")
+	errInvalidRedis       = errors.New("invalid redis config")
+	errInvalidAssertion   = errors.New("invalid http assertion")
+	errInvalidHTTPAuth    = errors.New("invalid http auth")
+	errInvalidHTTPTLS     = errors.New("invalid http tls config")
+	errInvalidScenario    = errors.New("invalid http scenario")
+	errInvalidSelector    = errors.New("invalid selector assertion")
+
+	// Placeholders of request body, see httptools.CreateRequest
+	reservedVariables = map[string]bool{
+		"timestamp":    true,
+		"timestamp_ms": true,
+		"uuid":         true,
+	}
+)
+
+const (
+	// Retries run inside one scheduler tick, so count should stay small
+	maxRetryAttempts = 10
 )
 
 type server struct {
 	apiPb.UnimplementedCacheServer
 	apiPb.UnimplementedSchedulersExecutorServer
-	schedulerStorage scheduler_storage.SchedulerStorage
-	jobExecutor      job_executor.JobExecutor
-	configStorage    scheduler_config_storage.Storage
-	cache            cache.Cache
+	schedulerStorage   scheduler_storage.SchedulerStorage
+	dispatcher         scheduler.Dispatcher
+	configStorage      scheduler_config_storage.Storage
+	maintenanceStorage maintenance.Storage
+	tester             job_executor.Tester
+	probes             probe.Hub
 }
 
-func (s *server) GetSchedulerList(ctx context.Context, rq *empty.Empty) (*apiPb.GetSchedulerListResponse, error) {
-	list, err := s.configStorage.GetAll(ctx)
+func (s *server) GetSchedulerList(ctx context.Context, rq *apiPb.GetSchedulerListRequest) (*apiPb.GetSchedulerListResponse, error) {
+	selector, err := labels.Parse(rq.GetSelector())
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.configStorage.GetBySelector(ctx, selector)
 	if err != nil {
 		return nil, err
 	}
@@ -69,174 +113,157 @@ func (s *server) GetSchedulerById(ctx context.Context, rq *apiPb.GetSchedulerByI
 	if err != nil {
 		return nil, err
 	}
+	config = config.Redacted()
+	res := &apiPb.Scheduler{
+		Id:                 id,
+		Name:               config.Name,
+		Type:               config.Type,
+		Status:             config.Status,
+		Interval:           config.Interval,
+		Cron:               config.Cron,
+		Labels:             config.Labels,
+		Timeout:            config.Timeout,
+		RetryPolicy:        helpers.RetryPolicyToProto(config.RetryPolicy),
+		ParentIds:          parentIDsToProto(config.ParentIDs),
+		Locations:          config.Locations,
+		MinFailedLocations: config.MinFailedLocations,
+		FailureInterval:    config.FailureInterval,
+		RecoverAfter:       config.RecoverAfter,
+		FlapDetection:      helpers.FlapDetectionToProto(config.FlapDetection),
+		StateChange:        stateChange(config),
+		Flapping:           isFlapping(config),
+	}
 	switch config.Type {
 	case apiPb.SchedulerType_TCP:
-		return &apiPb.Scheduler{
-			Id:       id,
-			Name:     config.Name,
-			Type:     apiPb.SchedulerType_TCP,
-			Status:   config.Status,
-			Interval: config.Interval,
-			Timeout:  config.Timeout,
-			Config: &apiPb.Scheduler_Tcp{
-				Tcp: &apiPb.TcpConfig{
-					Host: config.TCPConfig.Host,
-					Port: config.TCPConfig.Port,
-				},
-			},
-		}, nil
+		res.Config = &apiPb.Scheduler_Tcp{
+			Tcp: tcpConfigToProto(config.TCPConfig),
+		}
 	case apiPb.SchedulerType_GRPC:
-		return &apiPb.Scheduler{
-			Id:       id,
-			Name:     config.Name,
-			Type:     apiPb.SchedulerType_GRPC,
-			Status:   config.Status,
-			Interval: config.Interval,
-			Timeout:  config.Timeout,
-			Config: &apiPb.Scheduler_Grpc{
-				Grpc: &apiPb.GrpcConfig{
-					Service: config.GrpcConfig.Service,
-					Host:    config.GrpcConfig.Host,
-					Port:    config.GrpcConfig.Port,
-				},
+		res.Config = &apiPb.Scheduler_Grpc{
+			Grpc: &apiPb.GrpcConfig{
+				Service:     config.GrpcConfig.Service,
+				Host:        config.GrpcConfig.Host,
+				Port:        config.GrpcConfig.Port,
+				WarningTime: config.GrpcConfig.WarningTime,
 			},
-		}, nil
+		}
 	case apiPb.SchedulerType_HTTP:
-		return &apiPb.Scheduler{
-			Id:       id,
-			Name:     config.Name,
-			Type:     apiPb.SchedulerType_HTTP,
-			Status:   config.Status,
-			Interval: config.Interval,
-			Timeout:  config.Timeout,
-			Config: &apiPb.Scheduler_Http{
-				Http: &apiPb.HttpConfig{
-					Method:     config.HTTPConfig.Method,
-					Url:        config.HTTPConfig.URL,
-					Headers:    config.HTTPConfig.Headers,
-					StatusCode: config.HTTPConfig.StatusCode,
-				},
+		res.Config = &apiPb.Scheduler_Http{
+			Http: &apiPb.HttpConfig{
+				Method:      config.HTTPConfig.Method,
+				Url:         config.HTTPConfig.URL,
+				Headers:     config.HTTPConfig.Headers,
+				StatusCode:  config.HTTPConfig.StatusCode,
+				WarningTime: config.HTTPConfig.WarningTime,
+				Assertions:  helpers.HTTPAssertionsToProto(config.HTTPConfig.Assertions),
+				Body:        helpers.HTTPBodyToProto(config.HTTPConfig.Body),
+				Auth:        helpers.HTTPAuthToProto(config.HTTPConfig.Auth),
+				Tls:         helpers.HTTPTLSToProto(config.HTTPConfig.TLS),
 			},
-		}, nil
+		}
 	case apiPb.SchedulerType_SITE_MAP:
-		return &apiPb.Scheduler{
-			Id:       id,
-			Name:     config.Name,
-			Type:     apiPb.SchedulerType_SITE_MAP,
-			Status:   config.Status,
-			Interval: config.Interval,
-			Timeout:  config.Timeout,
-			Config: &apiPb.Scheduler_Sitemap{
-				Sitemap: &apiPb.SiteMapConfig{
-					Url:         config.SiteMapConfig.URL,
-					Concurrency: config.SiteMapConfig.Concurrency,
-				},
+		res.Config = &apiPb.Scheduler_Sitemap{
+			Sitemap: &apiPb.SiteMapConfig{
+				Url:         config.SiteMapConfig.URL,
+				Concurrency: config.SiteMapConfig.Concurrency,
 			},
-		}, nil
+		}
 	case apiPb.SchedulerType_SSL_EXPIRATION:
-		return &apiPb.Scheduler{
-			Id:       id,
-			Name:     config.Name,
-			Type:     apiPb.SchedulerType_SSL_EXPIRATION,
-			Status:   config.Status,
-			Interval: config.Interval,
-			Timeout:  config.Timeout,
-			Config: &apiPb.Scheduler_SslExpiration{
-				SslExpiration: &apiPb.SslExpirationConfig{
-					Host: config.SslExpirationConfig.Host,
-					Port: config.SslExpirationConfig.Port,
-				},
+		res.Config = &apiPb.Scheduler_SslExpiration{
+			SslExpiration: &apiPb.SslExpirationConfig{
+				Host:        config.SslExpirationConfig.Host,
+				Port:        config.SslExpirationConfig.Port,
+				WarningDays: config.SslExpirationConfig.WarningDays,
 			},
-		}, nil
+		}
 	case apiPb.SchedulerType_HTTP_JSON_VALUE:
-		return &apiPb.Scheduler{
-			Id:       id,
-			Name:     config.Name,
-			Type:     apiPb.SchedulerType_HTTP_JSON_VALUE,
-			Status:   config.Status,
-			Interval: config.Interval,
-			Timeout:  config.Timeout,
-			Config: &apiPb.Scheduler_HttpValue{
-				HttpValue: &apiPb.HttpJsonValueConfig{
-					Method:    config.HTTPValueConfig.Method,
-					Url:       config.HTTPValueConfig.URL,
-					Headers:   config.HTTPValueConfig.Headers,
-					Selectors: helpers.SelectorsToProto(config.HTTPValueConfig.Selectors),
-				},
+		res.Config = &apiPb.Scheduler_HttpValue{
+			HttpValue: &apiPb.HttpJsonValueConfig{
+				Method:      config.HTTPValueConfig.Method,
+				Url:         config.HTTPValueConfig.URL,
+				Headers:     config.HTTPValueConfig.Headers,
+				Selectors:   helpers.SelectorsToProto(config.HTTPValueConfig.Selectors),
+				WarningTime: config.HTTPValueConfig.WarningTime,
+				Body:        helpers.HTTPBodyToProto(config.HTTPValueConfig.Body),
+				Auth:        helpers.HTTPAuthToProto(config.HTTPValueConfig.Auth),
+				Tls:         helpers.HTTPTLSToProto(config.HTTPValueConfig.TLS),
 			},
-		}, nil
+		}
 	case apiPb.SchedulerType_CASSANDRA:
-		return &apiPb.Scheduler{
-			Id:       id,
-			Name:     config.Name,
-			Type:     apiPb.SchedulerType_CASSANDRA,
-			Status:   config.Status,
-			Interval: config.Interval,
-			Timeout:  config.Timeout,
-			Config: &apiPb.Scheduler_Cassandra{
-				Cassandra: &apiPb.DbConfig{
-					Host:     config.Db.Host,
-					Port:     config.Db.Port,
-					User:     config.Db.User,
-					Password: config.Db.Password,
-					DbName:   config.Db.Cluster,
-				},
+		res.Config = &apiPb.Scheduler_Cassandra{
+			Cassandra: &apiPb.DbConfig{
+				Host:     config.Db.Host,
+				Port:     config.Db.Port,
+				User:     config.Db.User,
+				Password: config.Db.Password,
+				DbName:   config.Db.Cluster,
 			},
-		}, nil
+		}
 	case apiPb.SchedulerType_MONGO:
-		return &apiPb.Scheduler{
-			Id:       id,
-			Name:     config.Name,
-			Type:     apiPb.SchedulerType_MONGO,
-			Status:   config.Status,
-			Interval: config.Interval,
-			Timeout:  config.Timeout,
-			Config: &apiPb.Scheduler_Mongo{
-				Mongo: &apiPb.DbConfig{
-					Host: config.Db.Host,
-					Port: config.Db.Port,
-				},
+		res.Config = &apiPb.Scheduler_Mongo{
+			Mongo: &apiPb.DbConfig{
+				Host: config.Db.Host,
+				Port: config.Db.Port,
 			},
-		}, nil
+		}
 	case apiPb.SchedulerType_MYSQL:
-		return &apiPb.Scheduler{
-			Id:       id,
-			Name:     config.Name,
-			Type:     apiPb.SchedulerType_MYSQL,
-			Status:   config.Status,
-			Interval: config.Interval,
-			Timeout:  config.Timeout,
-			Config: &apiPb.Scheduler_Mysql{
-				Mysql: &apiPb.DbConfig{
-					Host:     config.Db.Host,
-					Port:     config.Db.Port,
-					User:     config.Db.User,
-					Password: config.Db.Password,
-					DbName:   config.Db.DbName,
-				},
+		res.Config = &apiPb.Scheduler_Mysql{
+			Mysql: &apiPb.DbConfig{
+				Host:     config.Db.Host,
+				Port:     config.Db.Port,
+				User:     config.Db.User,
+				Password: config.Db.Password,
+				DbName:   config.Db.DbName,
 			},
-		}, nil
+		}
 	case apiPb.SchedulerType_POSTGRES:
-		return &apiPb.Scheduler{
-			Id:       id,
-			Name:     config.Name,
-			Type:     apiPb.SchedulerType_POSTGRES,
-			Status:   config.Status,
-			Interval: config.Interval,
-			Timeout:  config.Timeout,
-			Config: &apiPb.Scheduler_Postgres{
-				Postgres: &apiPb.DbConfig{
-					Host:     config.Db.Host,
-					Port:     config.Db.Port,
-					User:     config.Db.User,
-					Password: config.Db.Password,
-					DbName:   config.Db.DbName,
-				},
+		res.Config = &apiPb.Scheduler_Postgres{
+			Postgres: &apiPb.DbConfig{
+				Host:     config.Db.Host,
+				Port:     config.Db.Port,
+				User:     config.Db.User,
+				Password: config.Db.Password,
+				DbName:   config.Db.DbName,
+			},
+		}
+	case apiPb.SchedulerType_DNS:
+		res.Config = &apiPb.Scheduler_Dns{
+			Dns: &apiPb.DnsConfig{
+				Resolver:   config.DNSConfig.Resolver,
+				Name:       config.DNSConfig.Name,
+				RecordType: config.DNSConfig.RecordType,
+				Expected:   config.DNSConfig.Expected,
+				MaxTtl:     config.DNSConfig.MaxTTL,
 			},
-		}, nil
+		}
+	case apiPb.SchedulerType_UDP:
+		res.Config = &apiPb.Scheduler_Udp{
+			Udp: tcpConfigToProto(config.UDPConfig),
+		}
+	case apiPb.SchedulerType_REDIS:
+		res.Config = &apiPb.Scheduler_Redis{
+			Redis: &apiPb.RedisConfig{
+				Host:                config.RedisConfig.Host,
+				Port:                config.RedisConfig.Port,
+				User:                config.RedisConfig.User,
+				Password:            config.RedisConfig.Password,
+				Db:                  config.RedisConfig.Db,
+				Tls:                 config.RedisConfig.TLS,
+				InsecureSkipVerify:  config.RedisConfig.InsecureSkipVerify,
+				MaxUsedMemory:       config.RedisConfig.MaxUsedMemory,
+				MaxConnectedClients: config.RedisConfig.MaxConnectedClients,
+				MaxKeys:             config.RedisConfig.MaxKeys,
+				MasterLinkUp:        config.RedisConfig.MasterLinkUp,
+			},
+		}
+	case apiPb.SchedulerType_HTTP_SCENARIO:
+		res.Config = &apiPb.Scheduler_HttpScenario{
+			HttpScenario: helpers.HTTPScenarioToProto(config.HTTPScenarioConfig),
+		}
 	default:
 		return nil, errInvalidTypeError
 	}
+	return res, nil
 }
 
 func (s *server) Remove(ctx context.Context, rq *apiPb.RemoveRequest) (*apiPb.RemoveResponse, error) {
@@ -301,39 +328,334 @@ func (s *server) Stop(ctx context.Context, rq *apiPb.StopRequest) (*apiPb.StopRe
 	}, nil
 }
 
+func (s *server) Update(ctx context.Context, rq *apiPb.UpdateRequest) (*apiPb.UpdateResponse, error) {
+	id := rq.Id
+	idBson, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+	if rq.Scheduler == nil {
+		return nil, errInvalidTypeError
+	}
+	schedulerConfig, err := newConfig(idBson, rq.Scheduler)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := s.configStorage.Get(ctx, idBson)
+	if err != nil {
+		return nil, err
+	}
+	err = keepSecrets(schedulerConfig, stored)
+	if err != nil {
+		return nil, err
+	}
+	err = s.validateParents(ctx, schedulerConfig)
+	if err != nil {
+		return nil, err
+	}
+	// Validate interval or cron before config will be saved
+	schld, err := scheduler.NewFromConfig(schedulerConfig, s.dispatcher)
+	if err != nil {
+		return nil, err
+	}
+	old, err := s.schedulerStorage.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.configStorage.Update(ctx, schedulerConfig)
+	if err != nil {
+		return nil, err
+	}
+	isRun := old.IsRun()
+	err = s.schedulerStorage.Remove(id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.schedulerStorage.Set(schld)
+	if err != nil {
+		return nil, err
+	}
+	if isRun {
+		err = schld.Run()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &apiPb.UpdateResponse{
+		Id: id,
+	}, nil
+}
+
+func (s *server) BulkAction(ctx context.Context, rq *apiPb.BulkActionRequest) (*apiPb.BulkActionResponse, error) {
+	selector, err := labels.Parse(rq.Selector)
+	if err != nil {
+		return nil, err
+	}
+	if len(selector) == 0 {
+		return nil, errEmptySelectorError
+	}
+	var action func(id string) error
+	switch rq.Action {
+	case apiPb.BulkActionRequest_RUN:
+		action = func(id string) error {
+			_, errA := s.Run(ctx, &apiPb.RunRequest{Id: id})
+			return errA
+		}
+	case apiPb.BulkActionRequest_STOP:
+		action = func(id string) error {
+			_, errA := s.Stop(ctx, &apiPb.StopRequest{Id: id})
+			return errA
+		}
+	case apiPb.BulkActionRequest_REMOVE:
+		action = func(id string) error {
+			_, errA := s.Remove(ctx, &apiPb.RemoveRequest{Id: id})
+			return errA
+		}
+	default:
+		return nil, errInvalidActionError
+	}
+	configs, err := s.configStorage.GetBySelector(ctx, selector)
+	if err != nil {
+		return nil, err
+	}
+	res := &apiPb.BulkActionResponse{
+		Ids:    []string{},
+		Errors: map[string]string{},
+	}
+	// One broken scheduler should not block action for others
+	for _, config := range configs {
+		if config.Status == apiPb.SchedulerStatus_REMOVED {
+			continue
+		}
+		id := config.ID.Hex()
+		if errA := action(id); errA != nil {
+			res.Errors[id] = errA.Error()
+			continue
+		}
+		res.Ids = append(res.Ids, id)
+	}
+	return res, nil
+}
+
 func (s *server) Add(ctx context.Context, rq *apiPb.AddRequest) (*apiPb.AddResponse, error) {
-	interval := helpers.DurationFromSecond(rq.Interval)
-	schld, err := scheduler.New(
-		primitive.NewObjectID(),
-		interval,
-		s.jobExecutor,
-		s.cache,
-	)
+	schedulerConfig, err := newConfig(primitive.NewObjectID(), rq)
+	if err != nil {
+		return nil, err
+	}
+	err = keepSecrets(schedulerConfig, nil)
+	if err != nil {
+		return nil, err
+	}
+	schld, err := scheduler.NewFromConfig(schedulerConfig, s.dispatcher)
+	if err != nil {
+		return nil, err
+	}
+	err = s.validateParents(ctx, schedulerConfig)
+	if err != nil {
+		return nil, err
+	}
+	err = s.configStorage.Add(ctx, schedulerConfig)
+	if err != nil {
+		return nil, err
+	}
+	err = s.schedulerStorage.Set(schld)
+	if err != nil {
+		return nil, err
+	}
+	return &apiPb.AddResponse{
+		Id: schld.GetID(),
+	}, nil
+}
+
+func (s *server) ExportSchedulers(ctx context.Context, rq *apiPb.ExportSchedulersRequest) (*apiPb.ExportSchedulersResponse, error) {
+	configs, err := s.currentConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	doc := &scheduler_document.Document{
+		Checks: make([]*scheduler_document.Check, len(configs)),
+	}
+	for i, config := range configs {
+		doc.Checks[i] = scheduler_document.FromConfig(config.Redacted())
+	}
+	sort.SliceStable(doc.Checks, func(i, j int) bool {
+		return doc.Checks[i].Name < doc.Checks[j].Name
+	})
+	data, err := scheduler_document.Marshal(doc, rq.Format)
+	if err != nil {
+		return nil, err
+	}
+	return &apiPb.ExportSchedulersResponse{
+		Document: data,
+	}, nil
+}
+
+func (s *server) ApplySchedulers(ctx context.Context, rq *apiPb.ApplySchedulersRequest) (*apiPb.ApplySchedulersResponse, error) {
+	doc, err := scheduler_document.Unmarshal(rq.Document, rq.Format)
+	if err != nil {
+		return nil, err
+	}
+	configs, err := s.currentConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Exported document has redacted secrets, they are compared with stored ones
+	stored := map[string]*scheduler_config_storage.SchedulerConfig{}
+	current := make([]*scheduler_document.Entry, len(configs))
+	for i, config := range configs {
+		if _, ok := stored[config.Name]; !ok {
+			stored[config.Name] = config
+		}
+		current[i] = &scheduler_document.Entry{
+			ID:    config.ID.Hex(),
+			Check: scheduler_document.FromConfig(config),
+		}
+	}
+	// Whole document should be valid before first change
+	requests := map[string]*apiPb.AddRequest{}
+	desired := make([]*scheduler_document.Check, len(doc.Checks))
+	for i, check := range doc.Checks {
+		addRq, errR := check.ToAddRequest()
+		if errR != nil {
+			return nil, errR
+		}
+		config, errR := newConfig(primitive.NilObjectID, addRq)
+		if errR != nil {
+			return nil, errR
+		}
+		errR = keepSecrets(config, stored[check.Name])
+		if errR != nil {
+			return nil, fmt.Errorf("%w: %s", errR, check.Name)
+		}
+		_, errR = scheduler.NewFromConfig(config, s.dispatcher)
+		if errR != nil {
+			return nil, fmt.Errorf("%w: %s", errR, check.Name)
+		}
+		requests[check.Name] = addRq
+		// Same form as exported check, so equal checks would not produce diff
+		desired[i] = scheduler_document.FromConfig(config)
+	}
+	changes := scheduler_document.Plan(current, desired)
+	res := &apiPb.ApplySchedulersResponse{
+		Changes: make([]*apiPb.SchedulerChange, len(changes)),
+	}
+	for i, change := range changes {
+		res.Changes[i] = &apiPb.SchedulerChange{
+			Action: change.Action,
+			Id:     change.ID,
+			Name:   change.Check.Name,
+			Diff:   change.Diff,
+		}
+	}
+	if rq.DryRun {
+		return res, nil
+	}
+	for i, change := range changes {
+		switch change.Action {
+		case apiPb.SchedulerChange_CREATE:
+			added, errA := s.Add(ctx, requests[change.Check.Name])
+			if errA != nil {
+				return nil, errA
+			}
+			res.Changes[i].Id = added.Id
+		case apiPb.SchedulerChange_UPDATE:
+			_, errA := s.Update(ctx, &apiPb.UpdateRequest{
+				Id:        change.ID,
+				Scheduler: requests[change.Check.Name],
+			})
+			if errA != nil {
+				return nil, errA
+			}
+		case apiPb.SchedulerChange_REMOVE:
+			_, errA := s.Remove(ctx, &apiPb.RemoveRequest{
+				Id: change.ID,
+			})
+			if errA != nil {
+				return nil, errA
+			}
+		}
+	}
+	return res, nil
+}
+
+// All not removed schedulers
+func (s *server) currentConfigs(ctx context.Context) ([]*scheduler_config_storage.SchedulerConfig, error) {
+	configs, err := s.configStorage.GetAll(ctx)
 	if err != nil {
 		return nil, err
 	}
+	res := []*scheduler_config_storage.SchedulerConfig{}
+	for _, config := range configs {
+		if config.Status == apiPb.SchedulerStatus_REMOVED {
+			continue
+		}
+		res = append(res, config)
+	}
+	return res, nil
+}
+
+// Secrets are redacted by GetSchedulerById and ExportSchedulers, stored ones are kept when they come back,
+// stored is nil for new scheduler
+func keepSecrets(config, stored *scheduler_config_storage.SchedulerConfig) error {
+	if stored == nil {
+		stored = &scheduler_config_storage.SchedulerConfig{}
+	}
+	if err := config.KeepSecrets(stored); err != nil {
+		return err
+	}
+	// Client key could be kept, so pair is checked again
+	tlsConfigs := []*scheduler_config_storage.HTTPTLS{}
+	if config.HTTPConfig != nil {
+		tlsConfigs = append(tlsConfigs, config.HTTPConfig.TLS)
+	}
+	if config.HTTPValueConfig != nil {
+		tlsConfigs = append(tlsConfigs, config.HTTPValueConfig.TLS)
+	}
+	if config.HTTPScenarioConfig != nil {
+		tlsConfigs = append(tlsConfigs, config.HTTPScenarioConfig.TLS)
+	}
+	for _, tlsConfig := range tlsConfigs {
+		if tlsConfig == nil || (tlsConfig.ClientCert == "" && tlsConfig.ClientKey == "") {
+			continue
+		}
+		if _, err := tls.X509KeyPair([]byte(tlsConfig.ClientCert), []byte(tlsConfig.ClientKey)); err != nil {
+			return fmt.Errorf("%w: %s", errInvalidHTTPTLS, err.Error())
+		}
+	}
+	return nil
+}
+
+// Config is created with STOPPED status, Update does not touch status
+func newConfig(id primitive.ObjectID, rq *apiPb.AddRequest) (*scheduler_config_storage.SchedulerConfig, error) {
+	if err := labels.Validate(rq.Labels); err != nil {
+		return nil, err
+	}
 	var schedulerConfig *scheduler_config_storage.SchedulerConfig
 	switch config := rq.Config.(type) {
 	case *apiPb.AddRequest_Tcp:
+		if err := validateExchange(config.Tcp, false); err != nil {
+			return nil, err
+		}
 		schedulerConfig = &scheduler_config_storage.SchedulerConfig{
-			ID:       schld.GetIDBson(),
-			Name:     rq.Name,
-			Type:     apiPb.SchedulerType_TCP,
-			Status:   apiPb.SchedulerStatus_STOPPED,
-			Interval: rq.Interval,
-			Timeout:  rq.Timeout,
-			TCPConfig: &scheduler_config_storage.TCPConfig{
-				Host: config.Tcp.Host,
-				Port: config.Tcp.Port,
-			},
+			ID:        id,
+			Name:      rq.Name,
+			Type:      apiPb.SchedulerType_TCP,
+			Status:    apiPb.SchedulerStatus_STOPPED,
+			Interval:  rq.Interval,
+			Cron:      rq.Cron,
+			Labels:    rq.Labels,
+			Timeout:   rq.Timeout,
+			TCPConfig: tcpConfigToDb(config.Tcp),
 		}
 	case *apiPb.AddRequest_Sitemap:
 		schedulerConfig = &scheduler_config_storage.SchedulerConfig{
-			ID:       schld.GetIDBson(),
+			ID:       id,
 			Name:     rq.Name,
 			Type:     apiPb.SchedulerType_SITE_MAP,
 			Status:   apiPb.SchedulerStatus_STOPPED,
 			Interval: rq.Interval,
+			Cron:     rq.Cron,
+			Labels:   rq.Labels,
 			Timeout:  rq.Timeout,
 			SiteMapConfig: &scheduler_config_storage.SiteMapConfig{
 				URL:         config.Sitemap.Url,
@@ -342,68 +664,101 @@ func (s *server) Add(ctx context.Context, rq *apiPb.AddRequest) (*apiPb.AddRespo
 		}
 	case *apiPb.AddRequest_Grpc:
 		schedulerConfig = &scheduler_config_storage.SchedulerConfig{
-			ID:       schld.GetIDBson(),
+			ID:       id,
 			Name:     rq.Name,
 			Type:     apiPb.SchedulerType_GRPC,
 			Status:   apiPb.SchedulerStatus_STOPPED,
 			Interval: rq.Interval,
+			Cron:     rq.Cron,
+			Labels:   rq.Labels,
 			Timeout:  rq.Timeout,
 			GrpcConfig: &scheduler_config_storage.GrpcConfig{
-				Service: config.Grpc.Service,
-				Host:    config.Grpc.Host,
-				Port:    config.Grpc.Port,
+				Service:     config.Grpc.Service,
+				Host:        config.Grpc.Host,
+				Port:        config.Grpc.Port,
+				WarningTime: config.Grpc.WarningTime,
 			},
 		}
 	case *apiPb.AddRequest_Http:
+		if err := validateAssertions(config.Http.Assertions); err != nil {
+			return nil, err
+		}
+		if err := validateHTTPAuth(config.Http.Auth, config.Http.Tls); err != nil {
+			return nil, err
+		}
 		schedulerConfig = &scheduler_config_storage.SchedulerConfig{
-			ID:       schld.GetIDBson(),
+			ID:       id,
 			Name:     rq.Name,
 			Type:     apiPb.SchedulerType_HTTP,
 			Status:   apiPb.SchedulerStatus_STOPPED,
 			Interval: rq.Interval,
+			Cron:     rq.Cron,
+			Labels:   rq.Labels,
 			Timeout:  rq.Timeout,
 			HTTPConfig: &scheduler_config_storage.HTTPConfig{
-				Method:     config.Http.Method,
-				URL:        config.Http.Url,
-				Headers:    config.Http.Headers,
-				StatusCode: config.Http.StatusCode,
+				Method:      config.Http.Method,
+				URL:         config.Http.Url,
+				Headers:     config.Http.Headers,
+				StatusCode:  config.Http.StatusCode,
+				WarningTime: config.Http.WarningTime,
+				Assertions:  helpers.HTTPAssertionsToDb(config.Http.Assertions),
+				Body:        helpers.HTTPBodyToDb(config.Http.Body),
+				Auth:        helpers.HTTPAuthToDb(config.Http.Auth),
+				TLS:         helpers.HTTPTLSToDb(config.Http.Tls),
 			},
 		}
 	case *apiPb.AddRequest_HttpValue:
+		if err := validateSelectors(config.HttpValue.Selectors); err != nil {
+			return nil, err
+		}
+		if err := validateHTTPAuth(config.HttpValue.Auth, config.HttpValue.Tls); err != nil {
+			return nil, err
+		}
 		schedulerConfig = &scheduler_config_storage.SchedulerConfig{
-			ID:       schld.GetIDBson(),
+			ID:       id,
 			Name:     rq.Name,
 			Type:     apiPb.SchedulerType_HTTP_JSON_VALUE,
 			Status:   apiPb.SchedulerStatus_STOPPED,
 			Interval: rq.Interval,
+			Cron:     rq.Cron,
+			Labels:   rq.Labels,
 			Timeout:  rq.Timeout,
 			HTTPValueConfig: &scheduler_config_storage.HTTPValueConfig{
-				Method:    config.HttpValue.Method,
-				URL:       config.HttpValue.Url,
-				Headers:   config.HttpValue.Headers,
-				Selectors: helpers.SelectorsToDb(config.HttpValue.Selectors),
+				Method:      config.HttpValue.Method,
+				URL:         config.HttpValue.Url,
+				Headers:     config.HttpValue.Headers,
+				Selectors:   helpers.SelectorsToDb(config.HttpValue.Selectors),
+				WarningTime: config.HttpValue.WarningTime,
+				Body:        helpers.HTTPBodyToDb(config.HttpValue.Body),
+				Auth:        helpers.HTTPAuthToDb(config.HttpValue.Auth),
+				TLS:         helpers.HTTPTLSToDb(config.HttpValue.Tls),
 			},
 		}
 	case *apiPb.AddRequest_SslExpiration:
 		schedulerConfig = &scheduler_config_storage.SchedulerConfig{
-			ID:       schld.GetIDBson(),
+			ID:       id,
 			Name:     rq.Name,
 			Type:     apiPb.SchedulerType_SSL_EXPIRATION,
 			Status:   apiPb.SchedulerStatus_STOPPED,
 			Interval: rq.Interval,
+			Cron:     rq.Cron,
+			Labels:   rq.Labels,
 			Timeout:  rq.Timeout,
 			SslExpirationConfig: &scheduler_config_storage.SslExpirationConfig{
-				Host: config.SslExpiration.Host,
-				Port: config.SslExpiration.Port,
+				Host:        config.SslExpiration.Host,
+				Port:        config.SslExpiration.Port,
+				WarningDays: config.SslExpiration.WarningDays,
 			},
 		}
 	case *apiPb.AddRequest_Cassandra:
 		schedulerConfig = &scheduler_config_storage.SchedulerConfig{
-			ID:       schld.GetIDBson(),
+			ID:       id,
 			Name:     rq.Name,
 			Type:     apiPb.SchedulerType_CASSANDRA,
 			Status:   apiPb.SchedulerStatus_STOPPED,
 			Interval: rq.Interval,
+			Cron:     rq.Cron,
+			Labels:   rq.Labels,
 			Timeout:  rq.Timeout,
 			Db: &scheduler_config_storage.DbConfig{
 				Host:     config.Cassandra.Host,
@@ -415,11 +770,13 @@ func (s *server) Add(ctx context.Context, rq *apiPb.AddRequest) (*apiPb.AddRespo
 		}
 	case *apiPb.AddRequest_Mongo:
 		schedulerConfig = &scheduler_config_storage.SchedulerConfig{
-			ID:       schld.GetIDBson(),
+			ID:       id,
 			Name:     rq.Name,
 			Type:     apiPb.SchedulerType_MONGO,
 			Status:   apiPb.SchedulerStatus_STOPPED,
 			Interval: rq.Interval,
+			Cron:     rq.Cron,
+			Labels:   rq.Labels,
 			Timeout:  rq.Timeout,
 			Db: &scheduler_config_storage.DbConfig{
 				Host: config.Mongo.Host,
@@ -428,11 +785,13 @@ func (s *server) Add(ctx context.Context, rq *apiPb.AddRequest) (*apiPb.AddRespo
 		}
 	case *apiPb.AddRequest_Mysql:
 		schedulerConfig = &scheduler_config_storage.SchedulerConfig{
-			ID:       schld.GetIDBson(),
+			ID:       id,
 			Name:     rq.Name,
 			Type:     apiPb.SchedulerType_MYSQL,
 			Status:   apiPb.SchedulerStatus_STOPPED,
 			Interval: rq.Interval,
+			Cron:     rq.Cron,
+			Labels:   rq.Labels,
 			Timeout:  rq.Timeout,
 			Db: &scheduler_config_storage.DbConfig{
 				Host:     config.Mysql.Host,
@@ -444,11 +803,13 @@ func (s *server) Add(ctx context.Context, rq *apiPb.AddRequest) (*apiPb.AddRespo
 		}
 	case *apiPb.AddRequest_Postgres:
 		schedulerConfig = &scheduler_config_storage.SchedulerConfig{
-			ID:       schld.GetIDBson(),
+			ID:       id,
 			Name:     rq.Name,
 			Type:     apiPb.SchedulerType_POSTGRES,
 			Status:   apiPb.SchedulerStatus_STOPPED,
 			Interval: rq.Interval,
+			Cron:     rq.Cron,
+			Labels:   rq.Labels,
 			Timeout:  rq.Timeout,
 			Db: &scheduler_config_storage.DbConfig{
 				Host:     config.Postgres.Host,
@@ -458,32 +819,523 @@ func (s *server) Add(ctx context.Context, rq *apiPb.AddRequest) (*apiPb.AddRespo
 				DbName:   config.Postgres.DbName,
 			},
 		}
+	case *apiPb.AddRequest_Dns:
+		if err := validateDNS(config.Dns); err != nil {
+			return nil, err
+		}
+		schedulerConfig = &scheduler_config_storage.SchedulerConfig{
+			ID:       id,
+			Name:     rq.Name,
+			Type:     apiPb.SchedulerType_DNS,
+			Status:   apiPb.SchedulerStatus_STOPPED,
+			Interval: rq.Interval,
+			Cron:     rq.Cron,
+			Labels:   rq.Labels,
+			Timeout:  rq.Timeout,
+			DNSConfig: &scheduler_config_storage.DNSConfig{
+				Resolver:   config.Dns.Resolver,
+				Name:       config.Dns.Name,
+				RecordType: config.Dns.RecordType,
+				Expected:   config.Dns.Expected,
+				MaxTTL:     config.Dns.MaxTtl,
+			},
+		}
+	case *apiPb.AddRequest_Udp:
+		if err := validateExchange(config.Udp, true); err != nil {
+			return nil, err
+		}
+		schedulerConfig = &scheduler_config_storage.SchedulerConfig{
+			ID:        id,
+			Name:      rq.Name,
+			Type:      apiPb.SchedulerType_UDP,
+			Status:    apiPb.SchedulerStatus_STOPPED,
+			Interval:  rq.Interval,
+			Cron:      rq.Cron,
+			Labels:    rq.Labels,
+			Timeout:   rq.Timeout,
+			UDPConfig: tcpConfigToDb(config.Udp),
+		}
+	case *apiPb.AddRequest_Redis:
+		if err := validateRedis(config.Redis); err != nil {
+			return nil, err
+		}
+		schedulerConfig = &scheduler_config_storage.SchedulerConfig{
+			ID:       id,
+			Name:     rq.Name,
+			Type:     apiPb.SchedulerType_REDIS,
+			Status:   apiPb.SchedulerStatus_STOPPED,
+			Interval: rq.Interval,
+			Cron:     rq.Cron,
+			Labels:   rq.Labels,
+			Timeout:  rq.Timeout,
+			RedisConfig: &scheduler_config_storage.RedisConfig{
+				Host:                config.Redis.Host,
+				Port:                config.Redis.Port,
+				User:                config.Redis.User,
+				Password:            config.Redis.Password,
+				Db:                  config.Redis.Db,
+				TLS:                 config.Redis.Tls,
+				InsecureSkipVerify:  config.Redis.InsecureSkipVerify,
+				MaxUsedMemory:       config.Redis.MaxUsedMemory,
+				MaxConnectedClients: config.Redis.MaxConnectedClients,
+				MaxKeys:             config.Redis.MaxKeys,
+				MasterLinkUp:        config.Redis.MasterLinkUp,
+			},
+		}
+	case *apiPb.AddRequest_HttpScenario:
+		if err := validateScenario(config.HttpScenario); err != nil {
+			return nil, err
+		}
+		schedulerConfig = &scheduler_config_storage.SchedulerConfig{
+			ID:                 id,
+			Name:               rq.Name,
+			Type:               apiPb.SchedulerType_HTTP_SCENARIO,
+			Status:             apiPb.SchedulerStatus_STOPPED,
+			Interval:           rq.Interval,
+			Cron:               rq.Cron,
+			Labels:             rq.Labels,
+			Timeout:            rq.Timeout,
+			HTTPScenarioConfig: helpers.HTTPScenarioToDb(config.HttpScenario),
+		}
 	default:
 		return nil, errInvalidTypeError
 	}
-	err = s.configStorage.Add(ctx, schedulerConfig)
+	if err := validateRetryPolicy(rq.RetryPolicy); err != nil {
+		return nil, err
+	}
+	schedulerConfig.RetryPolicy = helpers.RetryPolicyToDb(rq.RetryPolicy)
+	for _, parentID := range rq.ParentIds {
+		parent, err := primitive.ObjectIDFromHex(parentID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errParentNotFound, parentID)
+		}
+		schedulerConfig.ParentIDs = append(schedulerConfig.ParentIDs, parent)
+	}
+	if err := validateLocations(rq.Locations, rq.MinFailedLocations); err != nil {
+		return nil, err
+	}
+	schedulerConfig.Locations = rq.Locations
+	schedulerConfig.MinFailedLocations = rq.MinFailedLocations
+	if rq.FailureInterval < 0 || rq.RecoverAfter < 0 {
+		return nil, errInvalidRecovery
+	}
+	schedulerConfig.FailureInterval = rq.FailureInterval
+	schedulerConfig.RecoverAfter = rq.RecoverAfter
+	if err := validateFlapDetection(rq.FlapDetection); err != nil {
+		return nil, err
+	}
+	schedulerConfig.FlapDetection = helpers.FlapDetectionToDb(rq.FlapDetection)
+	if err := validateWarning(schedulerConfig); err != nil {
+		return nil, err
+	}
+	return schedulerConfig, nil
+}
+
+// Resolver and name are required, record type should be known
+func validateDNS(config *apiPb.DnsConfig) error {
+	if config == nil || config.Resolver == "" || config.Name == "" || config.MaxTtl < 0 {
+		return errInvalidDNS
+	}
+	if config.RecordType == apiPb.DnsConfig_RECORD_TYPE_UNSPECIFIED {
+		return errInvalidDNS
+	}
+	if _, ok := apiPb.DnsConfig_RecordType_name[int32(config.RecordType)]; !ok {
+		return errInvalidDNS
+	}
+	return nil
+}
+
+// UDP check requires payload, only one of expect and expect regex could be set
+func validateExchange(config *apiPb.TcpConfig, udp bool) error {
+	if config == nil {
+		return errInvalidExchange
+	}
+	if udp && config.Send == "" {
+		return errInvalidExchange
+	}
+	if config.ReadTimeout < 0 || (config.Expect != "" && config.ExpectRegex != "") {
+		return errInvalidExchange
+	}
+	if config.ExpectRegex != "" {
+		if _, err := regexp.Compile(config.ExpectRegex); err != nil {
+			return fmt.Errorf("%w: %s", errInvalidExchange, err.Error())
+		}
+	}
+	return nil
+}
+
+// Host is required, thresholds could not be negative
+func validateRedis(config *apiPb.RedisConfig) error {
+	if config == nil || config.Host == "" || config.Port < 0 || config.Db < 0 {
+		return errInvalidRedis
+	}
+	if config.MaxUsedMemory < 0 || config.MaxConnectedClients < 0 || config.MaxKeys < 0 {
+		return errInvalidRedis
+	}
+	return nil
+}
+
+// Regexes should compile, header assertion needs name and only one of equals and regex
+func validateAssertions(assertions *apiPb.HttpAssertions) error {
+	if assertions == nil {
+		return nil
+	}
+	if assertions.MaxResponseTime < 0 {
+		return errInvalidAssertion
+	}
+	if assertions.BodyRegex != "" {
+		if _, err := regexp.Compile(assertions.BodyRegex); err != nil {
+			return fmt.Errorf("%w: %s", errInvalidAssertion, err.Error())
+		}
+	}
+	for _, header := range assertions.Headers {
+		if header.Name == "" || (header.Equals != "" && header.Regex != "") {
+			return errInvalidAssertion
+		}
+		if header.Regex != "" {
+			if _, err := regexp.Compile(header.Regex); err != nil {
+				return fmt.Errorf("%w: %s", errInvalidAssertion, err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+// Range of assertion should not be empty
+func validateSelectors(selectors []*apiPb.HttpJsonValueConfig_Selectors) error {
+	for _, selector := range selectors {
+		assertion := selector.Assertion
+		if assertion == nil {
+			continue
+		}
+		if assertion.GreaterThan != nil && assertion.LessThan != nil && assertion.GreaterThan.Value >= assertion.LessThan.Value {
+			return errInvalidSelector
+		}
+		if assertion.Regex != "" {
+			if _, err := regexp.Compile(assertion.Regex); err != nil {
+				return fmt.Errorf("%w: %s", errInvalidSelector, err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+func validateHTTPAuth(auth *apiPb.HttpAuth, tlsConfig *apiPb.HttpTls) error {
+	if auth != nil {
+		kinds := 0
+		if auth.BasicUser != "" {
+			kinds++
+		}
+		if auth.BearerToken != "" {
+			kinds++
+		}
+		if auth.Oauth2 != nil {
+			kinds++
+			if auth.Oauth2.TokenUrl == "" || auth.Oauth2.ClientId == "" {
+				return errInvalidHTTPAuth
+			}
+		}
+		if kinds != 1 || (auth.BasicPassword != "" && auth.BasicUser == "") {
+			return errInvalidHTTPAuth
+		}
+	}
+	if tlsConfig == nil {
+		return nil
+	}
+	if tlsConfig.CaBundle != "" && !x509.NewCertPool().AppendCertsFromPEM([]byte(tlsConfig.CaBundle)) {
+		return errInvalidHTTPTLS
+	}
+	// Redacted key is replaced by stored one before pair is checked, see keepSecrets
+	if tlsConfig.ClientKey != scheduler_config_storage.RedactedSecret && (tlsConfig.ClientCert != "" || tlsConfig.ClientKey != "") {
+		if _, err := tls.X509KeyPair([]byte(tlsConfig.ClientCert), []byte(tlsConfig.ClientKey)); err != nil {
+			return fmt.Errorf("%w: %s", errInvalidHTTPTLS, err.Error())
+		}
+	}
+	return nil
+}
+
+// Variables should be named, so they could be used by next steps
+func validateScenario(config *apiPb.HttpScenarioConfig) error {
+	if len(config.Steps) == 0 {
+		return errInvalidScenario
+	}
+	for _, step := range config.Steps {
+		if step.StatusCode < 0 {
+			return errInvalidScenario
+		}
+		if err := validateAssertions(step.Assertions); err != nil {
+			return err
+		}
+		for _, extract := range step.Extract {
+			if extract.Name == "" || reservedVariables[extract.Name] || extract.Expression == "" {
+				return errInvalidScenario
+			}
+			switch extract.Source {
+			case apiPb.HttpScenarioStep_Extract_JSON, apiPb.HttpScenarioStep_Extract_HEADER:
+			case apiPb.HttpScenarioStep_Extract_REGEX:
+				if _, err := regexp.Compile(extract.Expression); err != nil {
+					return fmt.Errorf("%w: %s", errInvalidScenario, err.Error())
+				}
+			default:
+				return errInvalidScenario
+			}
+		}
+	}
+	return validateHTTPAuth(config.Auth, config.Tls)
+}
+
+func tcpConfigToDb(config *apiPb.TcpConfig) *scheduler_config_storage.TCPConfig {
+	return &scheduler_config_storage.TCPConfig{
+		Host:        config.Host,
+		Port:        config.Port,
+		WarningTime: config.WarningTime,
+		Send:        config.Send,
+		Expect:      config.Expect,
+		ExpectRegex: config.ExpectRegex,
+		ReadTimeout: config.ReadTimeout,
+	}
+}
+
+func tcpConfigToProto(config *scheduler_config_storage.TCPConfig) *apiPb.TcpConfig {
+	return &apiPb.TcpConfig{
+		Host:        config.Host,
+		Port:        config.Port,
+		WarningTime: config.WarningTime,
+		Send:        config.Send,
+		Expect:      config.Expect,
+		ExpectRegex: config.ExpectRegex,
+		ReadTimeout: config.ReadTimeout,
+	}
+}
+
+// Thresholds could not be negative, range of selector could not be empty
+func validateWarning(config *scheduler_config_storage.SchedulerConfig) error {
+	var warning int32
+	switch {
+	case config.TCPConfig != nil:
+		warning = config.TCPConfig.WarningTime
+	case config.UDPConfig != nil:
+		warning = config.UDPConfig.WarningTime
+	case config.GrpcConfig != nil:
+		warning = config.GrpcConfig.WarningTime
+	case config.HTTPConfig != nil:
+		warning = config.HTTPConfig.WarningTime
+	case config.SslExpirationConfig != nil:
+		warning = config.SslExpirationConfig.WarningDays
+	case config.HTTPScenarioConfig != nil:
+		warning = config.HTTPScenarioConfig.WarningTime
+	case config.HTTPValueConfig != nil:
+		warning = config.HTTPValueConfig.WarningTime
+		for _, selector := range config.HTTPValueConfig.Selectors {
+			if selector.WarningMin != nil && selector.WarningMax != nil && *selector.WarningMin > *selector.WarningMax {
+				return errInvalidWarning
+			}
+		}
+	}
+	if warning < 0 {
+		return errInvalidWarning
+	}
+	return nil
+}
+
+// Locations should be unique, check could not require more failed locations than it has
+func validateLocations(locations []string, minFailed int32) error {
+	seen := map[string]bool{}
+	for _, location := range locations {
+		if location == "" || seen[location] {
+			return errInvalidLocations
+		}
+		seen[location] = true
+	}
+	if minFailed < 0 || int(minFailed) > len(locations) {
+		return errInvalidLocations
+	}
+	return nil
+}
+
+func validateFlapDetection(detection *apiPb.FlapDetection) error {
+	if detection == nil {
+		return nil
+	}
+	if detection.Window < 0 || detection.Threshold < 0 || detection.Threshold > 100 {
+		return errInvalidFlapping
+	}
+	return nil
+}
+
+func stateChange(config *scheduler_config_storage.SchedulerConfig) float64 {
+	if config.FlapDetection == nil {
+		return 0
+	}
+	return flapping.StateChange(config.RecentCodes)
+}
+
+func isFlapping(config *scheduler_config_storage.SchedulerConfig) bool {
+	if config.FlapDetection == nil {
+		return false
+	}
+	return flapping.IsFlapping(config.RecentCodes, config.FlapDetection.Threshold)
+}
+
+func parentIDsToProto(ids []primitive.ObjectID) []string {
+	if len(ids) == 0 {
+		return nil
+	}
+	res := make([]string, len(ids))
+	for i, id := range ids {
+		res[i] = id.Hex()
+	}
+	return res
+}
+
+// Parents are walked up to roots, so scheduler could not depend on itself through chain
+func (s *server) validateParents(ctx context.Context, config *scheduler_config_storage.SchedulerConfig) error {
+	visited := map[primitive.ObjectID]bool{}
+	queue := append([]primitive.ObjectID{}, config.ParentIDs...)
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if current == config.ID {
+			return errDependencyCycle
+		}
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+		parent, err := s.configStorage.Get(ctx, current)
+		if err != nil || parent == nil || parent.Status == apiPb.SchedulerStatus_REMOVED {
+			return fmt.Errorf("%w: %s", errParentNotFound, current.Hex())
+		}
+		queue = append(queue, parent.ParentIDs...)
+	}
+	return nil
+}
+
+func validateRetryPolicy(policy *apiPb.RetryPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	if policy.Attempts < 0 || policy.Attempts > maxRetryAttempts {
+		return errInvalidRetryPolicy
+	}
+	if policy.Backoff < 0 || policy.BackoffMultiplier < 0 {
+		return errInvalidRetryPolicy
+	}
+	return nil
+}
+
+func (s *server) AddMaintenanceWindow(ctx context.Context, rq *apiPb.AddMaintenanceWindowRequest) (*apiPb.AddMaintenanceWindowResponse, error) {
+	window, err := maintenance.FromProto(rq.GetWindow())
 	if err != nil {
 		return nil, err
 	}
-	err = s.schedulerStorage.Set(schld)
+	err = s.maintenanceStorage.Add(ctx, window)
 	if err != nil {
 		return nil, err
 	}
-	return &apiPb.AddResponse{
-		Id: schld.GetID(),
+	return &apiPb.AddMaintenanceWindowResponse{
+		Id: window.ID.Hex(),
+	}, nil
+}
+
+func (s *server) RemoveMaintenanceWindow(ctx context.Context, rq *apiPb.RemoveMaintenanceWindowRequest) (*apiPb.RemoveMaintenanceWindowResponse, error) {
+	id, err := primitive.ObjectIDFromHex(rq.GetId())
+	if err != nil {
+		return nil, err
+	}
+	err = s.maintenanceStorage.Remove(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &apiPb.RemoveMaintenanceWindowResponse{
+		Id: rq.GetId(),
 	}, nil
 }
 
+func (s *server) GetMaintenanceWindowList(ctx context.Context, rq *apiPb.GetMaintenanceWindowListRequest) (*apiPb.GetMaintenanceWindowListResponse, error) {
+	windows, err := s.maintenanceStorage.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*apiPb.MaintenanceWindow, len(windows))
+	for i, window := range windows {
+		res[i] = window.ToProto()
+	}
+	return &apiPb.GetMaintenanceWindowListResponse{
+		Windows: res,
+	}, nil
+}
+
+// Config is not saved, so interval and cron are not required.
+// Check runs locally even with locations, probes could be not connected yet while check is being written
+func (s *server) TestScheduler(ctx context.Context, rq *apiPb.AddRequest) (*apiPb.TestSchedulerResponse, error) {
+	config, err := newConfig(primitive.NewObjectID(), rq)
+	if err != nil {
+		return nil, err
+	}
+	err = keepSecrets(config, nil)
+	if err != nil {
+		return nil, err
+	}
+	res := s.tester.Test(config)
+	if res == nil {
+		return nil, errInvalidTypeError
+	}
+	return &apiPb.TestSchedulerResponse{
+		SchedulerId: res.SchedulerId,
+		Snapshot:    res.Snapshot,
+	}, nil
+}
+
+// Stream is kept open until probe disconnects, tasks not sent because of error are counted as failed by timeout
+func (s *server) ProbeTasks(rq *apiPb.ProbeTasksRequest, stream apiPb.SchedulersExecutor_ProbeTasksServer) error {
+	if err := s.probes.Authorize(stream.Context()); err != nil {
+		return err
+	}
+	if rq.GetLocation() == "" {
+		return errEmptyLocation
+	}
+	tasks, cancel := s.probes.Subscribe(rq.GetLocation())
+	defer cancel()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case task := <-tasks:
+			err := stream.Send(task)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *server) ProbeResult(ctx context.Context, rq *apiPb.ProbeResultRequest) (*apiPb.ProbeResultResponse, error) {
+	err := s.probes.Authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = s.probes.Report(rq)
+	if err != nil {
+		return nil, err
+	}
+	return &apiPb.ProbeResultResponse{}, nil
+}
+
 func New(
 	schedulerStorage scheduler_storage.SchedulerStorage,
-	jobExecutor job_executor.JobExecutor,
+	dispatcher scheduler.Dispatcher,
 	configStorage scheduler_config_storage.Storage,
-	cache cache.Cache,
+	maintenanceStorage maintenance.Storage,
+	tester job_executor.Tester,
+	probes probe.Hub,
 ) apiPb.SchedulersExecutorServer {
 	return &server{
-		schedulerStorage: schedulerStorage,
-		jobExecutor:      jobExecutor,
-		configStorage:    configStorage,
-		cache:            cache,
+		schedulerStorage:   schedulerStorage,
+		dispatcher:         dispatcher,
+		configStorage:      configStorage,
+		maintenanceStorage: maintenanceStorage,
+		tester:             tester,
+		probes:             probes,
 	}
 }
@@ -3,13 +3,21 @@ package server
 import (
 	"context"
 	"errors"
+	"github.com/squzy/squzy/internal/cache"
+	"github.com/squzy/squzy/internal/labels"
+	"github.com/squzy/squzy/internal/maintenance"
+	"github.com/squzy/squzy/internal/probe"
 	"github.com/squzy/squzy/internal/scheduler"
 	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson/primitive"
-	empty "google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	wrappers "google.golang.org/protobuf/types/known/wrapperspb"
 	"testing"
+	"time"
 )
 
 var (
@@ -48,6 +56,10 @@ var (
 			URL:        "",
 			Headers:    nil,
 			StatusCode: 0,
+			Assertions: &scheduler_config_storage.HTTPAssertions{
+				BodyNotContains: []string{"Service unavailable"},
+			},
+			Auth: &scheduler_config_storage.HTTPAuth{BearerToken: "token"},
 		},
 	}
 
@@ -62,6 +74,10 @@ var (
 			URL:       "",
 			Headers:   nil,
 			Selectors: nil,
+			Body: &scheduler_config_storage.HTTPBody{
+				Content:     `{"ts":{{timestamp}}}`,
+				ContentType: "application/json",
+			},
 		},
 	}
 
@@ -154,6 +170,48 @@ var (
 		},
 	}
 
+	successDNSConfig = &scheduler_config_storage.SchedulerConfig{
+		ID:   primitive.NewObjectID(),
+		Type: apiPb.SchedulerType_DNS,
+		DNSConfig: &scheduler_config_storage.DNSConfig{
+			Resolver:   "8.8.8.8",
+			Name:       "squzy.dev",
+			RecordType: apiPb.DnsConfig_A,
+		},
+	}
+
+	successUDPConfig = &scheduler_config_storage.SchedulerConfig{
+		ID:   primitive.NewObjectID(),
+		Type: apiPb.SchedulerType_UDP,
+		UDPConfig: &scheduler_config_storage.TCPConfig{
+			Host:   "localhost",
+			Port:   11211,
+			Send:   "stats",
+			Expect: "END",
+		},
+	}
+
+	successRedisConfig = &scheduler_config_storage.SchedulerConfig{
+		ID:   primitive.NewObjectID(),
+		Type: apiPb.SchedulerType_REDIS,
+		RedisConfig: &scheduler_config_storage.RedisConfig{
+			Host:    "localhost",
+			Port:    6379,
+			MaxKeys: 1000,
+		},
+	}
+
+	successScenarioConfig = &scheduler_config_storage.SchedulerConfig{
+		ID:   primitive.NewObjectID(),
+		Type: apiPb.SchedulerType_HTTP_SCENARIO,
+		HTTPScenarioConfig: &scheduler_config_storage.HTTPScenarioConfig{
+			Steps: []*scheduler_config_storage.HTTPScenarioStep{
+				{Name: "login", Method: "POST", URL: "https://squzy.dev/login"},
+				{Name: "me", Method: "GET", URL: "https://squzy.dev/me"},
+			},
+		},
+	}
+
 	errorConfig = &scheduler_config_storage.SchedulerConfig{
 		ID:       primitive.NewObjectID(),
 		Type:     11111,
@@ -173,6 +231,10 @@ var (
 		successMongoConfig.ID:     successMongoConfig,
 		successMysqlConfig.ID:     successMysqlConfig,
 		successPostgresConfig.ID:  successPostgresConfig,
+		successDNSConfig.ID:       successDNSConfig,
+		successUDPConfig.ID:       successUDPConfig,
+		successRedisConfig.ID:     successRedisConfig,
+		successScenarioConfig.ID:  successScenarioConfig,
 		errorConfig.ID:            errorConfig,
 	}
 
@@ -298,6 +360,62 @@ var (
 				},
 			},
 		},
+		apiPb.SchedulerType_DNS: {
+			Interval: 10,
+			Config: &apiPb.AddRequest_Dns{
+				Dns: &apiPb.DnsConfig{
+					Resolver:   "8.8.8.8",
+					Name:       "squzy.dev",
+					RecordType: apiPb.DnsConfig_A,
+					Expected:   []string{"10.0.0.1"},
+				},
+			},
+		},
+		apiPb.SchedulerType_UDP: {
+			Interval: 10,
+			Config: &apiPb.AddRequest_Udp{
+				Udp: &apiPb.TcpConfig{
+					Host:        "localhost",
+					Port:        11211,
+					Send:        "stats",
+					ExpectRegex: "^STAT",
+				},
+			},
+		},
+		apiPb.SchedulerType_REDIS: {
+			Interval: 10,
+			Config: &apiPb.AddRequest_Redis{
+				Redis: &apiPb.RedisConfig{
+					Host:          "localhost",
+					Password:      "secret",
+					Tls:           true,
+					MaxUsedMemory: 1024,
+				},
+			},
+		},
+		apiPb.SchedulerType_HTTP_SCENARIO: {
+			Interval: 60,
+			Config: &apiPb.AddRequest_HttpScenario{
+				HttpScenario: &apiPb.HttpScenarioConfig{
+					Steps: []*apiPb.HttpScenarioStep{
+						{
+							Name:   "login",
+							Method: "POST",
+							Url:    "https://squzy.dev/login",
+							Extract: []*apiPb.HttpScenarioStep_Extract{
+								{Name: "token", Source: apiPb.HttpScenarioStep_Extract_JSON, Expression: "data.token"},
+							},
+						},
+						{
+							Name:    "me",
+							Method:  "GET",
+							Url:     "https://squzy.dev/me",
+							Headers: map[string]string{"Authorization": "Bearer {{token}}"},
+						},
+					},
+				},
+			},
+		},
 		1000: {
 			Interval: 10,
 			Timeout:  0,
@@ -307,6 +425,7 @@ var (
 
 type schedulerMock struct {
 	schedulerRunErr error
+	isRun           bool
 }
 
 func (s schedulerMock) GetID() string {
@@ -328,18 +447,25 @@ func (s schedulerMock) Stop() {
 }
 
 func (s schedulerMock) IsRun() bool {
-	panic("implement me")
+	return s.isRun
+}
+
+func (s schedulerMock) GetSchedule() string {
+	return ""
 }
 
 type mockStorageOk struct {
 	schedulerRunErr error
+	isRun           bool
+	set             scheduler.Scheduler
 }
 
 func (m mockStorageOk) Get(string) (scheduler.Scheduler, error) {
-	return &schedulerMock{schedulerRunErr: m.schedulerRunErr}, nil
+	return &schedulerMock{schedulerRunErr: m.schedulerRunErr, isRun: m.isRun}, nil
 }
 
-func (m mockStorageOk) Set(scheduler.Scheduler) error {
+func (m *mockStorageOk) Set(schld scheduler.Scheduler) error {
+	m.set = schld
 	return nil
 }
 
@@ -347,6 +473,10 @@ func (m mockStorageOk) Remove(string) error {
 	return nil
 }
 
+func (m mockStorageOk) GetAll() []scheduler.Scheduler {
+	return []scheduler.Scheduler{}
+}
+
 type mockStorageError struct {
 }
 
@@ -362,6 +492,10 @@ func (m mockStorageError) Remove(string) error {
 	return errors.New("")
 }
 
+func (m mockStorageError) GetAll() []scheduler.Scheduler {
+	return nil
+}
+
 type mockConfigStorageOk struct {
 }
 
@@ -397,6 +531,26 @@ func (m mockConfigStorageOk) GetAllForSync(ctx context.Context) ([]*scheduler_co
 	panic("implement me")
 }
 
+func (m mockConfigStorageOk) SetLastCode(ctx context.Context, schedulerID primitive.ObjectID, code apiPb.SchedulerCode) error {
+	return nil
+}
+
+func (m mockConfigStorageOk) SetRecoverLeft(ctx context.Context, schedulerID primitive.ObjectID, left int32) error {
+	return nil
+}
+
+func (m mockConfigStorageOk) PushRecentCode(ctx context.Context, schedulerID primitive.ObjectID, code apiPb.SchedulerCode, window int32) error {
+	return nil
+}
+
+func (m mockConfigStorageOk) GetBySelector(ctx context.Context, selector labels.Selector) ([]*scheduler_config_storage.SchedulerConfig, error) {
+	return m.GetAll(ctx)
+}
+
+func (m mockConfigStorageOk) Update(ctx context.Context, config *scheduler_config_storage.SchedulerConfig) error {
+	return nil
+}
+
 type mockConfigStorageErrorSingle struct {
 }
 
@@ -432,6 +586,26 @@ func (m mockConfigStorageErrorSingle) GetAllForSync(ctx context.Context) ([]*sch
 	panic("implement me")
 }
 
+func (m mockConfigStorageErrorSingle) SetLastCode(ctx context.Context, schedulerID primitive.ObjectID, code apiPb.SchedulerCode) error {
+	return errors.New("")
+}
+
+func (m mockConfigStorageErrorSingle) SetRecoverLeft(ctx context.Context, schedulerID primitive.ObjectID, left int32) error {
+	return errors.New("")
+}
+
+func (m mockConfigStorageErrorSingle) PushRecentCode(ctx context.Context, schedulerID primitive.ObjectID, code apiPb.SchedulerCode, window int32) error {
+	return errors.New("")
+}
+
+func (m mockConfigStorageErrorSingle) GetBySelector(ctx context.Context, selector labels.Selector) ([]*scheduler_config_storage.SchedulerConfig, error) {
+	return m.GetAll(ctx)
+}
+
+func (m mockConfigStorageErrorSingle) Update(ctx context.Context, config *scheduler_config_storage.SchedulerConfig) error {
+	return errors.New("")
+}
+
 type mockConfigStorageError struct {
 }
 
@@ -463,157 +637,219 @@ func (m mockConfigStorageError) GetAllForSync(ctx context.Context) ([]*scheduler
 	panic("implement me")
 }
 
-type mockCacheErr struct {
+func (m mockConfigStorageError) SetLastCode(ctx context.Context, schedulerID primitive.ObjectID, code apiPb.SchedulerCode) error {
+	return errors.New("")
+}
+
+func (m mockConfigStorageError) SetRecoverLeft(ctx context.Context, schedulerID primitive.ObjectID, left int32) error {
+	return errors.New("")
 }
 
-func (m mockCacheErr) InsertSchedule(data *apiPb.InsertScheduleWithIdRequest) error {
-	return errors.New("InsertSchedule")
+func (m mockConfigStorageError) PushRecentCode(ctx context.Context, schedulerID primitive.ObjectID, code apiPb.SchedulerCode, window int32) error {
+	return errors.New("")
 }
 
-func (m mockCacheErr) GetScheduleById(data *apiPb.GetScheduleWithIdRequest) (*apiPb.GetScheduleWithIdResponse, error) {
-	return nil, errors.New("GetScheduleById")
+func (m mockConfigStorageError) GetBySelector(ctx context.Context, selector labels.Selector) ([]*scheduler_config_storage.SchedulerConfig, error) {
+	return m.GetAll(ctx)
 }
 
-func (m mockCacheErr) DeleteScheduleById(data *apiPb.DeleteScheduleWithIdRequest) error {
-	return errors.New("DeleteScheduleById")
+func (m mockConfigStorageError) Update(ctx context.Context, config *scheduler_config_storage.SchedulerConfig) error {
+	panic("implement me")
 }
 
 func TestNew(t *testing.T) {
 	t.Run("Should: implement interface", func(t *testing.T) {
-		s := New(nil, nil, nil, nil)
+		s := New(nil, nil, nil, nil, nil, nil)
 		assert.Implements(t, (*apiPb.SchedulersExecutorServer)(nil), s)
 	})
 }
 
 func TestServer_GetSchedulerList(t *testing.T) {
 	t.Run("Should: return error because DB", func(t *testing.T) {
-		s := New(nil, nil, &mockConfigStorageError{}, nil)
-		_, err := s.GetSchedulerList(context.Background(), &empty.Empty{})
+		s := New(nil, nil, &mockConfigStorageError{}, nil, nil, nil)
+		_, err := s.GetSchedulerList(context.Background(), &apiPb.GetSchedulerListRequest{})
 		assert.NotEqual(t, nil, err)
 	})
 	t.Run("Should: return error because single DB error", func(t *testing.T) {
-		s := New(nil, nil, &mockConfigStorageErrorSingle{}, nil)
-		_, err := s.GetSchedulerList(context.Background(), &empty.Empty{})
+		s := New(nil, nil, &mockConfigStorageErrorSingle{}, nil, nil, nil)
+		_, err := s.GetSchedulerList(context.Background(), &apiPb.GetSchedulerListRequest{})
 		assert.NotEqual(t, nil, err)
 	})
 	t.Run("Should: return without error", func(t *testing.T) {
-		s := New(nil, nil, &mockConfigStorageOk{}, nil)
-		_, err := s.GetSchedulerList(context.Background(), &empty.Empty{})
+		s := New(nil, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		_, err := s.GetSchedulerList(context.Background(), &apiPb.GetSchedulerListRequest{})
+		assert.Equal(t, nil, err)
+	})
+	t.Run("Should: return error because wrong selector", func(t *testing.T) {
+		s := New(nil, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		_, err := s.GetSchedulerList(context.Background(), &apiPb.GetSchedulerListRequest{
+			Selector: "team.name=payments",
+		})
+		assert.NotEqual(t, nil, err)
+	})
+	t.Run("Should: return schedulers by selector", func(t *testing.T) {
+		s := New(nil, nil, &mockConfigStorageDocument{configs: labeledConfigs}, nil, nil, nil)
+		res, err := s.GetSchedulerList(context.Background(), &apiPb.GetSchedulerListRequest{
+			Selector: "team=payments,!deprecated",
+		})
 		assert.Equal(t, nil, err)
+		assert.Len(t, res.Lists, 2)
+		assert.Equal(t, labeledConfigs[0].ID.Hex(), res.Lists[0].Id)
+		assert.Equal(t, map[string]string{"team": "payments"}, res.Lists[0].Labels)
 	})
 }
 
 func TestServer_GetSchedulerById(t *testing.T) {
 	t.Run("Should: return error because DB", func(t *testing.T) {
-		s := New(nil, nil, &mockConfigStorageErrorSingle{}, nil)
+		s := New(nil, nil, &mockConfigStorageErrorSingle{}, nil, nil, nil)
 		_, err := s.GetSchedulerById(context.Background(), &apiPb.GetSchedulerByIdRequest{
 			Id: "",
 		})
 		assert.NotEqual(t, nil, err)
 	})
 	t.Run("Should: return tcp config", func(t *testing.T) {
-		s := New(nil, nil, &mockConfigStorageOk{}, nil)
+		s := New(nil, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.GetSchedulerById(context.Background(), &apiPb.GetSchedulerByIdRequest{
 			Id: successTcpConfig.ID.Hex(),
 		})
 		assert.Equal(t, nil, err)
 	})
 	t.Run("Should: return ssl config", func(t *testing.T) {
-		s := New(nil, nil, &mockConfigStorageOk{}, nil)
+		s := New(nil, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.GetSchedulerById(context.Background(), &apiPb.GetSchedulerByIdRequest{
 			Id: successSSLConfig.ID.Hex(),
 		})
 		assert.Equal(t, nil, err)
 	})
 	t.Run("Should: return grpc config", func(t *testing.T) {
-		s := New(nil, nil, &mockConfigStorageOk{}, nil)
+		s := New(nil, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.GetSchedulerById(context.Background(), &apiPb.GetSchedulerByIdRequest{
 			Id: successGrpcConfig.ID.Hex(),
 		})
 		assert.Equal(t, nil, err)
 	})
 	t.Run("Should: return http config", func(t *testing.T) {
-		s := New(nil, nil, &mockConfigStorageOk{}, nil)
-		_, err := s.GetSchedulerById(context.Background(), &apiPb.GetSchedulerByIdRequest{
+		s := New(nil, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		res, err := s.GetSchedulerById(context.Background(), &apiPb.GetSchedulerByIdRequest{
 			Id: successHttpConfig.ID.Hex(),
 		})
 		assert.Equal(t, nil, err)
+		assert.Equal(t, []string{"Service unavailable"}, res.GetHttp().Assertions.BodyNotContains)
+		assert.Equal(t, scheduler_config_storage.RedactedSecret, res.GetHttp().Auth.BearerToken)
+		assert.Equal(t, "token", successHttpConfig.HTTPConfig.Auth.BearerToken)
 	})
 	t.Run("Should: return sitemap config", func(t *testing.T) {
-		s := New(nil, nil, &mockConfigStorageOk{}, nil)
+		s := New(nil, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.GetSchedulerById(context.Background(), &apiPb.GetSchedulerByIdRequest{
 			Id: successSiteMapConfig.ID.Hex(),
 		})
 		assert.Equal(t, nil, err)
 	})
 	t.Run("Should: return httpValue config", func(t *testing.T) {
-		s := New(nil, nil, &mockConfigStorageOk{}, nil)
-		_, err := s.GetSchedulerById(context.Background(), &apiPb.GetSchedulerByIdRequest{
+		s := New(nil, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		res, err := s.GetSchedulerById(context.Background(), &apiPb.GetSchedulerByIdRequest{
 			Id: successHttpValueConfig.ID.Hex(),
 		})
 		assert.Equal(t, nil, err)
+		assert.Equal(t, "application/json", res.GetHttpValue().Body.ContentType)
 	})
 	t.Run("Should: return error because not correct typw", func(t *testing.T) {
-		s := New(nil, nil, &mockConfigStorageOk{}, nil)
+		s := New(nil, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.GetSchedulerById(context.Background(), &apiPb.GetSchedulerByIdRequest{
 			Id: errorConfig.ID.Hex(),
 		})
 		assert.NotEqual(t, nil, err)
 	})
 	t.Run("Should: return Cassandra config", func(t *testing.T) {
-		s := New(nil, nil, &mockConfigStorageOk{}, nil)
+		s := New(nil, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.GetSchedulerById(context.Background(), &apiPb.GetSchedulerByIdRequest{
 			Id: successCassandraConfig.ID.Hex(),
 		})
 		assert.Equal(t, nil, err)
 	})
 	t.Run("Should: return Mongo config", func(t *testing.T) {
-		s := New(nil, nil, &mockConfigStorageOk{}, nil)
+		s := New(nil, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.GetSchedulerById(context.Background(), &apiPb.GetSchedulerByIdRequest{
 			Id: successMongoConfig.ID.Hex(),
 		})
 		assert.Equal(t, nil, err)
 	})
 	t.Run("Should: return Mysql config", func(t *testing.T) {
-		s := New(nil, nil, &mockConfigStorageOk{}, nil)
+		s := New(nil, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.GetSchedulerById(context.Background(), &apiPb.GetSchedulerByIdRequest{
 			Id: successMysqlConfig.ID.Hex(),
 		})
 		assert.Equal(t, nil, err)
 	})
 	t.Run("Should: return Postgres config", func(t *testing.T) {
-		s := New(nil, nil, &mockConfigStorageOk{}, nil)
+		s := New(nil, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.GetSchedulerById(context.Background(), &apiPb.GetSchedulerByIdRequest{
 			Id: successPostgresConfig.ID.Hex(),
 		})
 		assert.Equal(t, nil, err)
 	})
+	t.Run("Should: return DNS config", func(t *testing.T) {
+		s := New(nil, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		res, err := s.GetSchedulerById(context.Background(), &apiPb.GetSchedulerByIdRequest{
+			Id: successDNSConfig.ID.Hex(),
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, "squzy.dev", res.GetDns().Name)
+		assert.Equal(t, apiPb.DnsConfig_A, res.GetDns().RecordType)
+	})
+	t.Run("Should: return UDP config", func(t *testing.T) {
+		s := New(nil, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		res, err := s.GetSchedulerById(context.Background(), &apiPb.GetSchedulerByIdRequest{
+			Id: successUDPConfig.ID.Hex(),
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, "stats", res.GetUdp().Send)
+		assert.Equal(t, "END", res.GetUdp().Expect)
+	})
+	t.Run("Should: return REDIS config", func(t *testing.T) {
+		s := New(nil, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		res, err := s.GetSchedulerById(context.Background(), &apiPb.GetSchedulerByIdRequest{
+			Id: successRedisConfig.ID.Hex(),
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, "localhost", res.GetRedis().Host)
+		assert.Equal(t, int64(1000), res.GetRedis().MaxKeys)
+	})
+	t.Run("Should: return HTTP_SCENARIO config", func(t *testing.T) {
+		s := New(nil, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		res, err := s.GetSchedulerById(context.Background(), &apiPb.GetSchedulerByIdRequest{
+			Id: successScenarioConfig.ID.Hex(),
+		})
+		assert.Equal(t, nil, err)
+		assert.Len(t, res.GetHttpScenario().Steps, 2)
+		assert.Equal(t, "me", res.GetHttpScenario().Steps[1].Name)
+	})
 }
 
 func TestServer_Run(t *testing.T) {
 	t.Run("Should: return error because id not bson", func(t *testing.T) {
-		s := New(nil, nil, &mockConfigStorageOk{}, nil)
+		s := New(nil, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.Run(context.Background(), &apiPb.RunRequest{
 			Id: "sff",
 		})
 		assert.NotEqual(t, nil, err)
 	})
 	t.Run("Should: return error because id not found in DB", func(t *testing.T) {
-		s := New(nil, nil, &mockConfigStorageErrorSingle{}, nil)
+		s := New(nil, nil, &mockConfigStorageErrorSingle{}, nil, nil, nil)
 		_, err := s.Run(context.Background(), &apiPb.RunRequest{
 			Id: primitive.NewObjectID().Hex(),
 		})
 		assert.NotEqual(t, nil, err)
 	})
 	t.Run("Should: return error because cant find in memory", func(t *testing.T) {
-		s := New(&mockStorageError{}, nil, &mockConfigStorageOk{}, nil)
+		s := New(&mockStorageError{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.Run(context.Background(), &apiPb.RunRequest{
 			Id: primitive.NewObjectID().Hex(),
 		})
 		assert.NotEqual(t, nil, err)
 	})
 	t.Run("Should: not return error", func(t *testing.T) {
-		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil)
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.Run(context.Background(), &apiPb.RunRequest{
 			Id: primitive.NewObjectID().Hex(),
 		})
@@ -621,7 +857,7 @@ func TestServer_Run(t *testing.T) {
 	})
 	t.Run("Should: return error", func(t *testing.T) {
 		s := New(&mockStorageOk{schedulerRunErr: errors.New("schedulerRunErr")},
-			nil, &mockConfigStorageOk{}, mockCacheErr{})
+			nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.Run(context.Background(), &apiPb.RunRequest{
 			Id: primitive.NewObjectID().Hex(),
 		})
@@ -631,28 +867,28 @@ func TestServer_Run(t *testing.T) {
 
 func TestServer_Stop(t *testing.T) {
 	t.Run("Should: return error because id not bson", func(t *testing.T) {
-		s := New(nil, nil, &mockConfigStorageOk{}, nil)
+		s := New(nil, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.Stop(context.Background(), &apiPb.StopRequest{
 			Id: "sff",
 		})
 		assert.NotEqual(t, nil, err)
 	})
 	t.Run("Should: return error because id not found in DB", func(t *testing.T) {
-		s := New(nil, nil, &mockConfigStorageErrorSingle{}, nil)
+		s := New(nil, nil, &mockConfigStorageErrorSingle{}, nil, nil, nil)
 		_, err := s.Stop(context.Background(), &apiPb.StopRequest{
 			Id: primitive.NewObjectID().Hex(),
 		})
 		assert.NotEqual(t, nil, err)
 	})
 	t.Run("Should: return error because cant find in memory", func(t *testing.T) {
-		s := New(&mockStorageError{}, nil, &mockConfigStorageOk{}, nil)
+		s := New(&mockStorageError{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.Stop(context.Background(), &apiPb.StopRequest{
 			Id: primitive.NewObjectID().Hex(),
 		})
 		assert.NotEqual(t, nil, err)
 	})
 	t.Run("Should: not return error", func(t *testing.T) {
-		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil)
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.Stop(context.Background(), &apiPb.StopRequest{
 			Id: primitive.NewObjectID().Hex(),
 		})
@@ -662,28 +898,28 @@ func TestServer_Stop(t *testing.T) {
 
 func TestServer_Remove(t *testing.T) {
 	t.Run("Should: return error because id not bson", func(t *testing.T) {
-		s := New(nil, nil, &mockConfigStorageOk{}, nil)
+		s := New(nil, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.Remove(context.Background(), &apiPb.RemoveRequest{
 			Id: "sff",
 		})
 		assert.NotEqual(t, nil, err)
 	})
 	t.Run("Should: return error because id not found in DB", func(t *testing.T) {
-		s := New(nil, nil, &mockConfigStorageErrorSingle{}, nil)
+		s := New(nil, nil, &mockConfigStorageErrorSingle{}, nil, nil, nil)
 		_, err := s.Remove(context.Background(), &apiPb.RemoveRequest{
 			Id: primitive.NewObjectID().Hex(),
 		})
 		assert.NotEqual(t, nil, err)
 	})
 	t.Run("Should: return error because cant find in memory", func(t *testing.T) {
-		s := New(&mockStorageError{}, nil, &mockConfigStorageOk{}, nil)
+		s := New(&mockStorageError{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.Remove(context.Background(), &apiPb.RemoveRequest{
 			Id: primitive.NewObjectID().Hex(),
 		})
 		assert.NotEqual(t, nil, err)
 	})
 	t.Run("Should: not return error", func(t *testing.T) {
-		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil)
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.Remove(context.Background(), &apiPb.RemoveRequest{
 			Id: primitive.NewObjectID().Hex(),
 		})
@@ -693,7 +929,7 @@ func TestServer_Remove(t *testing.T) {
 
 func TestServer_Add(t *testing.T) {
 	t.Run("Should: return error because wrong interval", func(t *testing.T) {
-		s := New(nil, nil, nil, nil)
+		s := New(nil, nil, nil, nil, nil, nil)
 		_, err := s.Add(context.Background(), &apiPb.AddRequest{
 			Interval: 0,
 			Timeout:  0,
@@ -702,68 +938,1165 @@ func TestServer_Add(t *testing.T) {
 		assert.NotEqual(t, nil, err)
 	})
 	t.Run("Should: return error because wrong type", func(t *testing.T) {
-		s := New(nil, nil, nil, nil)
+		s := New(nil, nil, nil, nil, nil, nil)
 		_, err := s.Add(context.Background(), rqMap[1000])
 		assert.NotEqual(t, nil, err)
 	})
+	t.Run("Should: return error because wrong labels", func(t *testing.T) {
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		_, err := s.Add(context.Background(), &apiPb.AddRequest{
+			Interval: 10,
+			Labels:   map[string]string{"team.name": "payments"},
+			Config: &apiPb.AddRequest_Tcp{
+				Tcp: &apiPb.TcpConfig{},
+			},
+		})
+		assert.NotEqual(t, nil, err)
+	})
+	t.Run("Should: return error because wrong retry policy", func(t *testing.T) {
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		_, err := s.Add(context.Background(), &apiPb.AddRequest{
+			Interval:    10,
+			RetryPolicy: &apiPb.RetryPolicy{Attempts: 100},
+			Config: &apiPb.AddRequest_Tcp{
+				Tcp: &apiPb.TcpConfig{},
+			},
+		})
+		assert.Equal(t, errInvalidRetryPolicy, err)
+	})
 	t.Run("Should: return error because cant add to DB", func(t *testing.T) {
-		s := New(&mockStorageOk{}, nil, &mockConfigStorageErrorSingle{}, nil)
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageErrorSingle{}, nil, nil, nil)
 		_, err := s.Add(context.Background(), rqMap[apiPb.SchedulerType_TCP])
 		assert.NotEqual(t, nil, err)
 	})
 	t.Run("Should: return error because cant add to in memory", func(t *testing.T) {
-		s := New(&mockStorageError{}, nil, &mockConfigStorageOk{}, nil)
+		s := New(&mockStorageError{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.Add(context.Background(), rqMap[apiPb.SchedulerType_TCP])
 		assert.NotEqual(t, nil, err)
 	})
 	t.Run("Should: add tcp check without error", func(t *testing.T) {
-		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil)
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.Add(context.Background(), rqMap[apiPb.SchedulerType_TCP])
 		assert.Equal(t, nil, err)
 	})
 	t.Run("Should: add ssl check without error", func(t *testing.T) {
-		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil)
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.Add(context.Background(), rqMap[apiPb.SchedulerType_SSL_EXPIRATION])
 		assert.Equal(t, nil, err)
 	})
 	t.Run("Should: add grcp check without error", func(t *testing.T) {
-		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil)
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.Add(context.Background(), rqMap[apiPb.SchedulerType_GRPC])
 		assert.Equal(t, nil, err)
 	})
 	t.Run("Should: add sitemap check without error", func(t *testing.T) {
-		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil)
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.Add(context.Background(), rqMap[apiPb.SchedulerType_SITE_MAP])
 		assert.Equal(t, nil, err)
 	})
 	t.Run("Should: add httpValue check without error", func(t *testing.T) {
-		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil)
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.Add(context.Background(), rqMap[apiPb.SchedulerType_HTTP_JSON_VALUE])
 		assert.Equal(t, nil, err)
 	})
 	t.Run("Should: add http check without error", func(t *testing.T) {
-		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil)
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.Add(context.Background(), rqMap[apiPb.SchedulerType_HTTP])
 		assert.Equal(t, nil, err)
 	})
+	t.Run("Should: return error because http assertion is invalid", func(t *testing.T) {
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		_, err := s.Add(context.Background(), &apiPb.AddRequest{
+			Interval: 10,
+			Config: &apiPb.AddRequest_Http{Http: &apiPb.HttpConfig{
+				Assertions: &apiPb.HttpAssertions{BodyRegex: "("},
+			}},
+		})
+		assert.ErrorIs(t, err, errInvalidAssertion)
+		assert.Nil(t, validateAssertions(&apiPb.HttpAssertions{Headers: []*apiPb.HttpAssertions_Header{{Name: "Server", Regex: "^nginx"}}}))
+		assert.Equal(t, errInvalidAssertion, validateAssertions(&apiPb.HttpAssertions{MaxResponseTime: -1}))
+		assert.Equal(t, errInvalidAssertion, validateAssertions(&apiPb.HttpAssertions{Headers: []*apiPb.HttpAssertions_Header{{Equals: "a"}}}))
+		assert.Equal(t, errInvalidAssertion, validateAssertions(&apiPb.HttpAssertions{Headers: []*apiPb.HttpAssertions_Header{{Name: "a", Equals: "a", Regex: "a"}}}))
+		assert.ErrorIs(t, validateAssertions(&apiPb.HttpAssertions{Headers: []*apiPb.HttpAssertions_Header{{Name: "a", Regex: "("}}}), errInvalidAssertion)
+	})
+	t.Run("Should: return error because http auth is invalid", func(t *testing.T) {
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		_, err := s.Add(context.Background(), &apiPb.AddRequest{
+			Interval: 10,
+			Config: &apiPb.AddRequest_Http{Http: &apiPb.HttpConfig{
+				Auth: &apiPb.HttpAuth{BasicUser: "user", BearerToken: "token"},
+			}},
+		})
+		assert.Equal(t, errInvalidHTTPAuth, err)
+		_, err = s.Add(context.Background(), &apiPb.AddRequest{
+			Interval: 10,
+			Config: &apiPb.AddRequest_HttpValue{HttpValue: &apiPb.HttpJsonValueConfig{
+				Tls: &apiPb.HttpTls{CaBundle: "ca"},
+			}},
+		})
+		assert.Equal(t, errInvalidHTTPTLS, err)
+		assert.Nil(t, validateHTTPAuth(&apiPb.HttpAuth{BasicUser: "user"}, &apiPb.HttpTls{InsecureSkipVerify: true}))
+		assert.Nil(t, validateHTTPAuth(&apiPb.HttpAuth{Oauth2: &apiPb.HttpAuth_OAuth2{TokenUrl: "u", ClientId: "id"}}, nil))
+		assert.Equal(t, errInvalidHTTPAuth, validateHTTPAuth(&apiPb.HttpAuth{}, nil))
+		assert.Equal(t, errInvalidHTTPAuth, validateHTTPAuth(&apiPb.HttpAuth{BasicPassword: "pass", BearerToken: "token"}, nil))
+		assert.Equal(t, errInvalidHTTPAuth, validateHTTPAuth(&apiPb.HttpAuth{Oauth2: &apiPb.HttpAuth_OAuth2{TokenUrl: "u"}}, nil))
+		assert.ErrorIs(t, validateHTTPAuth(nil, &apiPb.HttpTls{ClientCert: "cert"}), errInvalidHTTPTLS)
+	})
 	t.Run("Should: add CASSANDRA check without error", func(t *testing.T) {
-		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil)
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.Add(context.Background(), rqMap[apiPb.SchedulerType_CASSANDRA])
 		assert.Equal(t, nil, err)
 	})
 	t.Run("Should: add MONGO check without error", func(t *testing.T) {
-		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil)
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.Add(context.Background(), rqMap[apiPb.SchedulerType_MONGO])
 		assert.Equal(t, nil, err)
 	})
 	t.Run("Should: add MYSQL check without error", func(t *testing.T) {
-		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil)
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.Add(context.Background(), rqMap[apiPb.SchedulerType_MYSQL])
 		assert.Equal(t, nil, err)
 	})
 	t.Run("Should: add POSTGRES check without error", func(t *testing.T) {
-		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil)
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
 		_, err := s.Add(context.Background(), rqMap[apiPb.SchedulerType_POSTGRES])
 		assert.Equal(t, nil, err)
 	})
+	t.Run("Should: add DNS check without error", func(t *testing.T) {
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		_, err := s.Add(context.Background(), rqMap[apiPb.SchedulerType_DNS])
+		assert.Equal(t, nil, err)
+	})
+	t.Run("Should: return error because dns config is invalid", func(t *testing.T) {
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		_, err := s.Add(context.Background(), &apiPb.AddRequest{
+			Interval: 10,
+			Config:   &apiPb.AddRequest_Dns{Dns: &apiPb.DnsConfig{Resolver: "8.8.8.8", Name: "squzy.dev"}},
+		})
+		assert.Equal(t, errInvalidDNS, err)
+		assert.Equal(t, errInvalidDNS, validateDNS(&apiPb.DnsConfig{Resolver: "8.8.8.8", Name: "squzy.dev", RecordType: 100}))
+		assert.Equal(t, errInvalidDNS, validateDNS(nil))
+	})
+	t.Run("Should: add UDP check without error", func(t *testing.T) {
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		_, err := s.Add(context.Background(), rqMap[apiPb.SchedulerType_UDP])
+		assert.Equal(t, nil, err)
+	})
+	t.Run("Should: return error because send/expect config is invalid", func(t *testing.T) {
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		_, err := s.Add(context.Background(), &apiPb.AddRequest{
+			Interval: 10,
+			Config:   &apiPb.AddRequest_Udp{Udp: &apiPb.TcpConfig{Host: "localhost", Port: 1}},
+		})
+		assert.Equal(t, errInvalidExchange, err)
+		_, err = s.Add(context.Background(), &apiPb.AddRequest{
+			Interval: 10,
+			Config:   &apiPb.AddRequest_Tcp{Tcp: &apiPb.TcpConfig{Host: "localhost", Port: 1, ExpectRegex: "("}},
+		})
+		assert.ErrorIs(t, err, errInvalidExchange)
+		assert.Equal(t, errInvalidExchange, validateExchange(&apiPb.TcpConfig{Expect: "a", ExpectRegex: "a"}, false))
+		assert.Equal(t, errInvalidExchange, validateExchange(&apiPb.TcpConfig{ReadTimeout: -1}, false))
+		assert.Equal(t, errInvalidExchange, validateExchange(nil, false))
+	})
+	t.Run("Should: add HTTP_SCENARIO check without error", func(t *testing.T) {
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		_, err := s.Add(context.Background(), rqMap[apiPb.SchedulerType_HTTP_SCENARIO])
+		assert.Equal(t, nil, err)
+	})
+	t.Run("Should: return error because http scenario is invalid", func(t *testing.T) {
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		_, err := s.Add(context.Background(), &apiPb.AddRequest{
+			Interval: 10,
+			Config:   &apiPb.AddRequest_HttpScenario{HttpScenario: &apiPb.HttpScenarioConfig{}},
+		})
+		assert.Equal(t, errInvalidScenario, err)
+		step := func(extract ...*apiPb.HttpScenarioStep_Extract) *apiPb.HttpScenarioConfig {
+			return &apiPb.HttpScenarioConfig{Steps: []*apiPb.HttpScenarioStep{{Url: "u", Extract: extract}}}
+		}
+		assert.Nil(t, validateScenario(step(&apiPb.HttpScenarioStep_Extract{Name: "id", Source: apiPb.HttpScenarioStep_Extract_REGEX, Expression: "id=(\\d+)"})))
+		assert.Equal(t, errInvalidScenario, validateScenario(step(&apiPb.HttpScenarioStep_Extract{Source: apiPb.HttpScenarioStep_Extract_JSON, Expression: "id"})))
+		assert.Equal(t, errInvalidScenario, validateScenario(step(&apiPb.HttpScenarioStep_Extract{Name: "uuid", Source: apiPb.HttpScenarioStep_Extract_JSON, Expression: "id"})))
+		assert.Equal(t, errInvalidScenario, validateScenario(step(&apiPb.HttpScenarioStep_Extract{Name: "id", Expression: "id"})))
+		assert.Equal(t, errInvalidScenario, validateScenario(step(&apiPb.HttpScenarioStep_Extract{Name: "id", Source: apiPb.HttpScenarioStep_Extract_HEADER})))
+		assert.ErrorIs(t, validateScenario(step(&apiPb.HttpScenarioStep_Extract{Name: "id", Source: apiPb.HttpScenarioStep_Extract_REGEX, Expression: "("})), errInvalidScenario)
+		assert.Equal(t, errInvalidScenario, validateScenario(&apiPb.HttpScenarioConfig{Steps: []*apiPb.HttpScenarioStep{{StatusCode: -1}}}))
+		assert.ErrorIs(t, validateScenario(&apiPb.HttpScenarioConfig{Steps: []*apiPb.HttpScenarioStep{{Assertions: &apiPb.HttpAssertions{BodyRegex: "("}}}}), errInvalidAssertion)
+		assert.Equal(t, errInvalidHTTPAuth, validateScenario(&apiPb.HttpScenarioConfig{Steps: []*apiPb.HttpScenarioStep{{}}, Auth: &apiPb.HttpAuth{}}))
+	})
+	t.Run("Should: add REDIS check without error", func(t *testing.T) {
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		_, err := s.Add(context.Background(), rqMap[apiPb.SchedulerType_REDIS])
+		assert.Equal(t, nil, err)
+	})
+	t.Run("Should: return error because redis config is invalid", func(t *testing.T) {
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		_, err := s.Add(context.Background(), &apiPb.AddRequest{
+			Interval: 10,
+			Config:   &apiPb.AddRequest_Redis{Redis: &apiPb.RedisConfig{}},
+		})
+		assert.Equal(t, errInvalidRedis, err)
+		assert.Equal(t, errInvalidRedis, validateRedis(&apiPb.RedisConfig{Host: "localhost", MaxKeys: -1}))
+		assert.Equal(t, errInvalidRedis, validateRedis(nil))
+	})
+	t.Run("Should: add cron check without error", func(t *testing.T) {
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		_, err := s.Add(context.Background(), &apiPb.AddRequest{
+			Cron:    "*/5 8-19 * * *",
+			Timeout: 10,
+			Config: &apiPb.AddRequest_Tcp{
+				Tcp: &apiPb.TcpConfig{},
+			},
+		})
+		assert.Equal(t, nil, err)
+	})
+	t.Run("Should: return error because wrong cron", func(t *testing.T) {
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		_, err := s.Add(context.Background(), &apiPb.AddRequest{
+			Cron: "every day",
+			Config: &apiPb.AddRequest_Tcp{
+				Tcp: &apiPb.TcpConfig{},
+			},
+		})
+		assert.NotEqual(t, nil, err)
+	})
+}
+
+func TestServer_Update(t *testing.T) {
+	t.Run("Should: return error because id not bson", func(t *testing.T) {
+		s := New(nil, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		_, err := s.Update(context.Background(), &apiPb.UpdateRequest{
+			Id:        "sff",
+			Scheduler: rqMap[apiPb.SchedulerType_TCP],
+		})
+		assert.NotEqual(t, nil, err)
+	})
+	t.Run("Should: return error because scheduler missing", func(t *testing.T) {
+		s := New(nil, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		_, err := s.Update(context.Background(), &apiPb.UpdateRequest{
+			Id: primitive.NewObjectID().Hex(),
+		})
+		assert.NotEqual(t, nil, err)
+	})
+	t.Run("Should: return error because wrong type", func(t *testing.T) {
+		s := New(nil, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		_, err := s.Update(context.Background(), &apiPb.UpdateRequest{
+			Id:        primitive.NewObjectID().Hex(),
+			Scheduler: rqMap[1000],
+		})
+		assert.NotEqual(t, nil, err)
+	})
+	t.Run("Should: return error because wrong interval", func(t *testing.T) {
+		s := New(nil, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		_, err := s.Update(context.Background(), &apiPb.UpdateRequest{
+			Id: primitive.NewObjectID().Hex(),
+			Scheduler: &apiPb.AddRequest{
+				Config: &apiPb.AddRequest_Tcp{
+					Tcp: &apiPb.TcpConfig{},
+				},
+			},
+		})
+		assert.NotEqual(t, nil, err)
+	})
+	t.Run("Should: return error because cant find in memory", func(t *testing.T) {
+		s := New(&mockStorageError{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		_, err := s.Update(context.Background(), &apiPb.UpdateRequest{
+			Id:        primitive.NewObjectID().Hex(),
+			Scheduler: rqMap[apiPb.SchedulerType_TCP],
+		})
+		assert.NotEqual(t, nil, err)
+	})
+	t.Run("Should: return error because cant update in DB", func(t *testing.T) {
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageErrorSingle{}, nil, nil, nil)
+		_, err := s.Update(context.Background(), &apiPb.UpdateRequest{
+			Id:        primitive.NewObjectID().Hex(),
+			Scheduler: rqMap[apiPb.SchedulerType_TCP],
+		})
+		assert.NotEqual(t, nil, err)
+	})
+	t.Run("Should: replace stopped scheduler with same id", func(t *testing.T) {
+		storage := &mockStorageOk{}
+		s := New(storage, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		id := primitive.NewObjectID().Hex()
+		res, err := s.Update(context.Background(), &apiPb.UpdateRequest{
+			Id:        id,
+			Scheduler: rqMap[apiPb.SchedulerType_HTTP],
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, id, res.Id)
+		assert.Equal(t, id, storage.set.GetID())
+		assert.False(t, storage.set.IsRun())
+	})
+	t.Run("Should: run new scheduler if old one was running", func(t *testing.T) {
+		memoryCache, err := cache.NewMemory("")
+		assert.Nil(t, err)
+		dispatcher := scheduler.NewDispatcher(nil, memoryCache)
+		defer dispatcher.Stop()
+		storage := &mockStorageOk{isRun: true}
+		s := New(storage, dispatcher, &mockConfigStorageOk{}, nil, nil, nil)
+		_, err = s.Update(context.Background(), &apiPb.UpdateRequest{
+			Id: primitive.NewObjectID().Hex(),
+			Scheduler: &apiPb.AddRequest{
+				Interval: 60,
+				Config: &apiPb.AddRequest_Tcp{
+					Tcp: &apiPb.TcpConfig{},
+				},
+			},
+		})
+		assert.Equal(t, nil, err)
+		assert.True(t, storage.set.IsRun())
+		storage.set.Stop()
+	})
+}
+
+type mockConfigStorageDocument struct {
+	mockConfigStorageOk
+	configs []*scheduler_config_storage.SchedulerConfig
+}
+
+func (m mockConfigStorageDocument) GetAll(ctx context.Context) ([]*scheduler_config_storage.SchedulerConfig, error) {
+	return m.configs, nil
+}
+
+func (m mockConfigStorageDocument) Get(ctx context.Context, schedulerId primitive.ObjectID) (*scheduler_config_storage.SchedulerConfig, error) {
+	for _, config := range m.configs {
+		if config.ID == schedulerId {
+			return config, nil
+		}
+	}
+	return m.mockConfigStorageOk.Get(ctx, schedulerId)
+}
+
+func (m mockConfigStorageDocument) GetBySelector(ctx context.Context, selector labels.Selector) ([]*scheduler_config_storage.SchedulerConfig, error) {
+	res := []*scheduler_config_storage.SchedulerConfig{}
+	for _, config := range m.configs {
+		if selector.Matches(config.Labels) {
+			res = append(res, config)
+		}
+	}
+	return res, nil
+}
+
+var (
+	documentConfigs = []*scheduler_config_storage.SchedulerConfig{
+		{
+			ID:        primitive.NewObjectID(),
+			Name:      "tcp",
+			Type:      apiPb.SchedulerType_TCP,
+			Status:    apiPb.SchedulerStatus_RUNNED,
+			Interval:  10,
+			TCPConfig: &scheduler_config_storage.TCPConfig{Host: "localhost", Port: 80},
+		},
+		{
+			ID:     primitive.NewObjectID(),
+			Name:   "http",
+			Type:   apiPb.SchedulerType_HTTP,
+			Status: apiPb.SchedulerStatus_STOPPED,
+			Cron:   "@hourly",
+			HTTPConfig: &scheduler_config_storage.HTTPConfig{
+				Method:     "GET",
+				URL:        "https://google.com",
+				StatusCode: 200,
+			},
+		},
+		{
+			ID:        primitive.NewObjectID(),
+			Name:      "removed",
+			Type:      apiPb.SchedulerType_TCP,
+			Status:    apiPb.SchedulerStatus_REMOVED,
+			Interval:  10,
+			TCPConfig: &scheduler_config_storage.TCPConfig{},
+		},
+	}
+)
+
+func TestServer_ExportSchedulers(t *testing.T) {
+	t.Run("Should: return error because DB", func(t *testing.T) {
+		s := New(nil, nil, &mockConfigStorageError{}, nil, nil, nil)
+		_, err := s.ExportSchedulers(context.Background(), &apiPb.ExportSchedulersRequest{})
+		assert.NotEqual(t, nil, err)
+	})
+	t.Run("Should: export not removed schedulers sorted by name", func(t *testing.T) {
+		s := New(nil, nil, &mockConfigStorageDocument{configs: documentConfigs}, nil, nil, nil)
+		res, err := s.ExportSchedulers(context.Background(), &apiPb.ExportSchedulersRequest{
+			Format: apiPb.DocumentFormat_DOCUMENT_FORMAT_YAML,
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, `checks:
+    - name: http
+      type: HTTP
+      cron: '@hourly'
+      http:
+        method: GET
+        url: https://google.com
+        statusCode: 200
+    - name: tcp
+      type: TCP
+      interval: 10
+      tcp:
+        host: localhost
+        port: 80
+`, string(res.Document))
+	})
+}
+
+func TestServer_ApplySchedulers(t *testing.T) {
+	document := []byte(`
+checks:
+  - name: tcp
+    type: TCP
+    interval: 10
+    tcp:
+      host: localhost
+      port: 80
+  - name: grpc
+    type: GRPC
+    interval: 30
+    grpc:
+      service: health
+      host: localhost
+      port: 9090
+`)
+	t.Run("Should: return error because wrong document", func(t *testing.T) {
+		s := New(nil, nil, &mockConfigStorageDocument{configs: documentConfigs}, nil, nil, nil)
+		_, err := s.ApplySchedulers(context.Background(), &apiPb.ApplySchedulersRequest{
+			Document: []byte("checks: 1"),
+		})
+		assert.NotEqual(t, nil, err)
+	})
+	t.Run("Should: return error because missing config", func(t *testing.T) {
+		s := New(nil, nil, &mockConfigStorageDocument{configs: documentConfigs}, nil, nil, nil)
+		_, err := s.ApplySchedulers(context.Background(), &apiPb.ApplySchedulersRequest{
+			Document: []byte("checks:\n  - name: tcp\n    type: TCP\n    interval: 10\n"),
+		})
+		assert.NotEqual(t, nil, err)
+	})
+	t.Run("Should: return error because wrong interval", func(t *testing.T) {
+		s := New(nil, nil, &mockConfigStorageDocument{configs: documentConfigs}, nil, nil, nil)
+		_, err := s.ApplySchedulers(context.Background(), &apiPb.ApplySchedulersRequest{
+			Document: []byte("checks:\n  - name: tcp\n    type: TCP\n    tcp: {}\n"),
+		})
+		assert.NotEqual(t, nil, err)
+	})
+	t.Run("Should: return error because DB", func(t *testing.T) {
+		s := New(nil, nil, &mockConfigStorageError{}, nil, nil, nil)
+		_, err := s.ApplySchedulers(context.Background(), &apiPb.ApplySchedulersRequest{
+			Document: document,
+		})
+		assert.NotEqual(t, nil, err)
+	})
+	t.Run("Should: return plan without changes", func(t *testing.T) {
+		storage := &mockStorageOk{}
+		s := New(storage, nil, &mockConfigStorageDocument{configs: documentConfigs}, nil, nil, nil)
+		res, err := s.ApplySchedulers(context.Background(), &apiPb.ApplySchedulersRequest{
+			Document: document,
+			DryRun:   true,
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 2, len(res.Changes))
+		assert.Equal(t, apiPb.SchedulerChange_CREATE, res.Changes[0].Action)
+		assert.Equal(t, "grpc", res.Changes[0].Name)
+		assert.Equal(t, "", res.Changes[0].Id)
+		assert.Equal(t, apiPb.SchedulerChange_REMOVE, res.Changes[1].Action)
+		assert.Equal(t, documentConfigs[1].ID.Hex(), res.Changes[1].Id)
+		assert.Nil(t, storage.set)
+	})
+	t.Run("Should: apply plan", func(t *testing.T) {
+		storage := &mockStorageOk{}
+		s := New(storage, nil, &mockConfigStorageDocument{configs: documentConfigs}, nil, nil, nil)
+		res, err := s.ApplySchedulers(context.Background(), &apiPb.ApplySchedulersRequest{
+			Document: []byte(`{"checks":[{"name":"tcp","type":"TCP","interval":20,"tcp":{"host":"localhost","port":80}},{"name":"grpc","type":"GRPC","interval":30,"grpc":{}}]}`),
+			Format:   apiPb.DocumentFormat_DOCUMENT_FORMAT_JSON,
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 3, len(res.Changes))
+		assert.Equal(t, apiPb.SchedulerChange_UPDATE, res.Changes[0].Action)
+		assert.Equal(t, []string{"interval: 10 -> 20"}, res.Changes[0].Diff)
+		assert.Equal(t, apiPb.SchedulerChange_CREATE, res.Changes[1].Action)
+		assert.NotEqual(t, "", res.Changes[1].Id)
+		assert.Equal(t, apiPb.SchedulerChange_REMOVE, res.Changes[2].Action)
+	})
+	t.Run("Should: return error because cant apply change", func(t *testing.T) {
+		s := New(&mockStorageError{}, nil, &mockConfigStorageDocument{configs: documentConfigs}, nil, nil, nil)
+		_, err := s.ApplySchedulers(context.Background(), &apiPb.ApplySchedulersRequest{
+			Document: document,
+		})
+		assert.NotEqual(t, nil, err)
+	})
+}
+
+type mockConfigStorageSecrets struct {
+	mockConfigStorageDocument
+	updated *scheduler_config_storage.SchedulerConfig
+}
+
+func (m *mockConfigStorageSecrets) Update(ctx context.Context, config *scheduler_config_storage.SchedulerConfig) error {
+	m.updated = config
+	return nil
+}
+
+func TestServer_RedactedSecrets(t *testing.T) {
+	secretConfig := &scheduler_config_storage.SchedulerConfig{
+		ID:       primitive.NewObjectID(),
+		Name:     "redis",
+		Type:     apiPb.SchedulerType_REDIS,
+		Interval: 10,
+		RedisConfig: &scheduler_config_storage.RedisConfig{
+			Host:     "localhost",
+			Port:     6379,
+			Password: "secret",
+		},
+	}
+	document := []byte(`
+checks:
+  - name: redis
+    type: REDIS
+    interval: 10
+    redis:
+      host: localhost
+      port: 6379
+      password: <redacted>
+`)
+	t.Run("Should: export redacted secret", func(t *testing.T) {
+		s := New(nil, nil, &mockConfigStorageDocument{configs: []*scheduler_config_storage.SchedulerConfig{secretConfig}}, nil, nil, nil)
+		res, err := s.ExportSchedulers(context.Background(), &apiPb.ExportSchedulersRequest{
+			Format: apiPb.DocumentFormat_DOCUMENT_FORMAT_YAML,
+		})
+		assert.Equal(t, nil, err)
+		assert.NotContains(t, string(res.Document), "secret")
+		assert.Contains(t, string(res.Document), "password: <redacted>")
+	})
+	t.Run("Should: not change scheduler because of redacted secret", func(t *testing.T) {
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageDocument{configs: []*scheduler_config_storage.SchedulerConfig{secretConfig}}, nil, nil, nil)
+		res, err := s.ApplySchedulers(context.Background(), &apiPb.ApplySchedulersRequest{
+			Document: document,
+			DryRun:   true,
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 0, len(res.Changes))
+	})
+	t.Run("Should: keep stored secret on update", func(t *testing.T) {
+		configStorage := &mockConfigStorageSecrets{
+			mockConfigStorageDocument: mockConfigStorageDocument{configs: []*scheduler_config_storage.SchedulerConfig{secretConfig}},
+		}
+		s := New(&mockStorageOk{}, nil, configStorage, nil, nil, nil)
+		_, err := s.Update(context.Background(), &apiPb.UpdateRequest{
+			Id: secretConfig.ID.Hex(),
+			Scheduler: &apiPb.AddRequest{
+				Interval: 20,
+				Config: &apiPb.AddRequest_Redis{
+					Redis: &apiPb.RedisConfig{Host: "localhost", Port: 6379, Password: scheduler_config_storage.RedactedSecret},
+				},
+			},
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, "secret", configStorage.updated.RedisConfig.Password)
+		assert.Equal(t, int32(20), configStorage.updated.Interval)
+	})
+	t.Run("Should: return error because redacted secret is not stored", func(t *testing.T) {
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageOk{}, nil, nil, nil)
+		_, err := s.Add(context.Background(), &apiPb.AddRequest{
+			Interval: 10,
+			Config: &apiPb.AddRequest_Redis{
+				Redis: &apiPb.RedisConfig{Host: "localhost", Port: 6379, Password: scheduler_config_storage.RedactedSecret},
+			},
+		})
+		assert.NotEqual(t, nil, err)
+	})
+}
+
+var (
+	labeledConfigs = []*scheduler_config_storage.SchedulerConfig{
+		{
+			ID:        primitive.NewObjectID(),
+			Type:      apiPb.SchedulerType_TCP,
+			Labels:    map[string]string{"team": "payments"},
+			TCPConfig: &scheduler_config_storage.TCPConfig{},
+		},
+		{
+			ID:        primitive.NewObjectID(),
+			Type:      apiPb.SchedulerType_TCP,
+			Labels:    map[string]string{"team": "payments", "deprecated": ""},
+			TCPConfig: &scheduler_config_storage.TCPConfig{},
+		},
+		{
+			ID:        primitive.NewObjectID(),
+			Type:      apiPb.SchedulerType_TCP,
+			Status:    apiPb.SchedulerStatus_REMOVED,
+			Labels:    map[string]string{"team": "payments"},
+			TCPConfig: &scheduler_config_storage.TCPConfig{},
+		},
+		{
+			ID:        primitive.NewObjectID(),
+			Type:      apiPb.SchedulerType_TCP,
+			Labels:    map[string]string{"team": "search"},
+			TCPConfig: &scheduler_config_storage.TCPConfig{},
+		},
+	}
+)
+
+func TestServer_BulkAction(t *testing.T) {
+	t.Run("Should: return error because empty selector", func(t *testing.T) {
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageDocument{configs: labeledConfigs}, nil, nil, nil)
+		_, err := s.BulkAction(context.Background(), &apiPb.BulkActionRequest{
+			Action: apiPb.BulkActionRequest_RUN,
+		})
+		assert.Equal(t, errEmptySelectorError, err)
+	})
+	t.Run("Should: return error because wrong selector", func(t *testing.T) {
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageDocument{configs: labeledConfigs}, nil, nil, nil)
+		_, err := s.BulkAction(context.Background(), &apiPb.BulkActionRequest{
+			Selector: "=",
+			Action:   apiPb.BulkActionRequest_RUN,
+		})
+		assert.NotEqual(t, nil, err)
+	})
+	t.Run("Should: return error because unknown action", func(t *testing.T) {
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageDocument{configs: labeledConfigs}, nil, nil, nil)
+		_, err := s.BulkAction(context.Background(), &apiPb.BulkActionRequest{
+			Selector: "team=payments",
+		})
+		assert.Equal(t, errInvalidActionError, err)
+	})
+	t.Run("Should: return error because DB", func(t *testing.T) {
+		s := New(&mockStorageOk{}, nil, &mockConfigStorageError{}, nil, nil, nil)
+		_, err := s.BulkAction(context.Background(), &apiPb.BulkActionRequest{
+			Selector: "team=payments",
+			Action:   apiPb.BulkActionRequest_STOP,
+		})
+		assert.NotEqual(t, nil, err)
+	})
+	t.Run("Should: apply action to matched schedulers", func(t *testing.T) {
+		for _, action := range []apiPb.BulkActionRequest_Action{
+			apiPb.BulkActionRequest_RUN,
+			apiPb.BulkActionRequest_STOP,
+			apiPb.BulkActionRequest_REMOVE,
+		} {
+			s := New(&mockStorageOk{}, nil, &mockConfigStorageDocument{configs: labeledConfigs}, nil, nil, nil)
+			res, err := s.BulkAction(context.Background(), &apiPb.BulkActionRequest{
+				Selector: "team=payments",
+				Action:   action,
+			})
+			assert.Equal(t, nil, err)
+			assert.Equal(t, []string{labeledConfigs[0].ID.Hex(), labeledConfigs[1].ID.Hex()}, res.Ids)
+			assert.Len(t, res.Errors, 0)
+		}
+	})
+	t.Run("Should: collect errors per scheduler", func(t *testing.T) {
+		s := New(&mockStorageError{}, nil, &mockConfigStorageDocument{configs: labeledConfigs}, nil, nil, nil)
+		res, err := s.BulkAction(context.Background(), &apiPb.BulkActionRequest{
+			Selector: "team=search",
+			Action:   apiPb.BulkActionRequest_RUN,
+		})
+		assert.Equal(t, nil, err)
+		assert.Len(t, res.Ids, 0)
+		assert.Contains(t, res.Errors, labeledConfigs[3].ID.Hex())
+	})
+}
+
+type mockMaintenanceStorage struct {
+	windows []*maintenance.Window
+	err     error
+}
+
+func (m *mockMaintenanceStorage) Add(ctx context.Context, window *maintenance.Window) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.windows = append(m.windows, window)
+	return nil
+}
+
+func (m *mockMaintenanceStorage) Remove(ctx context.Context, id primitive.ObjectID) error {
+	return m.err
+}
+
+func (m *mockMaintenanceStorage) GetAll(ctx context.Context) ([]*maintenance.Window, error) {
+	return m.windows, m.err
+}
+
+func TestServer_MaintenanceWindow(t *testing.T) {
+	window := &apiPb.MaintenanceWindow{
+		Name:     "deploy",
+		Selector: "team=payments",
+		Start:    timestamppb.New(time.Unix(1294960918, 0)),
+		End:      timestamppb.New(time.Unix(1294964518, 0)),
+	}
+	t.Run("Should: add window and return it in list", func(t *testing.T) {
+		s := New(nil, nil, nil, &mockMaintenanceStorage{}, nil, nil)
+		res, err := s.AddMaintenanceWindow(context.Background(), &apiPb.AddMaintenanceWindowRequest{Window: window})
+		assert.Nil(t, err)
+		list, err := s.GetMaintenanceWindowList(context.Background(), &apiPb.GetMaintenanceWindowListRequest{})
+		assert.Nil(t, err)
+		assert.Len(t, list.Windows, 1)
+		assert.Equal(t, res.Id, list.Windows[0].Id)
+		assert.Equal(t, "team=payments", list.Windows[0].Selector)
+	})
+	t.Run("Should: return error because window is invalid", func(t *testing.T) {
+		s := New(nil, nil, nil, &mockMaintenanceStorage{}, nil, nil)
+		_, err := s.AddMaintenanceWindow(context.Background(), &apiPb.AddMaintenanceWindowRequest{})
+		assert.NotNil(t, err)
+	})
+	t.Run("Should: return error because DB", func(t *testing.T) {
+		s := New(nil, nil, nil, &mockMaintenanceStorage{err: errors.New("")}, nil, nil)
+		_, err := s.AddMaintenanceWindow(context.Background(), &apiPb.AddMaintenanceWindowRequest{Window: window})
+		assert.NotNil(t, err)
+		_, err = s.RemoveMaintenanceWindow(context.Background(), &apiPb.RemoveMaintenanceWindowRequest{Id: primitive.NewObjectID().Hex()})
+		assert.NotNil(t, err)
+		_, err = s.GetMaintenanceWindowList(context.Background(), &apiPb.GetMaintenanceWindowListRequest{})
+		assert.NotNil(t, err)
+	})
+	t.Run("Should: remove window", func(t *testing.T) {
+		s := New(nil, nil, nil, &mockMaintenanceStorage{}, nil, nil)
+		id := primitive.NewObjectID().Hex()
+		res, err := s.RemoveMaintenanceWindow(context.Background(), &apiPb.RemoveMaintenanceWindowRequest{Id: id})
+		assert.Nil(t, err)
+		assert.Equal(t, id, res.Id)
+	})
+	t.Run("Should: return error because wrong id", func(t *testing.T) {
+		s := New(nil, nil, nil, &mockMaintenanceStorage{}, nil, nil)
+		_, err := s.RemoveMaintenanceWindow(context.Background(), &apiPb.RemoveMaintenanceWindowRequest{Id: "wrong"})
+		assert.NotNil(t, err)
+	})
+}
+
+func TestValidateRetryPolicy(t *testing.T) {
+	t.Run("Should: accept empty policy", func(t *testing.T) {
+		assert.Nil(t, validateRetryPolicy(nil))
+	})
+	t.Run("Should: accept correct policy", func(t *testing.T) {
+		assert.Nil(t, validateRetryPolicy(&apiPb.RetryPolicy{Attempts: 3, Backoff: 100, BackoffMultiplier: 2}))
+	})
+	t.Run("Should: return error because attempts are negative", func(t *testing.T) {
+		assert.Equal(t, errInvalidRetryPolicy, validateRetryPolicy(&apiPb.RetryPolicy{Attempts: -1}))
+	})
+	t.Run("Should: return error because backoff is negative", func(t *testing.T) {
+		assert.Equal(t, errInvalidRetryPolicy, validateRetryPolicy(&apiPb.RetryPolicy{Attempts: 2, Backoff: -1}))
+	})
+	t.Run("Should: return error because multiplier is negative", func(t *testing.T) {
+		assert.Equal(t, errInvalidRetryPolicy, validateRetryPolicy(&apiPb.RetryPolicy{Attempts: 2, BackoffMultiplier: -1}))
+	})
+}
+
+func TestNewConfig(t *testing.T) {
+	t.Run("Should: keep retry policy", func(t *testing.T) {
+		config, err := newConfig(primitive.NewObjectID(), &apiPb.AddRequest{
+			Interval:    10,
+			RetryPolicy: &apiPb.RetryPolicy{Attempts: 3, RetryableErrors: []string{"TIMEOUT"}},
+			Config: &apiPb.AddRequest_Tcp{
+				Tcp: &apiPb.TcpConfig{},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, &scheduler_config_storage.RetryPolicy{Attempts: 3, RetryableErrors: []string{"TIMEOUT"}}, config.RetryPolicy)
+	})
+}
+
+type mockTester struct {
+	config *scheduler_config_storage.SchedulerConfig
+}
+
+func (m *mockTester) Test(config *scheduler_config_storage.SchedulerConfig) *apiPb.SchedulerResponse {
+	m.config = config
+	if config.Type == apiPb.SchedulerType_MONGO {
+		return nil
+	}
+	return &apiPb.SchedulerResponse{
+		SchedulerId: config.ID.Hex(),
+		Snapshot: &apiPb.SchedulerSnapshot{
+			Code:  apiPb.SchedulerCode_ERROR,
+			Error: &apiPb.SchedulerSnapshot_Error{Message: "connection refused"},
+		},
+	}
+}
+
+func TestServer_TestScheduler(t *testing.T) {
+	t.Run("Should: return result of check without saving", func(t *testing.T) {
+		tester := &mockTester{}
+		s := New(nil, nil, &mockConfigStorageErrorSingle{}, nil, tester, nil)
+		res, err := s.TestScheduler(context.Background(), &apiPb.AddRequest{
+			Config: &apiPb.AddRequest_Tcp{
+				Tcp: &apiPb.TcpConfig{Host: "localhost", Port: 9999},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res.Snapshot.Code)
+		assert.Equal(t, "connection refused", res.Snapshot.Error.Message)
+		assert.Equal(t, tester.config.ID.Hex(), res.SchedulerId)
+		assert.Equal(t, "localhost", tester.config.TCPConfig.Host)
+	})
+	t.Run("Should: return error because wrong type", func(t *testing.T) {
+		s := New(nil, nil, nil, nil, &mockTester{}, nil)
+		_, err := s.TestScheduler(context.Background(), &apiPb.AddRequest{})
+		assert.Equal(t, errInvalidTypeError, err)
+	})
+	t.Run("Should: return error because check is not supported", func(t *testing.T) {
+		s := New(nil, nil, nil, nil, &mockTester{}, nil)
+		_, err := s.TestScheduler(context.Background(), &apiPb.AddRequest{
+			Config: &apiPb.AddRequest_Mongo{Mongo: &apiPb.DbConfig{}},
+		})
+		assert.Equal(t, errInvalidTypeError, err)
+	})
+}
+
+type mockConfigStorageParents struct {
+	mockConfigStorageOk
+	configs map[primitive.ObjectID]*scheduler_config_storage.SchedulerConfig
+}
+
+func (m mockConfigStorageParents) Get(ctx context.Context, schedulerId primitive.ObjectID) (*scheduler_config_storage.SchedulerConfig, error) {
+	config, ok := m.configs[schedulerId]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return config, nil
+}
+
+func TestServer_validateParents(t *testing.T) {
+	router := primitive.NewObjectID()
+	gateway := primitive.NewObjectID()
+	removed := primitive.NewObjectID()
+	storage := mockConfigStorageParents{
+		configs: map[primitive.ObjectID]*scheduler_config_storage.SchedulerConfig{
+			router:  {ID: router},
+			gateway: {ID: gateway, ParentIDs: []primitive.ObjectID{router}},
+			removed: {ID: removed, Status: apiPb.SchedulerStatus_REMOVED},
+		},
+	}
+	s := &server{configStorage: storage}
+	t.Run("Should: accept chain of parents", func(t *testing.T) {
+		err := s.validateParents(context.Background(), &scheduler_config_storage.SchedulerConfig{
+			ID:        primitive.NewObjectID(),
+			ParentIDs: []primitive.ObjectID{gateway, router},
+		})
+		assert.Nil(t, err)
+	})
+	t.Run("Should: return error because scheduler depends on itself", func(t *testing.T) {
+		err := s.validateParents(context.Background(), &scheduler_config_storage.SchedulerConfig{
+			ID:        gateway,
+			ParentIDs: []primitive.ObjectID{gateway},
+		})
+		assert.Equal(t, errDependencyCycle, err)
+	})
+	t.Run("Should: return error because of cycle", func(t *testing.T) {
+		err := s.validateParents(context.Background(), &scheduler_config_storage.SchedulerConfig{
+			ID:        router,
+			ParentIDs: []primitive.ObjectID{gateway},
+		})
+		assert.Equal(t, errDependencyCycle, err)
+	})
+	t.Run("Should: return error because parent not found", func(t *testing.T) {
+		err := s.validateParents(context.Background(), &scheduler_config_storage.SchedulerConfig{
+			ID:        primitive.NewObjectID(),
+			ParentIDs: []primitive.ObjectID{primitive.NewObjectID()},
+		})
+		assert.ErrorIs(t, err, errParentNotFound)
+	})
+	t.Run("Should: return error because parent is removed", func(t *testing.T) {
+		err := s.validateParents(context.Background(), &scheduler_config_storage.SchedulerConfig{
+			ID:        primitive.NewObjectID(),
+			ParentIDs: []primitive.ObjectID{removed},
+		})
+		assert.ErrorIs(t, err, errParentNotFound)
+	})
+}
+
+func TestNewConfigParents(t *testing.T) {
+	t.Run("Should: convert parent ids", func(t *testing.T) {
+		parent := primitive.NewObjectID()
+		config, err := newConfig(primitive.NewObjectID(), &apiPb.AddRequest{
+			ParentIds: []string{parent.Hex()},
+			Config:    &apiPb.AddRequest_Tcp{Tcp: &apiPb.TcpConfig{}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []primitive.ObjectID{parent}, config.ParentIDs)
+		assert.Equal(t, []string{parent.Hex()}, parentIDsToProto(config.ParentIDs))
+	})
+	t.Run("Should: return error because parent id is invalid", func(t *testing.T) {
+		_, err := newConfig(primitive.NewObjectID(), &apiPb.AddRequest{
+			ParentIds: []string{"router"},
+			Config:    &apiPb.AddRequest_Tcp{Tcp: &apiPb.TcpConfig{}},
+		})
+		assert.ErrorIs(t, err, errParentNotFound)
+	})
+	t.Run("Should: return nil for empty parents", func(t *testing.T) {
+		assert.Nil(t, parentIDsToProto(nil))
+	})
+}
+
+func TestServer_AddWithParents(t *testing.T) {
+	t.Run("Should: return error because parent not found", func(t *testing.T) {
+		s := New(&mockStorageOk{}, nil, mockConfigStorageParents{}, nil, nil, nil)
+		_, err := s.Add(context.Background(), &apiPb.AddRequest{
+			Interval:  10,
+			ParentIds: []string{primitive.NewObjectID().Hex()},
+			Config:    &apiPb.AddRequest_Tcp{Tcp: &apiPb.TcpConfig{}},
+		})
+		assert.ErrorIs(t, err, errParentNotFound)
+	})
+}
+
+func TestValidateLocations(t *testing.T) {
+	t.Run("Should: accept locations", func(t *testing.T) {
+		assert.Nil(t, validateLocations([]string{"eu", "us"}, 2))
+		assert.Nil(t, validateLocations(nil, 0))
+	})
+	t.Run("Should: return error because location is duplicated or empty", func(t *testing.T) {
+		assert.Equal(t, errInvalidLocations, validateLocations([]string{"eu", "eu"}, 0))
+		assert.Equal(t, errInvalidLocations, validateLocations([]string{""}, 0))
+	})
+	t.Run("Should: return error because min failed locations is wrong", func(t *testing.T) {
+		assert.Equal(t, errInvalidLocations, validateLocations([]string{"eu"}, 2))
+		assert.Equal(t, errInvalidLocations, validateLocations([]string{"eu"}, -1))
+	})
+	t.Run("Should: keep locations in config", func(t *testing.T) {
+		config, err := newConfig(primitive.NewObjectID(), &apiPb.AddRequest{
+			Locations:          []string{"eu", "us"},
+			MinFailedLocations: 2,
+			Config:             &apiPb.AddRequest_Tcp{Tcp: &apiPb.TcpConfig{}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"eu", "us"}, config.Locations)
+		assert.Equal(t, int32(2), config.MinFailedLocations)
+	})
+}
+
+func TestNewConfigFailureInterval(t *testing.T) {
+	t.Run("Should: keep failure interval in config", func(t *testing.T) {
+		config, err := newConfig(primitive.NewObjectID(), &apiPb.AddRequest{
+			Interval:        300,
+			FailureInterval: 15,
+			RecoverAfter:    3,
+			Config:          &apiPb.AddRequest_Tcp{Tcp: &apiPb.TcpConfig{}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, int32(15), config.FailureInterval)
+		assert.Equal(t, int32(3), config.RecoverAfter)
+	})
+	t.Run("Should: return error because failure interval is negative", func(t *testing.T) {
+		_, err := newConfig(primitive.NewObjectID(), &apiPb.AddRequest{
+			FailureInterval: -1,
+			Config:          &apiPb.AddRequest_Tcp{Tcp: &apiPb.TcpConfig{}},
+		})
+		assert.Equal(t, errInvalidRecovery, err)
+	})
+	t.Run("Should: return error because recover after is negative", func(t *testing.T) {
+		_, err := newConfig(primitive.NewObjectID(), &apiPb.AddRequest{
+			RecoverAfter: -1,
+			Config:       &apiPb.AddRequest_Tcp{Tcp: &apiPb.TcpConfig{}},
+		})
+		assert.Equal(t, errInvalidRecovery, err)
+	})
+}
+
+func TestNewConfigFlapDetection(t *testing.T) {
+	t.Run("Should: keep flap detection in config", func(t *testing.T) {
+		config, err := newConfig(primitive.NewObjectID(), &apiPb.AddRequest{
+			FlapDetection: &apiPb.FlapDetection{Window: 10, Threshold: 30},
+			Config:        &apiPb.AddRequest_Tcp{Tcp: &apiPb.TcpConfig{}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, &scheduler_config_storage.FlapDetection{Window: 10, Threshold: 30}, config.FlapDetection)
+	})
+	t.Run("Should: return error because threshold is wrong", func(t *testing.T) {
+		_, err := newConfig(primitive.NewObjectID(), &apiPb.AddRequest{
+			FlapDetection: &apiPb.FlapDetection{Threshold: 101},
+			Config:        &apiPb.AddRequest_Tcp{Tcp: &apiPb.TcpConfig{}},
+		})
+		assert.Equal(t, errInvalidFlapping, err)
+	})
+	t.Run("Should: return error because window is negative", func(t *testing.T) {
+		assert.Equal(t, errInvalidFlapping, validateFlapDetection(&apiPb.FlapDetection{Window: -1}))
+	})
+}
+
+func TestNewConfigWarning(t *testing.T) {
+	t.Run("Should: keep warning thresholds in config", func(t *testing.T) {
+		config, err := newConfig(primitive.NewObjectID(), &apiPb.AddRequest{
+			Config: &apiPb.AddRequest_HttpValue{HttpValue: &apiPb.HttpJsonValueConfig{
+				WarningTime: 500,
+				Selectors: []*apiPb.HttpJsonValueConfig_Selectors{
+					{
+						Path:       "value",
+						WarningMin: &wrappers.DoubleValue{Value: 1},
+					},
+				},
+			}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, int32(500), config.HTTPValueConfig.WarningTime)
+		assert.Equal(t, float64(1), *config.HTTPValueConfig.Selectors[0].WarningMin)
+		assert.Nil(t, config.HTTPValueConfig.Selectors[0].WarningMax)
+	})
+	t.Run("Should: keep selector assertion in config", func(t *testing.T) {
+		config, err := newConfig(primitive.NewObjectID(), &apiPb.AddRequest{
+			Config: &apiPb.AddRequest_HttpValue{HttpValue: &apiPb.HttpJsonValueConfig{
+				Selectors: []*apiPb.HttpJsonValueConfig_Selectors{
+					{
+						Path:      "status",
+						Assertion: &apiPb.HttpJsonValueConfig_Assertion{NotEquals: &wrappers.StringValue{Value: "red"}},
+					},
+				},
+			}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, "red", *config.HTTPValueConfig.Selectors[0].Assertion.NotEquals)
+	})
+	t.Run("Should: return error because selector assertion is invalid", func(t *testing.T) {
+		_, err := newConfig(primitive.NewObjectID(), &apiPb.AddRequest{
+			Config: &apiPb.AddRequest_HttpValue{HttpValue: &apiPb.HttpJsonValueConfig{
+				Selectors: []*apiPb.HttpJsonValueConfig_Selectors{
+					{
+						Assertion: &apiPb.HttpJsonValueConfig_Assertion{
+							GreaterThan: &wrappers.DoubleValue{Value: 2},
+							LessThan:    &wrappers.DoubleValue{Value: 2},
+						},
+					},
+				},
+			}},
+		})
+		assert.Equal(t, errInvalidSelector, err)
+		assert.ErrorIs(t, validateSelectors([]*apiPb.HttpJsonValueConfig_Selectors{
+			{Assertion: &apiPb.HttpJsonValueConfig_Assertion{Regex: "("}},
+		}), errInvalidSelector)
+	})
+	t.Run("Should: return error because warning time is negative", func(t *testing.T) {
+		_, err := newConfig(primitive.NewObjectID(), &apiPb.AddRequest{
+			Config: &apiPb.AddRequest_Tcp{Tcp: &apiPb.TcpConfig{WarningTime: -1}},
+		})
+		assert.Equal(t, errInvalidWarning, err)
+	})
+	t.Run("Should: return error because warning days is negative", func(t *testing.T) {
+		_, err := newConfig(primitive.NewObjectID(), &apiPb.AddRequest{
+			Config: &apiPb.AddRequest_SslExpiration{SslExpiration: &apiPb.SslExpirationConfig{WarningDays: -1}},
+		})
+		assert.Equal(t, errInvalidWarning, err)
+	})
+	t.Run("Should: return error because range is empty", func(t *testing.T) {
+		_, err := newConfig(primitive.NewObjectID(), &apiPb.AddRequest{
+			Config: &apiPb.AddRequest_HttpValue{HttpValue: &apiPb.HttpJsonValueConfig{
+				Selectors: []*apiPb.HttpJsonValueConfig_Selectors{
+					{
+						WarningMin: &wrappers.DoubleValue{Value: 2},
+						WarningMax: &wrappers.DoubleValue{Value: 1},
+					},
+				},
+			}},
+		})
+		assert.Equal(t, errInvalidWarning, err)
+	})
+}
+
+func TestFlappingState(t *testing.T) {
+	codes := []apiPb.SchedulerCode{
+		apiPb.SchedulerCode_OK,
+		apiPb.SchedulerCode_ERROR,
+		apiPb.SchedulerCode_OK,
+	}
+	t.Run("Should: return state of recent codes", func(t *testing.T) {
+		config := &scheduler_config_storage.SchedulerConfig{
+			FlapDetection: &scheduler_config_storage.FlapDetection{},
+			RecentCodes:   codes,
+		}
+		assert.Equal(t, float64(100), stateChange(config))
+		assert.True(t, isFlapping(config))
+	})
+	t.Run("Should: return empty state without flap detection", func(t *testing.T) {
+		config := &scheduler_config_storage.SchedulerConfig{
+			RecentCodes: codes,
+		}
+		assert.Equal(t, float64(0), stateChange(config))
+		assert.False(t, isFlapping(config))
+	})
+}
+
+type probeTasksStreamMock struct {
+	grpc.ServerStream
+	ctx   context.Context
+	tasks chan *apiPb.ProbeTask
+	err   error
+}
+
+func (p *probeTasksStreamMock) Context() context.Context {
+	return p.ctx
+}
+
+func (p *probeTasksStreamMock) Send(task *apiPb.ProbeTask) error {
+	p.tasks <- task
+	return p.err
+}
+
+// Call of probe with token as it is received by server
+func probeContext(ctx context.Context, token string) context.Context {
+	md, _ := metadata.FromOutgoingContext(probe.WithToken(ctx, token))
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+func TestServer_ProbeTasks(t *testing.T) {
+	t.Run("Should: return error because token is wrong", func(t *testing.T) {
+		s := New(nil, nil, nil, nil, nil, probe.New("token"))
+		err := s.ProbeTasks(&apiPb.ProbeTasksRequest{Location: "eu"}, &probeTasksStreamMock{ctx: probeContext(context.Background(), "other")})
+		assert.NotNil(t, err)
+	})
+	t.Run("Should: return error because location is empty", func(t *testing.T) {
+		s := New(nil, nil, nil, nil, nil, probe.New("token"))
+		err := s.ProbeTasks(&apiPb.ProbeTasksRequest{}, &probeTasksStreamMock{ctx: probeContext(context.Background(), "token")})
+		assert.Equal(t, errEmptyLocation, err)
+	})
+	t.Run("Should: send tasks and report results", func(t *testing.T) {
+		hub := probe.New("token")
+		s := New(nil, nil, nil, nil, nil, hub)
+		ctx, cancel := context.WithCancel(probeContext(context.Background(), "token"))
+		stream := &probeTasksStreamMock{ctx: ctx, tasks: make(chan *apiPb.ProbeTask, 1)}
+		done := make(chan error)
+		go func() {
+			done <- s.ProbeTasks(&apiPb.ProbeTasksRequest{Location: "eu"}, stream)
+		}()
+		go func() {
+			task := <-stream.tasks
+			_, err := s.ProbeResult(probeContext(context.Background(), "token"), &apiPb.ProbeResultRequest{
+				TaskId:   task.Id,
+				Location: "eu",
+				Snapshot: &apiPb.SchedulerSnapshot{Code: apiPb.SchedulerCode_OK},
+			})
+			assert.Nil(t, err)
+		}()
+		// Wait for subscription
+		time.Sleep(time.Millisecond * 50)
+		res := hub.Execute(&scheduler_config_storage.SchedulerConfig{
+			ID:        primitive.NewObjectID(),
+			Type:      apiPb.SchedulerType_TCP,
+			Locations: []string{"eu"},
+		})
+		assert.Equal(t, apiPb.SchedulerCode_OK, res.GetLogData().Snapshot.Code)
+		cancel()
+		assert.Nil(t, <-done)
+	})
+	t.Run("Should: return error because stream is broken", func(t *testing.T) {
+		hub := probe.New("token")
+		s := New(nil, nil, nil, nil, nil, hub)
+		stream := &probeTasksStreamMock{ctx: probeContext(context.Background(), "token"), tasks: make(chan *apiPb.ProbeTask, 1), err: errors.New("broken")}
+		done := make(chan error)
+		go func() {
+			done <- s.ProbeTasks(&apiPb.ProbeTasksRequest{Location: "eu"}, stream)
+		}()
+		time.Sleep(time.Millisecond * 50)
+		go hub.Execute(&scheduler_config_storage.SchedulerConfig{
+			ID:        primitive.NewObjectID(),
+			Locations: []string{"eu"},
+		})
+		assert.NotNil(t, <-done)
+	})
+}
+
+func TestServer_ProbeResult(t *testing.T) {
+	t.Run("Should: return error because token is missing", func(t *testing.T) {
+		s := New(nil, nil, nil, nil, nil, probe.New("token"))
+		_, err := s.ProbeResult(context.Background(), &apiPb.ProbeResultRequest{TaskId: "id"})
+		assert.NotNil(t, err)
+	})
+	t.Run("Should: return error because task is unknown", func(t *testing.T) {
+		s := New(nil, nil, nil, nil, nil, probe.New("token"))
+		_, err := s.ProbeResult(probeContext(context.Background(), "token"), &apiPb.ProbeResultRequest{TaskId: "id"})
+		assert.NotNil(t, err)
+	})
 }
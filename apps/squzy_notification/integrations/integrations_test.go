@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"github.com/squzy/squzy/apps/squzy_notification/database"
+	"github.com/squzy/squzy/internal/httptools"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
 	api "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"github.com/stretchr/testify/assert"
 	timestamp "google.golang.org/protobuf/types/known/timestamppb"
@@ -65,7 +67,15 @@ func (m mockError) SendRequestTimeoutStatusCode(req *http.Request, timeout time.
 	panic("implement me")
 }
 
-func (m mockError) CreateRequest(method string, url string, headers *map[string]string, schedulerID string) *http.Request {
+func (m mockError) SendRequestTimeoutResponse(req *http.Request, timeout time.Duration, expectedCode int) (*http.Response, []byte, error) {
+	panic("implement me")
+}
+
+func (m mockError) CreateRequest(method string, url string, headers *map[string]string, body *scheduler_config_storage.HTTPBody, schedulerID string) *http.Request {
+	panic("implement me")
+}
+
+func (m mockError) ForCheck(auth *scheduler_config_storage.HTTPAuth, tlsConfig *scheduler_config_storage.HTTPTLS) (httptools.HTTPTool, error) {
 	panic("implement me")
 }
 
@@ -85,7 +95,15 @@ func (m mock) SendRequestTimeoutStatusCode(req *http.Request, timeout time.Durat
 	panic("implement me")
 }
 
-func (m mock) CreateRequest(method string, url string, headers *map[string]string, schedulerID string) *http.Request {
+func (m mock) SendRequestTimeoutResponse(req *http.Request, timeout time.Duration, expectedCode int) (*http.Response, []byte, error) {
+	panic("implement me")
+}
+
+func (m mock) CreateRequest(method string, url string, headers *map[string]string, body *scheduler_config_storage.HTTPBody, schedulerID string) *http.Request {
+	panic("implement me")
+}
+
+func (m mock) ForCheck(auth *scheduler_config_storage.HTTPAuth, tlsConfig *scheduler_config_storage.HTTPTLS) (httptools.HTTPTool, error) {
 	panic("implement me")
 }
 
@@ -0,0 +1,158 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"github.com/squzy/squzy/internal/helpers"
+	job_executor "github.com/squzy/squzy/internal/job-executor"
+	"github.com/squzy/squzy/internal/logger"
+	"github.com/squzy/squzy/internal/probe"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"go.mongodb.org/mongo-driver/bson"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"sync"
+	"time"
+)
+
+const (
+	// Delay before probe connects again to squzy monitoring
+	RetryInterval = time.Second * 5
+	resultTimeout = time.Second * 5
+)
+
+var (
+	errEmptyLocation     = errors.New("EMPTY_PROBE_LOCATION")
+	errEmptyToken        = errors.New("EMPTY_PROBE_TOKEN")
+	errInvalidConfig     = errors.New("INVALID_PROBE_CONFIG")
+	errNotSupportedCheck = errors.New("NOT_SUPPORTED_CHECK_TYPE")
+)
+
+type app struct {
+	clients       []apiPb.SchedulersExecutorClient
+	tester        job_executor.Tester
+	location      string
+	token         string
+	slots         chan struct{}
+	retryInterval time.Duration
+}
+
+// New create probe which executes checks of location received from every client
+func New(
+	clients []apiPb.SchedulersExecutorClient,
+	tester job_executor.Tester,
+	location string,
+	token string,
+	workers int,
+) *app {
+	return &app{
+		clients:       clients,
+		tester:        tester,
+		location:      location,
+		token:         token,
+		slots:         make(chan struct{}, workers),
+		retryInterval: RetryInterval,
+	}
+}
+
+// Run until context is done, connection errors are logged and probe reconnects
+func (a *app) Run(ctx context.Context) error {
+	if a.location == "" {
+		return errEmptyLocation
+	}
+	if a.token == "" {
+		return errEmptyToken
+	}
+	// Every call is made with token, squzy monitoring rejects probe without it
+	ctx = probe.WithToken(ctx, a.token)
+	wg := sync.WaitGroup{}
+	for _, client := range a.clients {
+		wg.Add(1)
+		go func(client apiPb.SchedulersExecutorClient) {
+			defer wg.Done()
+			a.listen(ctx, client)
+		}(client)
+	}
+	wg.Wait()
+	return ctx.Err()
+}
+
+func (a *app) listen(ctx context.Context, client apiPb.SchedulersExecutorClient) {
+	for {
+		err := a.receive(ctx, client)
+		if ctx.Err() != nil {
+			return
+		}
+		logger.Errorf("Probe lost tasks stream, will try connect in %s: %s", a.retryInterval.String(), err.Error())
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(a.retryInterval):
+		}
+	}
+}
+
+func (a *app) receive(ctx context.Context, client apiPb.SchedulersExecutorClient) error {
+	stream, err := client.ProbeTasks(ctx, &apiPb.ProbeTasksRequest{
+		Location: a.location,
+	})
+	if err != nil {
+		return err
+	}
+	logger.Infof("Probe receives tasks for location %s", a.location)
+	for {
+		task, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		a.slots <- struct{}{}
+		go func() {
+			defer func() {
+				<-a.slots
+			}()
+			a.execute(ctx, client, task)
+		}()
+	}
+}
+
+func (a *app) execute(ctx context.Context, client apiPb.SchedulersExecutorClient, task *apiPb.ProbeTask) {
+	snapshot := a.test(task)
+	resultCtx, cancel := helpers.TimeoutContext(ctx, resultTimeout)
+	defer cancel()
+	_, err := client.ProbeResult(resultCtx, &apiPb.ProbeResultRequest{
+		TaskId:   task.GetId(),
+		Location: a.location,
+		Snapshot: snapshot,
+	})
+	if err != nil {
+		logger.Errorf("Could not send result for scheduler id %s: %s", task.GetSchedulerId(), err.Error())
+	}
+}
+
+// Probe and squzy monitoring could be different versions, so broken config is reported as error of check
+func (a *app) test(task *apiPb.ProbeTask) *apiPb.SchedulerSnapshot {
+	config := &scheduler_config_storage.SchedulerConfig{}
+	err := bson.Unmarshal(task.GetConfig(), config)
+	if err != nil {
+		return errorSnapshot(errInvalidConfig)
+	}
+	res := a.tester.Test(config)
+	if res == nil {
+		return errorSnapshot(errNotSupportedCheck)
+	}
+	return res.GetSnapshot()
+}
+
+func errorSnapshot(err error) *apiPb.SchedulerSnapshot {
+	now := timestamp.Now()
+	return &apiPb.SchedulerSnapshot{
+		Code: apiPb.SchedulerCode_ERROR,
+		Error: &apiPb.SchedulerSnapshot_Error{
+			Message: err.Error(),
+		},
+		Meta: &apiPb.SchedulerSnapshot_MetaData{
+			StartTime: now,
+			EndTime:   now,
+		},
+	}
+}
@@ -0,0 +1,155 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"github.com/squzy/squzy/internal/probe"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"io"
+	"testing"
+	"time"
+)
+
+type mockTester struct {
+}
+
+func (m mockTester) Test(config *scheduler_config_storage.SchedulerConfig) *apiPb.SchedulerResponse {
+	if config.Type != apiPb.SchedulerType_TCP {
+		return nil
+	}
+	return &apiPb.SchedulerResponse{
+		SchedulerId: config.ID.Hex(),
+		Snapshot: &apiPb.SchedulerSnapshot{
+			Code: apiPb.SchedulerCode_OK,
+			Type: config.Type,
+		},
+	}
+}
+
+type mockTasksStream struct {
+	grpc.ClientStream
+	tasks chan *apiPb.ProbeTask
+}
+
+func (m *mockTasksStream) Recv() (*apiPb.ProbeTask, error) {
+	task, ok := <-m.tasks
+	if !ok {
+		return nil, io.EOF
+	}
+	return task, nil
+}
+
+type mockClient struct {
+	apiPb.SchedulersExecutorClient
+	streamErr error
+	tasks     chan *apiPb.ProbeTask
+	results   chan *apiPb.ProbeResultRequest
+	connects  chan string
+	tokens    chan []string
+}
+
+func (m *mockClient) ProbeTasks(ctx context.Context, in *apiPb.ProbeTasksRequest, opts ...grpc.CallOption) (apiPb.SchedulersExecutor_ProbeTasksClient, error) {
+	m.connects <- in.Location
+	md, _ := metadata.FromOutgoingContext(ctx)
+	m.tokens <- md.Get(probe.TokenMetadataKey)
+	if m.streamErr != nil {
+		return nil, m.streamErr
+	}
+	return &mockTasksStream{tasks: m.tasks}, nil
+}
+
+func (m *mockClient) ProbeResult(ctx context.Context, in *apiPb.ProbeResultRequest, opts ...grpc.CallOption) (*apiPb.ProbeResultResponse, error) {
+	m.results <- in
+	md, _ := metadata.FromOutgoingContext(ctx)
+	m.tokens <- md.Get(probe.TokenMetadataKey)
+	return nil, errors.New("task is finished")
+}
+
+func newClient() *mockClient {
+	return &mockClient{
+		tasks:    make(chan *apiPb.ProbeTask, 2),
+		results:  make(chan *apiPb.ProbeResultRequest, 2),
+		connects: make(chan string, 10),
+		tokens:   make(chan []string, 10),
+	}
+}
+
+func newTask(t *testing.T, schedulerType apiPb.SchedulerType) *apiPb.ProbeTask {
+	config, err := bson.Marshal(&scheduler_config_storage.SchedulerConfig{
+		ID:   primitive.NewObjectID(),
+		Type: schedulerType,
+	})
+	assert.Nil(t, err)
+	return &apiPb.ProbeTask{Id: "task", Config: config}
+}
+
+func TestNew(t *testing.T) {
+	t.Run("Should: create new application", func(t *testing.T) {
+		assert.NotNil(t, New(nil, nil, "eu", "token", 1))
+	})
+}
+
+func TestApp_Run(t *testing.T) {
+	t.Run("Should: return error because location is empty", func(t *testing.T) {
+		assert.Equal(t, errEmptyLocation, New(nil, mockTester{}, "", "token", 1).Run(context.Background()))
+	})
+	t.Run("Should: return error because token is empty", func(t *testing.T) {
+		assert.Equal(t, errEmptyToken, New(nil, mockTester{}, "eu", "", 1).Run(context.Background()))
+	})
+	t.Run("Should: execute tasks and send results with location", func(t *testing.T) {
+		client := newClient()
+		a := New([]apiPb.SchedulersExecutorClient{client}, mockTester{}, "eu", "token", 1)
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() {
+			done <- a.Run(ctx)
+		}()
+		client.tasks <- newTask(t, apiPb.SchedulerType_TCP)
+		client.tasks <- &apiPb.ProbeTask{Id: "broken", Config: []byte("{")}
+		first := <-client.results
+		assert.Equal(t, "task", first.TaskId)
+		assert.Equal(t, "eu", first.Location)
+		assert.Equal(t, apiPb.SchedulerCode_OK, first.Snapshot.Code)
+		second := <-client.results
+		assert.Equal(t, "broken", second.TaskId)
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, second.Snapshot.Code)
+		assert.Equal(t, errInvalidConfig.Error(), second.Snapshot.Error.Message)
+		// Stream and both results
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, []string{"token"}, <-client.tokens)
+		}
+		cancel()
+		close(client.tasks)
+		assert.Equal(t, context.Canceled, <-done)
+	})
+	t.Run("Should: reconnect after stream error", func(t *testing.T) {
+		client := newClient()
+		client.streamErr = errors.New("unavailable")
+		a := New([]apiPb.SchedulersExecutorClient{client}, mockTester{}, "eu", "token", 1)
+		a.retryInterval = time.Millisecond
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() {
+			done <- a.Run(ctx)
+		}()
+		assert.Equal(t, "eu", <-client.connects)
+		assert.Equal(t, "eu", <-client.connects)
+		cancel()
+		assert.Equal(t, context.Canceled, <-done)
+	})
+}
+
+func TestApp_test(t *testing.T) {
+	t.Run("Should: return error because type is not supported", func(t *testing.T) {
+		a := New(nil, mockTester{}, "eu", "token", 1)
+		snapshot := a.test(newTask(t, apiPb.SchedulerType_HTTP))
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, snapshot.Code)
+		assert.Equal(t, errNotSupportedCheck.Error(), snapshot.Error.Message)
+	})
+}
@@ -0,0 +1,73 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	ENV_MONITORING_HOSTS = "SQUZY_MONITORING_HOSTS"
+	ENV_PROBE_LOCATION   = "SQUZY_PROBE_LOCATION"
+	ENV_PROBE_WORKERS    = "SQUZY_PROBE_WORKERS"
+	ENV_PROBE_TOKEN      = "SQUZY_PROBE_TOKEN"
+
+	defaultWorkers = 100
+)
+
+type Config interface {
+	// Every replica of squzy monitoring, probe receives checks from all of them
+	GetMonitoringHosts() []string
+	GetLocation() string
+	// Same as token of squzy monitoring
+	GetToken() string
+	// How many checks are executed at same time
+	GetWorkers() int
+}
+
+type cfg struct {
+	monitoringHosts []string
+	location        string
+	token           string
+	workers         int
+}
+
+func (c *cfg) GetMonitoringHosts() []string {
+	return c.monitoringHosts
+}
+
+func (c *cfg) GetLocation() string {
+	return c.location
+}
+
+func (c *cfg) GetToken() string {
+	return c.token
+}
+
+func (c *cfg) GetWorkers() int {
+	return c.workers
+}
+
+func New() Config {
+	hosts := []string{}
+	for _, host := range strings.Split(os.Getenv(ENV_MONITORING_HOSTS), ",") {
+		host = strings.TrimSpace(host)
+		if host != "" {
+			hosts = append(hosts, host)
+		}
+	}
+	workers := defaultWorkers
+	workersValue := os.Getenv(ENV_PROBE_WORKERS)
+	if workersValue != "" {
+		i, err := strconv.ParseInt(workersValue, 10, 32)
+		if err == nil && i > 0 {
+			workers = int(i)
+		}
+	}
+	return &cfg{
+		monitoringHosts: hosts,
+		location:        os.Getenv(ENV_PROBE_LOCATION),
+		token:           os.Getenv(ENV_PROBE_TOKEN),
+		workers:         workers,
+	}
+}
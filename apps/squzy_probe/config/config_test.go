@@ -0,0 +1,41 @@
+package config
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("Should: return default value", func(t *testing.T) {
+		s := New()
+		assert.Equal(t, []string{}, s.GetMonitoringHosts())
+		assert.Equal(t, "", s.GetLocation())
+		assert.Equal(t, "", s.GetToken())
+		assert.Equal(t, defaultWorkers, s.GetWorkers())
+	})
+	t.Run("Should: read values from env", func(t *testing.T) {
+		_ = os.Setenv(ENV_MONITORING_HOSTS, "monitoring-1:9094, monitoring-2:9094,")
+		_ = os.Setenv(ENV_PROBE_LOCATION, "eu-west")
+		_ = os.Setenv(ENV_PROBE_WORKERS, "5")
+		_ = os.Setenv(ENV_PROBE_TOKEN, "token")
+		defer func() {
+			_ = os.Unsetenv(ENV_MONITORING_HOSTS)
+			_ = os.Unsetenv(ENV_PROBE_LOCATION)
+			_ = os.Unsetenv(ENV_PROBE_WORKERS)
+			_ = os.Unsetenv(ENV_PROBE_TOKEN)
+		}()
+		s := New()
+		assert.Equal(t, []string{"monitoring-1:9094", "monitoring-2:9094"}, s.GetMonitoringHosts())
+		assert.Equal(t, "eu-west", s.GetLocation())
+		assert.Equal(t, 5, s.GetWorkers())
+		assert.Equal(t, "token", s.GetToken())
+	})
+	t.Run("Should: ignore wrong workers", func(t *testing.T) {
+		_ = os.Setenv(ENV_PROBE_WORKERS, "-1")
+		defer func() {
+			_ = os.Unsetenv(ENV_PROBE_WORKERS)
+		}()
+		assert.Equal(t, defaultWorkers, New().GetWorkers())
+	})
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"github.com/squzy/squzy/apps/squzy_probe/application"
+	"github.com/squzy/squzy/apps/squzy_probe/config"
+	"github.com/squzy/squzy/apps/squzy_probe/version"
+	"github.com/squzy/squzy/internal/httptools"
+	"github.com/squzy/squzy/internal/job"
+	job_executor "github.com/squzy/squzy/internal/job-executor"
+	"github.com/squzy/squzy/internal/logger"
+	"github.com/squzy/squzy/internal/parsers"
+	"github.com/squzy/squzy/internal/semaphore"
+	sitemap_storage "github.com/squzy/squzy/internal/sitemap-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"google.golang.org/grpc"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+const (
+	day = time.Hour * 24
+)
+
+func main() {
+	cfg := config.New()
+	httpPackage := httptools.New(version.GetVersion())
+	// Probe only executes checks, results are sent back to squzy monitoring
+	tester := job_executor.NewExecutor(
+		nil,
+		sitemap_storage.New(
+			day,
+			httpPackage,
+			parsers.NewSiteMapParser(),
+		),
+		httpPackage,
+		semaphore.NewSemaphore,
+		nil,
+		job.ExecTCP,
+		job.ExecGrpc,
+		job.ExecHTTP,
+		job.ExecSiteMap,
+		job.ExecHTTPValue,
+		job.ExecSSL,
+		job.ExecCassandra,
+		job.ExecMongo,
+		job.ExecMysql,
+		job.ExecPostgres,
+		job.ExecDNS,
+		job.ExecUDP,
+		job.ExecRedis,
+		job.ExecHTTPScenario,
+		nil,
+		nil,
+	)
+	clients := []apiPb.SchedulersExecutorClient{}
+	for _, host := range cfg.GetMonitoringHosts() {
+		// Connection is established lazily, so probe could start before squzy monitoring
+		conn, err := grpc.Dial(host, grpc.WithInsecure())
+		if err != nil {
+			logger.Fatal(err.Error())
+		}
+		defer conn.Close()
+		clients = append(clients, apiPb.NewSchedulersExecutorClient(conn))
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, syscall.SIGTERM, syscall.SIGINT)
+	go func() {
+		<-interrupt
+		cancel()
+	}()
+	app := application.New(clients, tester, cfg.GetLocation(), cfg.GetToken(), cfg.GetWorkers())
+	err := app.Run(ctx)
+	if err != nil && err != context.Canceled {
+		logger.Fatal(err.Error())
+	}
+}
@@ -17,8 +17,29 @@ type Cache interface {
 	InsertSchedule(data *apiPb.InsertScheduleWithIdRequest) error
 	GetScheduleById(data *apiPb.GetScheduleWithIdRequest) (*apiPb.GetScheduleWithIdResponse, error)
 	DeleteScheduleById(data *apiPb.DeleteScheduleWithIdRequest) error
+	// Atomically move schedules forward if nobody did it before, only replica which claimed tick should execute it
+	ClaimSchedules(data []*apiPb.ClaimScheduleWithIdRequest) ([]*apiPb.ClaimScheduleWithIdResponse, error)
 }
 
+var (
+	// Returned when schedule was never inserted or already deleted
+	ErrScheduleNotFound = errors.New("SCHEDULE_NOT_FOUND")
+)
+
+var (
+	claimScript = redis.NewScript(`
+local current = redis.call("GET", KEYS[1])
+if current == ARGV[1] then
+	redis.call("SET", KEYS[1], ARGV[2])
+	return {1, tonumber(ARGV[2])}
+end
+if current then
+	return {0, tonumber(current)}
+end
+return {0, -1}
+`)
+)
+
 func New(ca interface{}) (Cache, error) {
 	client, ok := ca.(*redis.Client)
 	if !ok {
@@ -37,6 +58,9 @@ func (c *Redis) GetScheduleById(data *apiPb.GetScheduleWithIdRequest) (*apiPb.Ge
 	res := c.Client.Get(context.Background(), data.GetId())
 
 	if err := res.Err(); err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrScheduleNotFound
+		}
 		return nil, err
 	}
 
@@ -53,6 +77,41 @@ func (c *Redis) GetScheduleById(data *apiPb.GetScheduleWithIdRequest) (*apiPb.Ge
 	}, nil
 }
 
+func (c *Redis) ClaimSchedules(data []*apiPb.ClaimScheduleWithIdRequest) ([]*apiPb.ClaimScheduleWithIdResponse, error) {
+	ctx := context.Background()
+	pipe := c.Client.Pipeline()
+	cmds := make([]*redis.Cmd, len(data))
+	for i, claim := range data {
+		cmds[i] = claimScript.Eval(
+			ctx,
+			pipe,
+			[]string{claim.GetId()},
+			claim.GetScheduledCurrent().GetSeconds(),
+			claim.GetScheduledNext().GetSeconds(),
+		)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+	res := make([]*apiPb.ClaimScheduleWithIdResponse, len(cmds))
+	for i, cmd := range cmds {
+		values, err := cmd.Int64Slice()
+		if err != nil {
+			return nil, err
+		}
+		res[i] = &apiPb.ClaimScheduleWithIdResponse{
+			Claimed: values[0] == 1,
+		}
+		if values[1] >= 0 {
+			res[i].ScheduledNext = &timestamppb.Timestamp{
+				Seconds: values[1],
+				Nanos:   0,
+			}
+		}
+	}
+	return res, nil
+}
+
 func (c *Redis) DeleteScheduleById(data *apiPb.DeleteScheduleWithIdRequest) error {
 	res := c.Client.Del(context.Background(), data.GetId())
 	return res.Err()
@@ -2,11 +2,13 @@ package cache
 
 import (
 	"errors"
+	"github.com/alicebob/miniredis/v2"
 	"github.com/go-redis/redis/v8"
 	"github.com/go-redis/redismock/v8"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"github.com/stretchr/testify/assert"
 	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"sync"
 	"testing"
 	"time"
 )
@@ -84,6 +86,11 @@ func TestRedis_InsertScheduleError(t *testing.T) {
 	assert.ErrorContains(t, err, "GetScheduleWithIdRequest")
 	assert.Nil(t, res, "GetScheduleWithIdRequest")
 
+	mock.ExpectGet(data2.Id).RedisNil()
+	res, err = c.GetScheduleById(data2)
+	assert.Equal(t, ErrScheduleNotFound, err)
+	assert.Nil(t, res)
+
 	mock.ExpectGet(data2.Id).SetVal("string")
 	res, err = c.GetScheduleById(data2)
 	assert.ErrorContains(t, err, "strconv.ParseInt: parsing \"string\"")
@@ -96,3 +103,104 @@ func TestRedis_InsertScheduleError(t *testing.T) {
 	err = c.DeleteScheduleById(data3)
 	assert.ErrorContains(t, err, "DeleteScheduleWithIdRequest")
 }
+
+func TestRedis_ClaimSchedules(t *testing.T) {
+	server, err := miniredis.Run()
+	assert.Nil(t, err)
+	defer server.Close()
+	c := &Redis{
+		Client: redis.NewClient(&redis.Options{
+			Addr: server.Addr(),
+		}),
+	}
+	current := &timestamp.Timestamp{Seconds: 1294960918}
+	next := &timestamp.Timestamp{Seconds: 1294960928}
+
+	t.Run("Should: not claim missing schedule", func(t *testing.T) {
+		res, err := c.ClaimSchedules([]*apiPb.ClaimScheduleWithIdRequest{
+			{
+				Id:               "missing",
+				ScheduledCurrent: current,
+				ScheduledNext:    next,
+			},
+		})
+		assert.Nil(t, err)
+		assert.False(t, res[0].Claimed)
+		assert.Nil(t, res[0].ScheduledNext)
+	})
+	t.Run("Should: claim tick just once between replicas", func(t *testing.T) {
+		err := c.InsertSchedule(&apiPb.InsertScheduleWithIdRequest{
+			Id:            "id",
+			ScheduledNext: current,
+		})
+		assert.Nil(t, err)
+		claimed := make(chan *apiPb.ClaimScheduleWithIdResponse, 10)
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				res, err := c.ClaimSchedules([]*apiPb.ClaimScheduleWithIdRequest{
+					{
+						Id:               "id",
+						ScheduledCurrent: current,
+						ScheduledNext:    next,
+					},
+				})
+				assert.Nil(t, err)
+				claimed <- res[0]
+			}()
+		}
+		wg.Wait()
+		close(claimed)
+		count := 0
+		for res := range claimed {
+			if res.Claimed {
+				count++
+			}
+			assert.Equal(t, next.Seconds, res.ScheduledNext.Seconds)
+		}
+		assert.Equal(t, 1, count)
+		res, err := c.GetScheduleById(&apiPb.GetScheduleWithIdRequest{
+			Id: "id",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, next.Seconds, res.ScheduledNext.Seconds)
+	})
+	t.Run("Should: claim batch", func(t *testing.T) {
+		for _, id := range []string{"first", "second"} {
+			err := c.InsertSchedule(&apiPb.InsertScheduleWithIdRequest{
+				Id:            id,
+				ScheduledNext: current,
+			})
+			assert.Nil(t, err)
+		}
+		res, err := c.ClaimSchedules([]*apiPb.ClaimScheduleWithIdRequest{
+			{
+				Id:               "first",
+				ScheduledCurrent: current,
+				ScheduledNext:    next,
+			},
+			{
+				Id:               "second",
+				ScheduledCurrent: next,
+				ScheduledNext:    next,
+			},
+		})
+		assert.Nil(t, err)
+		assert.True(t, res[0].Claimed)
+		assert.False(t, res[1].Claimed)
+		assert.Equal(t, current.Seconds, res[1].ScheduledNext.Seconds)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		server.Close()
+		_, err := c.ClaimSchedules([]*apiPb.ClaimScheduleWithIdRequest{
+			{
+				Id:               "id",
+				ScheduledCurrent: next,
+				ScheduledNext:    current,
+			},
+		})
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,180 @@
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/squzy/squzy/internal/logger"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// How often changed schedules are written to file
+	flushInterval = time.Second
+)
+
+// Memory keeps schedules inside process, enough for single replica or dev installation.
+// With file path schedules are flushed to file and loaded on start, so next runs survive restart
+type Memory interface {
+	Cache
+	// Should write schedules to file and stop flushing
+	Close() error
+}
+
+type memory struct {
+	mutex     sync.Mutex
+	schedules map[string]int64
+	path      string
+	dirty     bool
+	quitCh    chan struct{}
+	closeOnce sync.Once
+}
+
+// NewMemory create in process cache, empty path means schedules are not persisted
+func NewMemory(path string) (Memory, error) {
+	m := &memory{
+		schedules: map[string]int64{},
+		path:      path,
+		quitCh:    make(chan struct{}),
+	}
+	if path == "" {
+		return m, nil
+	}
+	err := m.load()
+	if err != nil {
+		return nil, err
+	}
+	go m.loop()
+	return m, nil
+}
+
+func (m *memory) load() error {
+	data, err := os.ReadFile(m.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, &m.schedules)
+}
+
+func (m *memory) loop() {
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.quitCh:
+			return
+		case <-ticker.C:
+			err := m.flush()
+			if err != nil {
+				logger.Error("could not flush schedules: " + err.Error())
+			}
+		}
+	}
+}
+
+func (m *memory) flush() error {
+	m.mutex.Lock()
+	if !m.dirty {
+		m.mutex.Unlock()
+		return nil
+	}
+	data, err := json.Marshal(m.schedules)
+	m.dirty = false
+	m.mutex.Unlock()
+	if err != nil {
+		return err
+	}
+	// Write to temporary file first, so crash during write does not break previous state
+	tmp := m.path + ".tmp"
+	err = os.WriteFile(tmp, data, 0600)
+	if err != nil {
+		m.markDirty()
+		return err
+	}
+	err = os.Rename(tmp, m.path)
+	if err != nil {
+		m.markDirty()
+		return err
+	}
+	return nil
+}
+
+func (m *memory) markDirty() {
+	m.mutex.Lock()
+	m.dirty = true
+	m.mutex.Unlock()
+}
+
+func (m *memory) Close() error {
+	if m.path == "" {
+		return nil
+	}
+	m.closeOnce.Do(func() {
+		close(m.quitCh)
+	})
+	return m.flush()
+}
+
+func (m *memory) InsertSchedule(data *apiPb.InsertScheduleWithIdRequest) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.schedules[data.GetId()] = data.GetScheduledNext().GetSeconds()
+	m.dirty = true
+	return nil
+}
+
+func (m *memory) GetScheduleById(data *apiPb.GetScheduleWithIdRequest) (*apiPb.GetScheduleWithIdResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	value, ok := m.schedules[data.GetId()]
+	if !ok {
+		return nil, ErrScheduleNotFound
+	}
+	return &apiPb.GetScheduleWithIdResponse{
+		ScheduledNext: &timestamppb.Timestamp{
+			Seconds: value,
+			Nanos:   0,
+		},
+	}, nil
+}
+
+func (m *memory) DeleteScheduleById(data *apiPb.DeleteScheduleWithIdRequest) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.schedules, data.GetId())
+	m.dirty = true
+	return nil
+}
+
+func (m *memory) ClaimSchedules(data []*apiPb.ClaimScheduleWithIdRequest) ([]*apiPb.ClaimScheduleWithIdResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	res := make([]*apiPb.ClaimScheduleWithIdResponse, len(data))
+	for i, claim := range data {
+		res[i] = &apiPb.ClaimScheduleWithIdResponse{}
+		current, ok := m.schedules[claim.GetId()]
+		if !ok {
+			continue
+		}
+		if current == claim.GetScheduledCurrent().GetSeconds() {
+			current = claim.GetScheduledNext().GetSeconds()
+			m.schedules[claim.GetId()] = current
+			m.dirty = true
+			res[i].Claimed = true
+		}
+		res[i].ScheduledNext = &timestamppb.Timestamp{
+			Seconds: current,
+			Nanos:   0,
+		}
+	}
+	return res, nil
+}
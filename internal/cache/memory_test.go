@@ -0,0 +1,128 @@
+package cache
+
+import (
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestNewMemory(t *testing.T) {
+	t.Run("Should: create cache without file", func(t *testing.T) {
+		c, err := NewMemory("")
+		assert.Nil(t, err)
+		assert.Implements(t, (*Cache)(nil), c)
+		assert.Nil(t, c.Close())
+	})
+	t.Run("Should: create cache with missing file", func(t *testing.T) {
+		c, err := NewMemory(filepath.Join(t.TempDir(), "schedules.json"))
+		assert.Nil(t, err)
+		assert.Nil(t, c.Close())
+	})
+	t.Run("Should: return error because file is broken", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "schedules.json")
+		assert.Nil(t, os.WriteFile(path, []byte("{"), 0600))
+		_, err := NewMemory(path)
+		assert.NotNil(t, err)
+	})
+	t.Run("Should: return error because path is directory", func(t *testing.T) {
+		_, err := NewMemory(t.TempDir())
+		assert.NotNil(t, err)
+	})
+}
+
+func TestMemory_InsertSchedule(t *testing.T) {
+	c, _ := NewMemory("")
+	t.Run("Should: return not found", func(t *testing.T) {
+		_, err := c.GetScheduleById(&apiPb.GetScheduleWithIdRequest{Id: "id"})
+		assert.Equal(t, ErrScheduleNotFound, err)
+	})
+	t.Run("Should: insert, get and delete schedule", func(t *testing.T) {
+		err := c.InsertSchedule(&apiPb.InsertScheduleWithIdRequest{
+			Id:            "id",
+			ScheduledNext: &timestamp.Timestamp{Seconds: 1294960918, Nanos: 5},
+		})
+		assert.Nil(t, err)
+		res, err := c.GetScheduleById(&apiPb.GetScheduleWithIdRequest{Id: "id"})
+		assert.Nil(t, err)
+		assert.Equal(t, &apiPb.GetScheduleWithIdResponse{
+			ScheduledNext: &timestamp.Timestamp{Seconds: 1294960918},
+		}, res)
+		assert.Nil(t, c.DeleteScheduleById(&apiPb.DeleteScheduleWithIdRequest{Id: "id"}))
+		_, err = c.GetScheduleById(&apiPb.GetScheduleWithIdRequest{Id: "id"})
+		assert.Equal(t, ErrScheduleNotFound, err)
+	})
+}
+
+func TestMemory_ClaimSchedules(t *testing.T) {
+	c, _ := NewMemory("")
+	current := &timestamp.Timestamp{Seconds: 1294960918}
+	next := &timestamp.Timestamp{Seconds: 1294960928}
+	_ = c.InsertSchedule(&apiPb.InsertScheduleWithIdRequest{Id: "id", ScheduledNext: current})
+
+	t.Run("Should: not claim missing schedule", func(t *testing.T) {
+		res, err := c.ClaimSchedules([]*apiPb.ClaimScheduleWithIdRequest{
+			{Id: "missing", ScheduledCurrent: current, ScheduledNext: next},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []*apiPb.ClaimScheduleWithIdResponse{{}}, res)
+	})
+	t.Run("Should: claim only once", func(t *testing.T) {
+		claim := []*apiPb.ClaimScheduleWithIdRequest{
+			{Id: "id", ScheduledCurrent: current, ScheduledNext: next},
+		}
+		res, err := c.ClaimSchedules(claim)
+		assert.Nil(t, err)
+		assert.True(t, res[0].Claimed)
+		assert.Equal(t, next.Seconds, res[0].ScheduledNext.Seconds)
+		res, err = c.ClaimSchedules(claim)
+		assert.Nil(t, err)
+		assert.False(t, res[0].Claimed)
+		assert.Equal(t, next.Seconds, res[0].ScheduledNext.Seconds)
+	})
+}
+
+func TestMemory_Close(t *testing.T) {
+	t.Run("Should: keep schedules after restart", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "schedules.json")
+		c, err := NewMemory(path)
+		assert.Nil(t, err)
+		_ = c.InsertSchedule(&apiPb.InsertScheduleWithIdRequest{
+			Id:            "id",
+			ScheduledNext: &timestamp.Timestamp{Seconds: 1294960918},
+		})
+		assert.Nil(t, c.Close())
+		assert.Nil(t, c.Close())
+
+		restarted, err := NewMemory(path)
+		assert.Nil(t, err)
+		defer restarted.Close()
+		res, err := restarted.GetScheduleById(&apiPb.GetScheduleWithIdRequest{Id: "id"})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1294960918), res.ScheduledNext.Seconds)
+	})
+	t.Run("Should: flush schedules in background", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "schedules.json")
+		c, err := NewMemory(path)
+		assert.Nil(t, err)
+		defer c.Close()
+		_ = c.InsertSchedule(&apiPb.InsertScheduleWithIdRequest{
+			Id:            "id",
+			ScheduledNext: &timestamp.Timestamp{Seconds: 1294960918},
+		})
+		time.Sleep(flushInterval + time.Millisecond*200)
+		data, err := os.ReadFile(path)
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{"id":1294960918}`, string(data))
+	})
+	t.Run("Should: return error because cant write file", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "missing")
+		c, err := NewMemory(filepath.Join(dir, "schedules.json"))
+		assert.Nil(t, err)
+		_ = c.DeleteScheduleById(&apiPb.DeleteScheduleWithIdRequest{Id: "id"})
+		assert.NotNil(t, c.Close())
+	})
+}
@@ -52,7 +52,11 @@ func (c *Clickhouse) Migrate() error {
 				error   String,
 				meta_start_time   Int64,
 				meta_end_time   Int64,
-				meta_value  Array(UInt8)
+				meta_value  Array(UInt8),
+				meta_attempts Int32,
+				meta_attempt_errors Array(UInt8),
+				meta_skipped_by String,
+				meta_locations Array(UInt8)
 			) ENGINE = MergeTree ORDER BY tuple()`)
 	if err != nil {
 		return err
@@ -193,6 +197,16 @@ func (c *Clickhouse) Migrate() error {
 	if err != nil {
 		return err
 	}
+
+	// Tables created by previous versions do not have retry, dependency and probe columns
+	_, err = c.Db.Exec(`ALTER TABLE snapshots
+				ADD COLUMN IF NOT EXISTS meta_attempts Int32,
+				ADD COLUMN IF NOT EXISTS meta_attempt_errors Array(UInt8),
+				ADD COLUMN IF NOT EXISTS meta_skipped_by String,
+				ADD COLUMN IF NOT EXISTS meta_locations Array(UInt8)`)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -359,6 +359,10 @@ func (s *SuiteClickhouse) Test_Migrate_12() {
 		WithArgs().
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	query = fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s`, dbIncidentHistoryCollection)
+	s.mock.ExpectExec(regexp.QuoteMeta(query)).
+		WithArgs().
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	query = fmt.Sprintf(`ALTER TABLE %s`, dbSnapshotCollection)
 	s.mock.ExpectExec(regexp.QuoteMeta(query)).
 		WithArgs().
 		WillReturnResult(sqlmock.NewResult(1, 1))
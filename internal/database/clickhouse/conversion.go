@@ -2,6 +2,7 @@ package clickhouse
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"github.com/golang/protobuf/jsonpb"
 	_struct "github.com/golang/protobuf/ptypes/struct"
@@ -156,10 +157,19 @@ func convertToSnapshot(request *apiPb.SchedulerSnapshot, schedulerID string) (*S
 		Type:          int32(request.GetType()),
 		MetaStartTime: startTime.UnixNano(),
 		MetaEndTime:   endTime.UnixNano(),
+		MetaAttempts:  request.GetMeta().GetAttempts(),
+		MetaSkippedBy: request.GetMeta().GetSkippedBy(),
 	}
 	if request.GetError() != nil {
 		res.Error = request.GetError().GetMessage()
 	}
+	if len(request.GetMeta().GetAttemptErrors()) > 0 {
+		// Marshal of string slice could not fail
+		res.MetaAttemptErrors, _ = json.Marshal(request.GetMeta().GetAttemptErrors())
+	}
+	if len(request.GetMeta().GetLocations()) > 0 {
+		res.MetaLocations, _ = json.Marshal(request.GetMeta().GetLocations())
+	}
 
 	var b bytes.Buffer
 	err = (&jsonpb.Marshaler{}).Marshal(&b, request.GetMeta().GetValue())
@@ -190,8 +200,16 @@ func convertFromSnapshot(snapshot *Snapshot) *apiPb.SchedulerSnapshot {
 		Meta: &apiPb.SchedulerSnapshot_MetaData{
 			StartTime: startTime,
 			EndTime:   endTime,
+			Attempts:  snapshot.MetaAttempts,
+			SkippedBy: snapshot.MetaSkippedBy,
 		},
 	}
+	if len(snapshot.MetaAttemptErrors) > 0 {
+		_ = json.Unmarshal(snapshot.MetaAttemptErrors, &res.Meta.AttemptErrors)
+	}
+	if len(snapshot.MetaLocations) > 0 {
+		_ = json.Unmarshal(snapshot.MetaLocations, &res.Meta.Locations)
+	}
 	if snapshot.Error != "" {
 		res.Error = &apiPb.SchedulerSnapshot_Error{
 			Message: snapshot.Error,
@@ -223,8 +241,9 @@ func convertFromUptimeResult(uptimeResult *UptimeResult, countAll int64) *apiPb.
 		}
 	}
 	return &apiPb.GetSchedulerUptimeResponse{
-		Uptime:  float64(uptimeResult.Count) / float64(countAll),
-		Latency: latency,
+		Uptime:   float64(uptimeResult.Count) / float64(countAll),
+		Latency:  latency,
+		Degraded: float64(uptimeResult.Degraded) / float64(countAll),
 	}
 }
 
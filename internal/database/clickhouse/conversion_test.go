@@ -152,6 +152,66 @@ func TestConvertFromClickhouseSnapshots(t *testing.T) {
 	})
 }
 
+func TestConvertSnapshotAttempts(t *testing.T) {
+	t.Run("Should: keep attempts after conversion", func(t *testing.T) {
+		now := ptypes.TimestampNow()
+		snapshot, err := ConvertToSnapshot(&apiPb.SchedulerResponse{
+			SchedulerId: "id",
+			Snapshot: &apiPb.SchedulerSnapshot{
+				Code: apiPb.SchedulerCode_ERROR,
+				Meta: &apiPb.SchedulerSnapshot_MetaData{
+					StartTime:     now,
+					EndTime:       now,
+					Attempts:      3,
+					AttemptErrors: []string{"first", "second", "third"},
+				},
+			},
+		})
+		assert.NoError(t, err)
+		res := ConvertFromSnapshots([]*Snapshot{snapshot})
+		assert.Equal(t, int32(3), res[0].Meta.Attempts)
+		assert.Equal(t, []string{"first", "second", "third"}, res[0].Meta.AttemptErrors)
+	})
+	t.Run("Should: keep skipped by after conversion", func(t *testing.T) {
+		snapshot, err := ConvertToSnapshot(&apiPb.SchedulerResponse{
+			SchedulerId: "id",
+			Snapshot: &apiPb.SchedulerSnapshot{
+				Code: apiPb.SchedulerCode_SKIPPED,
+				Meta: &apiPb.SchedulerSnapshot_MetaData{
+					StartTime: ptypes.TimestampNow(),
+					EndTime:   ptypes.TimestampNow(),
+					SkippedBy: "parent",
+				},
+			},
+		})
+		assert.NoError(t, err)
+		res := ConvertFromSnapshots([]*Snapshot{snapshot})
+		assert.Equal(t, "parent", res[0].Meta.SkippedBy)
+	})
+	t.Run("Should: keep locations after conversion", func(t *testing.T) {
+		snapshot, err := ConvertToSnapshot(&apiPb.SchedulerResponse{
+			SchedulerId: "id",
+			Snapshot: &apiPb.SchedulerSnapshot{
+				Code: apiPb.SchedulerCode_ERROR,
+				Meta: &apiPb.SchedulerSnapshot_MetaData{
+					StartTime: ptypes.TimestampNow(),
+					EndTime:   ptypes.TimestampNow(),
+					Locations: []*apiPb.LocationResult{
+						{Location: "eu", Code: apiPb.SchedulerCode_OK},
+						{Location: "us", Code: apiPb.SchedulerCode_ERROR, Error: "CONNECTION_TIMEOUT"},
+					},
+				},
+			},
+		})
+		assert.NoError(t, err)
+		res := ConvertFromSnapshots([]*Snapshot{snapshot})
+		assert.Len(t, res[0].Meta.Locations, 2)
+		assert.Equal(t, "us", res[0].Meta.Locations[1].Location)
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res[0].Meta.Locations[1].Code)
+		assert.Equal(t, "CONNECTION_TIMEOUT", res[0].Meta.Locations[1].Error)
+	})
+}
+
 func TestConvertFromUptimeResult(t *testing.T) {
 	t.Run("Test: no error for nan", func(t *testing.T) {
 		res := convertFromUptimeResult(&UptimeResult{
@@ -174,6 +234,15 @@ func TestConvertFromUptimeResult(t *testing.T) {
 		}, 10)
 		assert.NotNil(t, res)
 	})
+	t.Run("Should: return part of degraded snapshots", func(t *testing.T) {
+		res := convertFromUptimeResult(&UptimeResult{
+			Count:    8,
+			Degraded: 2,
+			Latency:  "10000",
+		}, 10)
+		assert.Equal(t, 0.8, res.Uptime)
+		assert.Equal(t, 0.2, res.Degraded)
+	})
 }
 
 func TestConvertToClickhouseStatRequest(t *testing.T) {
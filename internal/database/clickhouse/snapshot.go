@@ -18,17 +18,30 @@ type Snapshot struct {
 	MetaStartTime int64
 	MetaEndTime   int64
 	MetaValue     []byte
+	MetaAttempts  int32
+	// JSON array with error of every failed attempt
+	MetaAttemptErrors []byte
+	MetaSkippedBy     string
+	// JSON array with result of every probe location
+	MetaLocations []byte
 }
 
 type UptimeResult struct {
 	Count   int64
 	Latency string
+	// Count of WARNING snapshots, they are part of Count
+	Degraded int64
 }
 
 var (
-	snapshotFields                    = "id, created_at, updated_at, scheduler_id, code, type, error, meta_start_time, meta_end_time, meta_value"
+	snapshotFields                    = "id, created_at, updated_at, scheduler_id, code, type, error, meta_start_time, meta_end_time, meta_value, meta_attempts, meta_attempt_errors, meta_skipped_by, meta_locations"
 	snapshotSchedulerIdString         = fmt.Sprintf(`"scheduler_id" = ?`)
 	snapshotMetaStartTimeFilterString = fmt.Sprintf(`"meta_start_time" BETWEEN ? and ?`)
+	// Snapshots taken during maintenance window are not counted in uptime
+	snapshotNotMaintenanceString = fmt.Sprintf(`"code" != '%d'`, apiPb.SchedulerCode_MAINTENANCE)
+	// WARNING snapshots are passed, so they are counted in uptime too
+	snapshotPassedString  = fmt.Sprintf(`"code" IN ('%d', '%d')`, apiPb.SchedulerCode_OK, apiPb.SchedulerCode_WARNING)
+	snapshotWarningString = fmt.Sprintf(`"code" = '%d'`, apiPb.SchedulerCode_WARNING)
 
 	snapOrderMap = map[apiPb.SortSchedulerList]string{
 		apiPb.SortSchedulerList_SORT_SCHEDULER_LIST_UNSPECIFIED: fmt.Sprintf(`"%s"."meta_start_time"`, dbSnapshotCollection),
@@ -60,7 +73,7 @@ func (c *Clickhouse) insertSnapshot(now time.Time, snapshot *Snapshot) error {
 		return err
 	}
 
-	q := fmt.Sprintf(`INSERT INTO "%s" (%s) VALUES ($0, $1, $2, $3, $4, $5, $6, $7, $8, $9)`, dbSnapshotCollection, snapshotFields)
+	q := fmt.Sprintf(`INSERT INTO "%s" (%s) VALUES ($0, $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`, dbSnapshotCollection, snapshotFields)
 	_, err = tx.Exec(q,
 		clickhouse.UUID(uuid.New().String()),
 		now,
@@ -72,6 +85,10 @@ func (c *Clickhouse) insertSnapshot(now time.Time, snapshot *Snapshot) error {
 		snapshot.MetaStartTime,
 		snapshot.MetaEndTime,
 		snapshot.MetaValue,
+		snapshot.MetaAttempts,
+		snapshot.MetaAttemptErrors,
+		snapshot.MetaSkippedBy,
+		snapshot.MetaLocations,
 	)
 	if err != nil {
 		return err
@@ -121,7 +138,8 @@ func (c *Clickhouse) GetSnapshots(request *apiPb.GetSchedulerInformationRequest)
 		snp := &Snapshot{}
 		if err := rows.Scan(&snp.Model.ID, &snp.Model.CreatedAt, &snp.Model.UpdatedAt,
 			&snp.SchedulerID, &snp.Code, &snp.Type, &snp.Error,
-			&snp.MetaStartTime, &snp.MetaEndTime, &snp.MetaValue); err != nil {
+			&snp.MetaStartTime, &snp.MetaEndTime, &snp.MetaValue,
+			&snp.MetaAttempts, &snp.MetaAttemptErrors, &snp.MetaSkippedBy, &snp.MetaLocations); err != nil {
 			logger.Error(err.Error())
 			return nil, -1, err
 		}
@@ -181,9 +199,10 @@ func (c *Clickhouse) GetSnapshotsUptime(request *apiPb.GetSchedulerUptimeRequest
 
 func (c *Clickhouse) countAllSnapshots(request *apiPb.GetSchedulerUptimeRequest, timeFrom int64, timeTo int64) (int64, error) {
 	var count int64
-	rows, err := c.Db.Query(fmt.Sprintf(`SELECT count(*) FROM "%s" WHERE %s AND (%s)`,
+	rows, err := c.Db.Query(fmt.Sprintf(`SELECT count(*) FROM "%s" WHERE %s AND %s AND (%s)`,
 		dbSnapshotCollection,
 		snapshotSchedulerIdString,
+		snapshotNotMaintenanceString,
 		snapshotMetaStartTimeFilterString),
 		request.SchedulerId,
 		timeFrom,
@@ -211,10 +230,11 @@ func (c *Clickhouse) countAllSnapshots(request *apiPb.GetSchedulerUptimeRequest,
 func (c *Clickhouse) countSnapshotsUptime(request *apiPb.GetSchedulerUptimeRequest, timeFrom int64, timeTo int64) (UptimeResult, error) {
 	var result UptimeResult
 
-	rows, err := c.Db.Query(fmt.Sprintf(`SELECT count(*) as "count", avg(meta_end_time-meta_start_time) as "latency" FROM "%s" WHERE %s AND %s %s`,
+	rows, err := c.Db.Query(fmt.Sprintf(`SELECT count(*) as "count", avg(meta_end_time-meta_start_time) as "latency", COUNT(CASE WHEN %s THEN 1 ELSE NULL END) as "degraded" FROM "%s" WHERE %s AND %s AND %s`,
+		snapshotWarningString,
 		dbSnapshotCollection,
 		snapshotSchedulerIdString,
-		getCodeString(apiPb.SchedulerCode_OK, andSep),
+		snapshotPassedString,
 		snapshotMetaStartTimeFilterString),
 		request.SchedulerId,
 		timeFrom,
@@ -237,7 +257,7 @@ func (c *Clickhouse) countSnapshotsUptime(request *apiPb.GetSchedulerUptimeReque
 		}, nil
 	}
 
-	if err := rows.Scan(&result.Count, &result.Latency); err != nil {
+	if err := rows.Scan(&result.Count, &result.Latency, &result.Degraded); err != nil {
 		logger.Error(err.Error())
 		return UptimeResult{
 			Count:   -1,
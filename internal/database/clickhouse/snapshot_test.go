@@ -42,7 +42,7 @@ func (s *SuiteSnapshot) Test_Snapshots() {
 	s.mock.ExpectBegin()
 	query := fmt.Sprintf(`INSERT INTO "%s" (%s)`, dbSnapshotCollection, snapshotFields)
 	s.mock.ExpectExec(regexp.QuoteMeta(query)).
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	s.mock.ExpectCommit()
 
@@ -92,7 +92,7 @@ func (s *SuiteSnapshot) Test_InsertSnapshot_Error() {
 	query := fmt.Sprintf(`INSERT INTO "%s" (%s)`, dbSnapshotCollection, snapshotFields)
 	s.mock.ExpectBegin()
 	s.mock.ExpectExec(regexp.QuoteMeta(query)).
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnError(errors.New("r"))
 
 	err := clickSnapshot.insertSnapshot(time.Now(), &Snapshot{})
@@ -103,7 +103,7 @@ func (s *SuiteSnapshot) Test_InsertSnapshot_commitError() {
 	query := fmt.Sprintf(`INSERT INTO "%s" (%s)`, dbSnapshotCollection, snapshotFields)
 	s.mock.ExpectBegin()
 	s.mock.ExpectExec(regexp.QuoteMeta(query)).
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 	s.mock.ExpectCommit().WillReturnError(errors.New("Test_InsertSnapshot_commitError"))
 	err := clickSnapshot.insertSnapshot(time.Now(), &Snapshot{})
@@ -122,8 +122,8 @@ func (s *SuiteSnapshot) Test_GetSnapshots() {
 		WillReturnRows(rows)
 
 	query = fmt.Sprintf(`SELECT %s FROM %s`, snapshotFields, dbSnapshotCollection)
-	rows = sqlmock.NewRows([]string{"id", "created_at", "updated_at", "scheduler_id", "code", "type", "error", "meta_start_time", "meta_end_time", "meta_value"}).
-		AddRow("1", time.Now(), time.Now(), "1", "1", "1", "error", "1", "1", "1")
+	rows = sqlmock.NewRows([]string{"id", "created_at", "updated_at", "scheduler_id", "code", "type", "error", "meta_start_time", "meta_end_time", "meta_value", "meta_attempts", "meta_attempt_errors", "meta_skipped_by", "meta_locations"}).
+		AddRow("1", time.Now(), time.Now(), "1", "1", "1", "error", "1", "1", "1", "2", `["timeout"]`, "", "")
 	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
 		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnRows(rows)
@@ -151,8 +151,8 @@ func (s *SuiteSnapshot) Test_GetSnapshots_scanError() {
 		WillReturnRows(rows)
 
 	query = fmt.Sprintf(`SELECT %s FROM %s`, snapshotFields, dbSnapshotCollection)
-	rows = sqlmock.NewRows([]string{"id", "created_at", "updated_at", "scheduler_id", "code", "type", "error", "meta_start_time", "meta_end_time", "meta_value", "a"}).
-		AddRow("1", time.Now(), time.Now(), "1", "1", "1", "error", "1", "1", "1", "a")
+	rows = sqlmock.NewRows([]string{"id", "created_at", "updated_at", "scheduler_id", "code", "type", "error", "meta_start_time", "meta_end_time", "meta_value", "meta_attempts", "meta_attempt_errors", "meta_skipped_by", "meta_locations", "a"}).
+		AddRow("1", time.Now(), time.Now(), "1", "1", "1", "error", "1", "1", "1", "1", "", "", "", "a")
 	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
 		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnRows(rows)
@@ -241,12 +241,31 @@ func (s *SuiteSnapshot) Test_countAllSnapshots_scanError() {
 	require.Error(s.T(), err)
 }
 
+func (s *SuiteSnapshot) Test_countAllSnapshots_excludeMaintenance() {
+	var (
+		id = "1"
+	)
+
+	query := fmt.Sprintf(`SELECT count(*) FROM "%s" WHERE "scheduler_id" = ? AND "code" != '3'`, dbSnapshotCollection)
+	rows := sqlmock.NewRows([]string{"count"}).AddRow("5")
+	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(id, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(rows)
+
+	count, err := clickSnapshot.countAllSnapshots(&apiPb.GetSchedulerUptimeRequest{
+		SchedulerId: id,
+		TimeRange:   nil,
+	}, 0, 0)
+	require.Equal(s.T(), int64(5), count)
+	require.Nil(s.T(), err)
+}
+
 func (s *SuiteSnapshot) Test_countSnapshotsUptime_nextError() {
 	var (
 		id = "1"
 	)
 
-	query := fmt.Sprintf(`SELECT count(*) as "count", avg(meta_end_time-meta_start_time) as "latency" FROM "%s"`, dbSnapshotCollection)
+	query := fmt.Sprintf(`SELECT count(*) as "count", avg(meta_end_time-meta_start_time) as "latency", COUNT(CASE WHEN "code" = '5' THEN 1 ELSE NULL END) as "degraded" FROM "%s"`, dbSnapshotCollection)
 	rows := sqlmock.NewRows([]string{})
 	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
 		WithArgs(id, sqlmock.AnyArg(), sqlmock.AnyArg()).
@@ -266,7 +285,7 @@ func (s *SuiteSnapshot) Test_countSnapshotsUptime_scanError() {
 		id = "1"
 	)
 
-	query := fmt.Sprintf(`SELECT count(*) as "count", avg(meta_end_time-meta_start_time) as "latency" FROM "%s"`, dbSnapshotCollection)
+	query := fmt.Sprintf(`SELECT count(*) as "count", avg(meta_end_time-meta_start_time) as "latency", COUNT(CASE WHEN "code" = '5' THEN 1 ELSE NULL END) as "degraded" FROM "%s"`, dbSnapshotCollection)
 	rows := sqlmock.NewRows([]string{"count", "latency", "a"}).AddRow("1", "", "a")
 	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
 		WithArgs(id, sqlmock.AnyArg(), sqlmock.AnyArg()).
@@ -293,8 +312,8 @@ func (s *SuiteSnapshot) Test_GetSnapshots_WithStatus() {
 		WillReturnRows(rows)
 
 	query = fmt.Sprintf(`SELECT %s FROM %s`, snapshotFields, dbSnapshotCollection)
-	rows = sqlmock.NewRows([]string{"id", "created_at", "updated_at", "scheduler_id", "code", "type", "error", "meta_start_time", "meta_end_time", "meta_value"}).
-		AddRow("1", time.Now(), time.Now(), "1", "1", "1", "error", "1", "1", "1")
+	rows = sqlmock.NewRows([]string{"id", "created_at", "updated_at", "scheduler_id", "code", "type", "error", "meta_start_time", "meta_end_time", "meta_value", "meta_attempts", "meta_attempt_errors", "meta_skipped_by", "meta_locations"}).
+		AddRow("1", time.Now(), time.Now(), "1", "1", "1", "error", "1", "1", "1", "2", `["timeout"]`, "", "")
 	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
 		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnRows(rows)
@@ -360,21 +379,23 @@ func (s *SuiteSnapshot) Test_GetSnapshotsUptime() {
 	)
 
 	query := fmt.Sprintf(`SELECT count(*) FROM "%s"`, dbSnapshotCollection)
-	rows := sqlmock.NewRows([]string{"count"}).AddRow("1")
+	rows := sqlmock.NewRows([]string{"count"}).AddRow("4")
 	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
 		WithArgs(id, sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnRows(rows)
 
-	query = fmt.Sprintf(`SELECT count(*) as "count", avg(meta_end_time-meta_start_time) as "latency" FROM "%s"`, dbSnapshotCollection)
-	rows = sqlmock.NewRows([]string{"count", "latency"}).AddRow("1", "1")
+	query = fmt.Sprintf(`SELECT count(*) as "count", avg(meta_end_time-meta_start_time) as "latency", COUNT(CASE WHEN "code" = '5' THEN 1 ELSE NULL END) as "degraded" FROM "%s"`, dbSnapshotCollection)
+	rows = sqlmock.NewRows([]string{"count", "latency", "degraded"}).AddRow("2", "1", "1")
 	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
 		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnRows(rows)
 
-	_, err := clickSnapshot.GetSnapshotsUptime(&apiPb.GetSchedulerUptimeRequest{
+	res, err := clickSnapshot.GetSnapshotsUptime(&apiPb.GetSchedulerUptimeRequest{
 		SchedulerId: id,
 	})
 	require.NoError(s.T(), err)
+	require.Equal(s.T(), 0.5, res.Uptime)
+	require.Equal(s.T(), 0.25, res.Degraded)
 }
 
 func (s *SuiteSnapshot) Test_GetSnapshotsUptime_FirstCountError() {
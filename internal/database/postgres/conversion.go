@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"encoding/json"
 	"errors"
 	"github.com/squzy/squzy/internal/logger"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
@@ -95,10 +96,19 @@ func convertToSnapshot(request *apiPb.SchedulerSnapshot, schedulerID string) (*S
 		Type:          int32(request.GetType()),
 		MetaStartTime: startTime.UnixNano(),
 		MetaEndTime:   endTime.UnixNano(),
+		MetaAttempts:  request.GetMeta().GetAttempts(),
+		MetaSkippedBy: request.GetMeta().GetSkippedBy(),
 	}
 	if request.GetError() != nil {
 		res.Error = request.GetError().GetMessage()
 	}
+	if len(request.GetMeta().GetAttemptErrors()) > 0 {
+		// Marshal of string slice could not fail
+		res.MetaAttemptErrors, _ = json.Marshal(request.GetMeta().GetAttemptErrors())
+	}
+	if len(request.GetMeta().GetLocations()) > 0 {
+		res.MetaLocations, _ = json.Marshal(request.GetMeta().GetLocations())
+	}
 
 	bValue, err := request.GetMeta().GetValue().MarshalJSON()
 	if err != nil {
@@ -119,8 +129,16 @@ func convertFromSnapshot(snapshot *Snapshot) (*apiPb.SchedulerSnapshot, error) {
 		Meta: &apiPb.SchedulerSnapshot_MetaData{
 			StartTime: startTime,
 			EndTime:   endTime,
+			Attempts:  snapshot.MetaAttempts,
+			SkippedBy: snapshot.MetaSkippedBy,
 		},
 	}
+	if len(snapshot.MetaAttemptErrors) > 0 {
+		_ = json.Unmarshal(snapshot.MetaAttemptErrors, &res.Meta.AttemptErrors)
+	}
+	if len(snapshot.MetaLocations) > 0 {
+		_ = json.Unmarshal(snapshot.MetaLocations, &res.Meta.Locations)
+	}
 	if snapshot.Error != "" {
 		res.Error = &apiPb.SchedulerSnapshot_Error{
 			Message: snapshot.Error,
@@ -479,8 +497,9 @@ func convertFromUptimeResult(uptimeResult *UptimeResult, countAll int64) *apiPb.
 		}
 	}
 	return &apiPb.GetSchedulerUptimeResponse{
-		Uptime:  float64(uptimeResult.Count) / float64(countAll),
-		Latency: latency,
+		Uptime:   float64(uptimeResult.Count) / float64(countAll),
+		Latency:  latency,
+		Degraded: float64(uptimeResult.Degraded) / float64(countAll),
 	}
 }
 
@@ -71,6 +71,67 @@ func TestConvertToPostgresSnapshot(t *testing.T) {
 	})
 }
 
+func TestConvertSnapshotAttempts(t *testing.T) {
+	t.Run("Should: keep attempts after conversion", func(t *testing.T) {
+		correctTime := timestamp.Now()
+		snapshot, err := ConvertToPostgresSnapshot(&apiPb.SchedulerResponse{
+			SchedulerId: "id",
+			Snapshot: &apiPb.SchedulerSnapshot{
+				Code: apiPb.SchedulerCode_OK,
+				Meta: &apiPb.SchedulerSnapshot_MetaData{
+					StartTime:     correctTime,
+					EndTime:       correctTime,
+					Attempts:      2,
+					AttemptErrors: []string{"CONNECTION_TIMEOUT"},
+				},
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), snapshot.MetaAttempts)
+		res := ConvertFromPostgresSnapshots([]*Snapshot{snapshot})
+		assert.Equal(t, int32(2), res[0].Meta.Attempts)
+		assert.Equal(t, []string{"CONNECTION_TIMEOUT"}, res[0].Meta.AttemptErrors)
+	})
+	t.Run("Should: keep skipped by after conversion", func(t *testing.T) {
+		snapshot, err := ConvertToPostgresSnapshot(&apiPb.SchedulerResponse{
+			SchedulerId: "id",
+			Snapshot: &apiPb.SchedulerSnapshot{
+				Code: apiPb.SchedulerCode_SKIPPED,
+				Meta: &apiPb.SchedulerSnapshot_MetaData{
+					StartTime: timestamp.Now(),
+					EndTime:   timestamp.Now(),
+					SkippedBy: "parent",
+				},
+			},
+		})
+		assert.NoError(t, err)
+		res := ConvertFromPostgresSnapshots([]*Snapshot{snapshot})
+		assert.Equal(t, "parent", res[0].Meta.SkippedBy)
+	})
+	t.Run("Should: keep locations after conversion", func(t *testing.T) {
+		snapshot, err := ConvertToPostgresSnapshot(&apiPb.SchedulerResponse{
+			SchedulerId: "id",
+			Snapshot: &apiPb.SchedulerSnapshot{
+				Code: apiPb.SchedulerCode_ERROR,
+				Meta: &apiPb.SchedulerSnapshot_MetaData{
+					StartTime: timestamp.Now(),
+					EndTime:   timestamp.Now(),
+					Locations: []*apiPb.LocationResult{
+						{Location: "eu", Code: apiPb.SchedulerCode_OK},
+						{Location: "us", Code: apiPb.SchedulerCode_ERROR, Error: "CONNECTION_TIMEOUT"},
+					},
+				},
+			},
+		})
+		assert.NoError(t, err)
+		res := ConvertFromPostgresSnapshots([]*Snapshot{snapshot})
+		assert.Len(t, res[0].Meta.Locations, 2)
+		assert.Equal(t, "us", res[0].Meta.Locations[1].Location)
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res[0].Meta.Locations[1].Code)
+		assert.Equal(t, "CONNECTION_TIMEOUT", res[0].Meta.Locations[1].Error)
+	})
+}
+
 func TestConvertFromPostgresSnapshots(t *testing.T) {
 	wrongTime := time.Unix(-62135596888, -100000000) //Protobuf validate this seconds aas error
 	t.Run("Test: error in convertation", func(t *testing.T) {
@@ -222,6 +283,15 @@ func TestConvertFromUptimeResult(t *testing.T) {
 		}, 10)
 		assert.NotNil(t, res)
 	})
+	t.Run("Should: return part of degraded snapshots", func(t *testing.T) {
+		res := convertFromUptimeResult(&UptimeResult{
+			Count:    8,
+			Degraded: 2,
+			Latency:  "10000",
+		}, 10)
+		assert.Equal(t, 0.8, res.Uptime)
+		assert.Equal(t, 0.2, res.Degraded)
+	})
 }
 
 func TestConvertFromGroupResult(t *testing.T) {
@@ -15,16 +15,29 @@ type Snapshot struct {
 	MetaStartTime int64  `gorm:"column:metaStartTime"`
 	MetaEndTime   int64  `gorm:"column:metaEndTime"`
 	MetaValue     []byte `gorm:"column:metaValue"`
+	MetaAttempts  int32  `gorm:"column:metaAttempts"`
+	// JSON array with error of every failed attempt
+	MetaAttemptErrors []byte `gorm:"column:metaAttemptErrors"`
+	// Id of failing parent for skipped snapshot
+	MetaSkippedBy string `gorm:"column:metaSkippedBy"`
+	// JSON array with result of every probe location
+	MetaLocations []byte `gorm:"column:metaLocations"`
 }
 
 type UptimeResult struct {
-	Count   int64  `gorm:"column:count"`
-	Latency string `gorm:"column:latency"`
+	Count int64 `gorm:"column:count"`
+	// Count of WARNING snapshots, they are part of Count
+	Degraded int64  `gorm:"column:degraded"`
+	Latency  string `gorm:"column:latency"`
 }
 
 var (
 	schedulerIdFilterString   = fmt.Sprintf(`"%s"."schedulerId" = ?`, dbSnapshotCollection)
 	metaStartTimeFilterString = fmt.Sprintf(`"%s"."metaStartTime" BETWEEN ? and ?`, dbSnapshotCollection)
+	// Snapshots taken during maintenance window are not counted in uptime
+	notMaintenanceFilterString = fmt.Sprintf(`"%s"."code" != '%d'`, dbSnapshotCollection, apiPb.SchedulerCode_MAINTENANCE)
+	// WARNING snapshots are passed, so they are counted in uptime too
+	passedFilterString = fmt.Sprintf(`"%s"."code" IN ('%d', '%d')`, dbSnapshotCollection, apiPb.SchedulerCode_OK, apiPb.SchedulerCode_WARNING)
 
 	snapOrderMap = map[apiPb.SortSchedulerList]string{
 		apiPb.SortSchedulerList_SORT_SCHEDULER_LIST_UNSPECIFIED: fmt.Sprintf(`"%s"."metaStartTime"`, dbSnapshotCollection),
@@ -90,6 +103,7 @@ func (p *Postgres) GetSnapshotsUptime(request *apiPb.GetSchedulerUptimeRequest)
 	err = p.Db.Table(dbSnapshotCollection).
 		Where(schedulerIdFilterString, request.GetSchedulerId()).
 		Where(metaStartTimeFilterString, timeFrom, timeTo).
+		Where(notMaintenanceFilterString).
 		Count(&countAll).Error
 
 	if err != nil {
@@ -97,9 +111,10 @@ func (p *Postgres) GetSnapshotsUptime(request *apiPb.GetSchedulerUptimeRequest)
 	}
 
 	selectString := fmt.Sprintf(
-		`COUNT(*) as "count", AVG("%s"."metaEndTime"-"%s"."metaStartTime") as "latency"`,
+		`COUNT(*) as "count", AVG("%s"."metaEndTime"-"%s"."metaStartTime") as "latency", COUNT(*) FILTER (WHERE %s) as "degraded"`,
 		dbSnapshotCollection,
 		dbSnapshotCollection,
+		getCodeString(apiPb.SchedulerCode_WARNING),
 	)
 
 	var uptimeResult UptimeResult
@@ -107,7 +122,7 @@ func (p *Postgres) GetSnapshotsUptime(request *apiPb.GetSchedulerUptimeRequest)
 		Select(selectString).
 		Where(schedulerIdFilterString, request.GetSchedulerId()).
 		Where(metaStartTimeFilterString, timeFrom, timeTo).
-		Where(getCodeString(apiPb.SchedulerCode_OK)).
+		Where(passedFilterString).
 		Find(&uptimeResult).Error
 	if err != nil {
 		return nil, err
@@ -49,7 +49,7 @@ func (s *SuiteSnapshot) SetupSuite() {
 func (s *SuiteSnapshot) Test_Snapshots() {
 	s.mock.ExpectBegin()
 	s.mock.ExpectQuery(fmt.Sprintf(`INSERT INTO "%s"`, dbSnapshotCollection)).
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 	s.mock.ExpectCommit()
 
@@ -217,6 +217,54 @@ func (s *SuiteSnapshot) Test_GetSnapshotsUptime() {
 	require.NoError(s.T(), err)
 }
 
+func (s *SuiteSnapshot) Test_GetSnapshotsUptime_ExcludeMaintenance() {
+	var (
+		id = "1"
+	)
+
+	query := fmt.Sprintf(`SELECT count(*) FROM "%s"`, dbSnapshotCollection)
+	rows := sqlmock.NewRows([]string{"count"}).AddRow("1")
+	s.mock.ExpectQuery(regexp.QuoteMeta(query) + ".*" + regexp.QuoteMeta(`"code" != '3'`)).
+		WithArgs(id, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(rows)
+
+	query = fmt.Sprintf(`COUNT(*) as "count", AVG("%s"."metaEndTime"-"%s"."metaStartTime") as "latency"`, dbSnapshotCollection, dbSnapshotCollection)
+	rows = sqlmock.NewRows([]string{"id"}).AddRow("1")
+	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(rows)
+
+	_, err := postgrSnapshot.GetSnapshotsUptime(&apiPb.GetSchedulerUptimeRequest{
+		SchedulerId: id,
+	})
+	require.NoError(s.T(), err)
+}
+
+func (s *SuiteSnapshot) Test_GetSnapshotsUptime_CountWarning() {
+	var (
+		id = "1"
+	)
+
+	query := fmt.Sprintf(`SELECT count(*) FROM "%s"`, dbSnapshotCollection)
+	rows := sqlmock.NewRows([]string{"count"}).AddRow("4")
+	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(id, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(rows)
+
+	query = `COUNT(*) FILTER (WHERE "snapshots"."code" = '5') as "degraded"`
+	rows = sqlmock.NewRows([]string{"count", "degraded", "latency"}).AddRow("3", "1", "10")
+	s.mock.ExpectQuery(regexp.QuoteMeta(query) + ".*" + regexp.QuoteMeta(`"code" IN ('1', '5')`)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(rows)
+
+	res, err := postgrSnapshot.GetSnapshotsUptime(&apiPb.GetSchedulerUptimeRequest{
+		SchedulerId: id,
+	})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 0.75, res.Uptime)
+	require.Equal(s.T(), 0.25, res.Degraded)
+}
+
 //Based on fact, that if request is not mocked, it will return error
 func (s *SuiteSnapshot) Test_GetSnapshotsUptime_FirstCountError() {
 	var (
@@ -0,0 +1,61 @@
+package flapping
+
+import (
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+)
+
+const (
+	// How many recent results are used when scheduler does not set window
+	DefaultWindow = 21
+	// State change percent above which check is flapping
+	DefaultThreshold = 50
+)
+
+// Skipped check is failed too, warning is passed, maintenance and unknown codes do not have state
+func isFailed(code apiPb.SchedulerCode) (failed bool, ok bool) {
+	switch code {
+	case apiPb.SchedulerCode_OK, apiPb.SchedulerCode_WARNING:
+		return false, true
+	case apiPb.SchedulerCode_ERROR, apiPb.SchedulerCode_SKIPPED:
+		return true, true
+	}
+	return false, false
+}
+
+// StateChange returns how many percent of neighbour results have different state, codes should be ordered by time
+func StateChange(codes []apiPb.SchedulerCode) float64 {
+	states := []bool{}
+	for _, code := range codes {
+		failed, ok := isFailed(code)
+		if ok {
+			states = append(states, failed)
+		}
+	}
+	if len(states) < 2 {
+		return 0
+	}
+	changes := 0
+	for i := 1; i < len(states); i++ {
+		if states[i] != states[i-1] {
+			changes++
+		}
+	}
+	return float64(changes) * 100 / float64(len(states)-1)
+}
+
+// IsFlapping returns true if state change percent is above threshold, 0 means default threshold
+func IsFlapping(codes []apiPb.SchedulerCode, threshold float64) bool {
+	if threshold <= 0 {
+		threshold = DefaultThreshold
+	}
+	return StateChange(codes) > threshold
+}
+
+// Codes returns codes of snapshots in same order
+func Codes(snapshots []*apiPb.SchedulerSnapshot) []apiPb.SchedulerCode {
+	codes := make([]apiPb.SchedulerCode, len(snapshots))
+	for i, snapshot := range snapshots {
+		codes[i] = snapshot.GetCode()
+	}
+	return codes
+}
@@ -0,0 +1,52 @@
+package flapping
+
+import (
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var (
+	ok      = apiPb.SchedulerCode_OK
+	failed  = apiPb.SchedulerCode_ERROR
+	skipped = apiPb.SchedulerCode_SKIPPED
+	paused  = apiPb.SchedulerCode_MAINTENANCE
+	warning = apiPb.SchedulerCode_WARNING
+)
+
+func TestStateChange(t *testing.T) {
+	t.Run("Should: return 0 for stable results", func(t *testing.T) {
+		assert.Equal(t, float64(0), StateChange([]apiPb.SchedulerCode{ok, ok, ok}))
+		assert.Equal(t, float64(0), StateChange([]apiPb.SchedulerCode{failed, skipped, failed}))
+		assert.Equal(t, float64(0), StateChange([]apiPb.SchedulerCode{ok, warning, ok}))
+	})
+	t.Run("Should: return 0 without enough results", func(t *testing.T) {
+		assert.Equal(t, float64(0), StateChange(nil))
+		assert.Equal(t, float64(0), StateChange([]apiPb.SchedulerCode{ok, paused}))
+	})
+	t.Run("Should: return 100 when every result changes state", func(t *testing.T) {
+		assert.Equal(t, float64(100), StateChange([]apiPb.SchedulerCode{ok, failed, ok, failed, ok}))
+	})
+	t.Run("Should: ignore maintenance results", func(t *testing.T) {
+		assert.Equal(t, float64(50), StateChange([]apiPb.SchedulerCode{ok, paused, failed, failed}))
+	})
+}
+
+func TestIsFlapping(t *testing.T) {
+	t.Run("Should: use default threshold", func(t *testing.T) {
+		assert.True(t, IsFlapping([]apiPb.SchedulerCode{ok, failed, ok, failed}, 0))
+		assert.False(t, IsFlapping([]apiPb.SchedulerCode{ok, failed, failed}, 0))
+	})
+	t.Run("Should: use threshold", func(t *testing.T) {
+		assert.True(t, IsFlapping([]apiPb.SchedulerCode{ok, failed, failed}, 40))
+	})
+}
+
+func TestCodes(t *testing.T) {
+	t.Run("Should: return codes of snapshots", func(t *testing.T) {
+		assert.Equal(t, []apiPb.SchedulerCode{ok, failed}, Codes([]*apiPb.SchedulerSnapshot{
+			{Code: ok},
+			{Code: failed},
+		}))
+	})
+}
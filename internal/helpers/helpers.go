@@ -4,6 +4,7 @@ import (
 	"context"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	wrappers "google.golang.org/protobuf/types/known/wrapperspb"
 	"strings"
 	"time"
 )
@@ -44,8 +45,11 @@ func SelectorsToDb(selectors []*apiPb.HttpJsonValueConfig_Selectors) []*schedule
 	arr := []*scheduler_config_storage.Selectors{}
 	for _, v := range selectors {
 		arr = append(arr, &scheduler_config_storage.Selectors{
-			Type: v.Type,
-			Path: v.Path,
+			Type:       v.Type,
+			Path:       v.Path,
+			WarningMin: DoubleValueToDb(v.WarningMin),
+			WarningMax: DoubleValueToDb(v.WarningMax),
+			Assertion:  SelectorAssertionToDb(v.Assertion),
 		})
 	}
 	return arr
@@ -55,9 +59,327 @@ func SelectorsToProto(selectors []*scheduler_config_storage.Selectors) []*apiPb.
 	arr := []*apiPb.HttpJsonValueConfig_Selectors{}
 	for _, v := range selectors {
 		arr = append(arr, &apiPb.HttpJsonValueConfig_Selectors{
-			Type: v.Type,
-			Path: v.Path,
+			Type:       v.Type,
+			Path:       v.Path,
+			WarningMin: DoubleValueToProto(v.WarningMin),
+			WarningMax: DoubleValueToProto(v.WarningMax),
+			Assertion:  SelectorAssertionToProto(v.Assertion),
 		})
 	}
 	return arr
 }
+
+func SelectorAssertionToDb(assertion *apiPb.HttpJsonValueConfig_Assertion) *scheduler_config_storage.SelectorAssertion {
+	if assertion == nil {
+		return nil
+	}
+	return &scheduler_config_storage.SelectorAssertion{
+		Equals:      StringValueToDb(assertion.Equals),
+		NotEquals:   StringValueToDb(assertion.NotEquals),
+		GreaterThan: DoubleValueToDb(assertion.GreaterThan),
+		LessThan:    DoubleValueToDb(assertion.LessThan),
+		Regex:       assertion.Regex,
+		BoolEquals:  BoolValueToDb(assertion.BoolEquals),
+	}
+}
+
+func SelectorAssertionToProto(assertion *scheduler_config_storage.SelectorAssertion) *apiPb.HttpJsonValueConfig_Assertion {
+	if assertion == nil {
+		return nil
+	}
+	return &apiPb.HttpJsonValueConfig_Assertion{
+		Equals:      StringValueToProto(assertion.Equals),
+		NotEquals:   StringValueToProto(assertion.NotEquals),
+		GreaterThan: DoubleValueToProto(assertion.GreaterThan),
+		LessThan:    DoubleValueToProto(assertion.LessThan),
+		Regex:       assertion.Regex,
+		BoolEquals:  BoolValueToProto(assertion.BoolEquals),
+	}
+}
+
+func HTTPAssertionsToDb(assertions *apiPb.HttpAssertions) *scheduler_config_storage.HTTPAssertions {
+	if assertions == nil {
+		return nil
+	}
+	headers := []*scheduler_config_storage.HeaderAssertion{}
+	for _, header := range assertions.Headers {
+		headers = append(headers, &scheduler_config_storage.HeaderAssertion{
+			Name:   header.Name,
+			Equals: header.Equals,
+			Regex:  header.Regex,
+		})
+	}
+	return &scheduler_config_storage.HTTPAssertions{
+		BodyContains:    assertions.BodyContains,
+		BodyNotContains: assertions.BodyNotContains,
+		BodyRegex:       assertions.BodyRegex,
+		Headers:         headers,
+		MaxResponseTime: assertions.MaxResponseTime,
+	}
+}
+
+func HTTPBodyToDb(body *apiPb.HttpBody) *scheduler_config_storage.HTTPBody {
+	if body == nil {
+		return nil
+	}
+	return &scheduler_config_storage.HTTPBody{
+		Content:     body.Content,
+		ContentType: body.ContentType,
+	}
+}
+
+func HTTPBodyToProto(body *scheduler_config_storage.HTTPBody) *apiPb.HttpBody {
+	if body == nil {
+		return nil
+	}
+	return &apiPb.HttpBody{
+		Content:     body.Content,
+		ContentType: body.ContentType,
+	}
+}
+
+func HTTPAuthToDb(auth *apiPb.HttpAuth) *scheduler_config_storage.HTTPAuth {
+	if auth == nil {
+		return nil
+	}
+	res := &scheduler_config_storage.HTTPAuth{
+		BasicUser:     auth.BasicUser,
+		BasicPassword: auth.BasicPassword,
+		BearerToken:   auth.BearerToken,
+	}
+	if auth.Oauth2 != nil {
+		res.OAuth2 = &scheduler_config_storage.OAuth2Config{
+			TokenURL:     auth.Oauth2.TokenUrl,
+			ClientID:     auth.Oauth2.ClientId,
+			ClientSecret: auth.Oauth2.ClientSecret,
+			Scopes:       auth.Oauth2.Scopes,
+		}
+	}
+	return res
+}
+
+func HTTPAuthToProto(auth *scheduler_config_storage.HTTPAuth) *apiPb.HttpAuth {
+	if auth == nil {
+		return nil
+	}
+	res := &apiPb.HttpAuth{
+		BasicUser:     auth.BasicUser,
+		BasicPassword: auth.BasicPassword,
+		BearerToken:   auth.BearerToken,
+	}
+	if auth.OAuth2 != nil {
+		res.Oauth2 = &apiPb.HttpAuth_OAuth2{
+			TokenUrl:     auth.OAuth2.TokenURL,
+			ClientId:     auth.OAuth2.ClientID,
+			ClientSecret: auth.OAuth2.ClientSecret,
+			Scopes:       auth.OAuth2.Scopes,
+		}
+	}
+	return res
+}
+
+func HTTPTLSToDb(config *apiPb.HttpTls) *scheduler_config_storage.HTTPTLS {
+	if config == nil {
+		return nil
+	}
+	return &scheduler_config_storage.HTTPTLS{
+		ClientCert:         config.ClientCert,
+		ClientKey:          config.ClientKey,
+		CABundle:           config.CaBundle,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+}
+
+func HTTPTLSToProto(config *scheduler_config_storage.HTTPTLS) *apiPb.HttpTls {
+	if config == nil {
+		return nil
+	}
+	return &apiPb.HttpTls{
+		ClientCert:         config.ClientCert,
+		ClientKey:          config.ClientKey,
+		CaBundle:           config.CABundle,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+}
+
+func HTTPScenarioToDb(config *apiPb.HttpScenarioConfig) *scheduler_config_storage.HTTPScenarioConfig {
+	if config == nil {
+		return nil
+	}
+	steps := []*scheduler_config_storage.HTTPScenarioStep{}
+	for _, step := range config.Steps {
+		extract := []*scheduler_config_storage.HTTPExtract{}
+		for _, e := range step.Extract {
+			extract = append(extract, &scheduler_config_storage.HTTPExtract{
+				Name:       e.Name,
+				Source:     e.Source,
+				Expression: e.Expression,
+			})
+		}
+		steps = append(steps, &scheduler_config_storage.HTTPScenarioStep{
+			Name:       step.Name,
+			Method:     step.Method,
+			URL:        step.Url,
+			Headers:    step.Headers,
+			Body:       HTTPBodyToDb(step.Body),
+			StatusCode: step.StatusCode,
+			Assertions: HTTPAssertionsToDb(step.Assertions),
+			Extract:    extract,
+		})
+	}
+	return &scheduler_config_storage.HTTPScenarioConfig{
+		Steps:       steps,
+		WarningTime: config.WarningTime,
+		Auth:        HTTPAuthToDb(config.Auth),
+		TLS:         HTTPTLSToDb(config.Tls),
+	}
+}
+
+func HTTPScenarioToProto(config *scheduler_config_storage.HTTPScenarioConfig) *apiPb.HttpScenarioConfig {
+	if config == nil {
+		return nil
+	}
+	steps := []*apiPb.HttpScenarioStep{}
+	for _, step := range config.Steps {
+		extract := []*apiPb.HttpScenarioStep_Extract{}
+		for _, e := range step.Extract {
+			extract = append(extract, &apiPb.HttpScenarioStep_Extract{
+				Name:       e.Name,
+				Source:     e.Source,
+				Expression: e.Expression,
+			})
+		}
+		steps = append(steps, &apiPb.HttpScenarioStep{
+			Name:       step.Name,
+			Method:     step.Method,
+			Url:        step.URL,
+			Headers:    step.Headers,
+			Body:       HTTPBodyToProto(step.Body),
+			StatusCode: step.StatusCode,
+			Assertions: HTTPAssertionsToProto(step.Assertions),
+			Extract:    extract,
+		})
+	}
+	return &apiPb.HttpScenarioConfig{
+		Steps:       steps,
+		WarningTime: config.WarningTime,
+		Auth:        HTTPAuthToProto(config.Auth),
+		Tls:         HTTPTLSToProto(config.TLS),
+	}
+}
+
+func HTTPAssertionsToProto(assertions *scheduler_config_storage.HTTPAssertions) *apiPb.HttpAssertions {
+	if assertions == nil {
+		return nil
+	}
+	headers := []*apiPb.HttpAssertions_Header{}
+	for _, header := range assertions.Headers {
+		headers = append(headers, &apiPb.HttpAssertions_Header{
+			Name:   header.Name,
+			Equals: header.Equals,
+			Regex:  header.Regex,
+		})
+	}
+	return &apiPb.HttpAssertions{
+		BodyContains:    assertions.BodyContains,
+		BodyNotContains: assertions.BodyNotContains,
+		BodyRegex:       assertions.BodyRegex,
+		Headers:         headers,
+		MaxResponseTime: assertions.MaxResponseTime,
+	}
+}
+
+func RetryPolicyToDb(policy *apiPb.RetryPolicy) *scheduler_config_storage.RetryPolicy {
+	if policy == nil {
+		return nil
+	}
+	return &scheduler_config_storage.RetryPolicy{
+		Attempts:          policy.Attempts,
+		Backoff:           policy.Backoff,
+		BackoffMultiplier: policy.BackoffMultiplier,
+		RetryableErrors:   policy.RetryableErrors,
+	}
+}
+
+func RetryPolicyToProto(policy *scheduler_config_storage.RetryPolicy) *apiPb.RetryPolicy {
+	if policy == nil {
+		return nil
+	}
+	return &apiPb.RetryPolicy{
+		Attempts:          policy.Attempts,
+		Backoff:           policy.Backoff,
+		BackoffMultiplier: policy.BackoffMultiplier,
+		RetryableErrors:   policy.RetryableErrors,
+	}
+}
+
+func FlapDetectionToDb(detection *apiPb.FlapDetection) *scheduler_config_storage.FlapDetection {
+	if detection == nil {
+		return nil
+	}
+	return &scheduler_config_storage.FlapDetection{
+		Window:    detection.Window,
+		Threshold: detection.Threshold,
+	}
+}
+
+func FlapDetectionToProto(detection *scheduler_config_storage.FlapDetection) *apiPb.FlapDetection {
+	if detection == nil {
+		return nil
+	}
+	return &apiPb.FlapDetection{
+		Window:    detection.Window,
+		Threshold: detection.Threshold,
+	}
+}
+
+func DoubleValueToDb(value *wrappers.DoubleValue) *float64 {
+	if value == nil {
+		return nil
+	}
+	v := value.Value
+	return &v
+}
+
+func DoubleValueToProto(value *float64) *wrappers.DoubleValue {
+	if value == nil {
+		return nil
+	}
+	return &wrappers.DoubleValue{
+		Value: *value,
+	}
+}
+
+func StringValueToDb(value *wrappers.StringValue) *string {
+	if value == nil {
+		return nil
+	}
+	v := value.Value
+	return &v
+}
+
+func StringValueToProto(value *string) *wrappers.StringValue {
+	if value == nil {
+		return nil
+	}
+	return &wrappers.StringValue{
+		Value: *value,
+	}
+}
+
+func BoolValueToDb(value *wrappers.BoolValue) *bool {
+	if value == nil {
+		return nil
+	}
+	v := value.Value
+	return &v
+}
+
+func BoolValueToProto(value *bool) *wrappers.BoolValue {
+	if value == nil {
+		return nil
+	}
+	return &wrappers.BoolValue{
+		Value: *value,
+	}
+}
@@ -5,6 +5,7 @@ import (
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"github.com/stretchr/testify/assert"
 	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	wrappers "google.golang.org/protobuf/types/known/wrapperspb"
 	"testing"
 	"time"
 )
@@ -80,3 +81,346 @@ func TestSelectorsToProto(t *testing.T) {
 		}))
 	})
 }
+
+func TestRetryPolicyToDb(t *testing.T) {
+	t.Run("Should: return nil", func(t *testing.T) {
+		assert.Nil(t, RetryPolicyToDb(nil))
+	})
+	t.Run("Should: convert correct", func(t *testing.T) {
+		assert.EqualValues(t, &scheduler_config_storage.RetryPolicy{
+			Attempts:          3,
+			Backoff:           100,
+			BackoffMultiplier: 2,
+			RetryableErrors:   []string{"TIMEOUT"},
+		}, RetryPolicyToDb(&apiPb.RetryPolicy{
+			Attempts:          3,
+			Backoff:           100,
+			BackoffMultiplier: 2,
+			RetryableErrors:   []string{"TIMEOUT"},
+		}))
+	})
+}
+
+func TestRetryPolicyToProto(t *testing.T) {
+	t.Run("Should: return nil", func(t *testing.T) {
+		assert.Nil(t, RetryPolicyToProto(nil))
+	})
+	t.Run("Should: convert correct", func(t *testing.T) {
+		assert.EqualValues(t, &apiPb.RetryPolicy{
+			Attempts:          3,
+			Backoff:           100,
+			BackoffMultiplier: 2,
+			RetryableErrors:   []string{"TIMEOUT"},
+		}, RetryPolicyToProto(&scheduler_config_storage.RetryPolicy{
+			Attempts:          3,
+			Backoff:           100,
+			BackoffMultiplier: 2,
+			RetryableErrors:   []string{"TIMEOUT"},
+		}))
+	})
+}
+
+func TestFlapDetectionToDb(t *testing.T) {
+	t.Run("Should: return nil", func(t *testing.T) {
+		assert.Nil(t, FlapDetectionToDb(nil))
+	})
+	t.Run("Should: convert correct", func(t *testing.T) {
+		assert.EqualValues(t, &scheduler_config_storage.FlapDetection{
+			Window:    10,
+			Threshold: 30,
+		}, FlapDetectionToDb(&apiPb.FlapDetection{
+			Window:    10,
+			Threshold: 30,
+		}))
+	})
+}
+
+func TestFlapDetectionToProto(t *testing.T) {
+	t.Run("Should: return nil", func(t *testing.T) {
+		assert.Nil(t, FlapDetectionToProto(nil))
+	})
+	t.Run("Should: convert correct", func(t *testing.T) {
+		assert.EqualValues(t, &apiPb.FlapDetection{
+			Window:    10,
+			Threshold: 30,
+		}, FlapDetectionToProto(&scheduler_config_storage.FlapDetection{
+			Window:    10,
+			Threshold: 30,
+		}))
+	})
+}
+
+func TestDoubleValueToDb(t *testing.T) {
+	t.Run("Should: return nil", func(t *testing.T) {
+		assert.Nil(t, DoubleValueToDb(nil))
+	})
+	t.Run("Should: convert correct", func(t *testing.T) {
+		assert.Equal(t, 1.5, *DoubleValueToDb(&wrappers.DoubleValue{Value: 1.5}))
+	})
+}
+
+func TestDoubleValueToProto(t *testing.T) {
+	t.Run("Should: return nil", func(t *testing.T) {
+		assert.Nil(t, DoubleValueToProto(nil))
+	})
+	t.Run("Should: convert correct", func(t *testing.T) {
+		value := 1.5
+		assert.Equal(t, 1.5, DoubleValueToProto(&value).Value)
+	})
+}
+
+func TestSelectorAssertionToDb(t *testing.T) {
+	t.Run("Should: return nil", func(t *testing.T) {
+		assert.Nil(t, SelectorAssertionToDb(nil))
+	})
+	t.Run("Should: convert correct", func(t *testing.T) {
+		assertion := SelectorAssertionToDb(&apiPb.HttpJsonValueConfig_Assertion{
+			Equals:     &wrappers.StringValue{Value: "green"},
+			LessThan:   &wrappers.DoubleValue{Value: 10},
+			Regex:      "^g",
+			BoolEquals: &wrappers.BoolValue{Value: false},
+		})
+		assert.Equal(t, "green", *assertion.Equals)
+		assert.Nil(t, assertion.NotEquals)
+		assert.Nil(t, assertion.GreaterThan)
+		assert.Equal(t, float64(10), *assertion.LessThan)
+		assert.Equal(t, "^g", assertion.Regex)
+		assert.False(t, *assertion.BoolEquals)
+	})
+}
+
+func TestSelectorAssertionToProto(t *testing.T) {
+	t.Run("Should: return nil", func(t *testing.T) {
+		assert.Nil(t, SelectorAssertionToProto(nil))
+	})
+	t.Run("Should: convert correct", func(t *testing.T) {
+		notEquals := "red"
+		greaterThan := 1.5
+		assertion := SelectorAssertionToProto(&scheduler_config_storage.SelectorAssertion{
+			NotEquals:   &notEquals,
+			GreaterThan: &greaterThan,
+		})
+		assert.Equal(t, "red", assertion.NotEquals.Value)
+		assert.Equal(t, 1.5, assertion.GreaterThan.Value)
+		assert.Nil(t, assertion.Equals)
+		assert.Nil(t, assertion.BoolEquals)
+	})
+}
+
+func TestStringValueToDb(t *testing.T) {
+	t.Run("Should: return nil", func(t *testing.T) {
+		assert.Nil(t, StringValueToDb(nil))
+	})
+	t.Run("Should: convert correct", func(t *testing.T) {
+		assert.Equal(t, "", *StringValueToDb(&wrappers.StringValue{}))
+	})
+}
+
+func TestBoolValueToProto(t *testing.T) {
+	t.Run("Should: return nil", func(t *testing.T) {
+		assert.Nil(t, BoolValueToProto(nil))
+	})
+	t.Run("Should: convert correct", func(t *testing.T) {
+		value := true
+		assert.True(t, BoolValueToProto(&value).Value)
+	})
+}
+
+func TestHTTPAssertionsToDb(t *testing.T) {
+	t.Run("Should: return nil", func(t *testing.T) {
+		assert.Nil(t, HTTPAssertionsToDb(nil))
+	})
+	t.Run("Should: convert correct", func(t *testing.T) {
+		assert.EqualValues(t, &scheduler_config_storage.HTTPAssertions{
+			BodyContains:    []string{"OK"},
+			BodyRegex:       "^{",
+			Headers:         []*scheduler_config_storage.HeaderAssertion{{Name: "Content-Type", Equals: "application/json"}},
+			MaxResponseTime: 500,
+		}, HTTPAssertionsToDb(&apiPb.HttpAssertions{
+			BodyContains:    []string{"OK"},
+			BodyRegex:       "^{",
+			Headers:         []*apiPb.HttpAssertions_Header{{Name: "Content-Type", Equals: "application/json"}},
+			MaxResponseTime: 500,
+		}))
+	})
+}
+
+func TestHTTPAssertionsToProto(t *testing.T) {
+	t.Run("Should: return nil", func(t *testing.T) {
+		assert.Nil(t, HTTPAssertionsToProto(nil))
+	})
+	t.Run("Should: convert correct", func(t *testing.T) {
+		assert.EqualValues(t, &apiPb.HttpAssertions{
+			BodyNotContains: []string{"Service unavailable"},
+			Headers:         []*apiPb.HttpAssertions_Header{{Name: "Server", Regex: "^nginx"}},
+		}, HTTPAssertionsToProto(&scheduler_config_storage.HTTPAssertions{
+			BodyNotContains: []string{"Service unavailable"},
+			Headers:         []*scheduler_config_storage.HeaderAssertion{{Name: "Server", Regex: "^nginx"}},
+		}))
+	})
+}
+
+func TestHTTPBodyToDb(t *testing.T) {
+	t.Run("Should: return nil", func(t *testing.T) {
+		assert.Nil(t, HTTPBodyToDb(nil))
+	})
+	t.Run("Should: convert correct", func(t *testing.T) {
+		assert.EqualValues(t, &scheduler_config_storage.HTTPBody{
+			Content:     `{"id":"{{uuid}}"}`,
+			ContentType: "application/json",
+		}, HTTPBodyToDb(&apiPb.HttpBody{
+			Content:     `{"id":"{{uuid}}"}`,
+			ContentType: "application/json",
+		}))
+	})
+}
+
+func TestHTTPBodyToProto(t *testing.T) {
+	t.Run("Should: return nil", func(t *testing.T) {
+		assert.Nil(t, HTTPBodyToProto(nil))
+	})
+	t.Run("Should: convert correct", func(t *testing.T) {
+		assert.EqualValues(t, &apiPb.HttpBody{
+			Content: "a=b",
+		}, HTTPBodyToProto(&scheduler_config_storage.HTTPBody{
+			Content: "a=b",
+		}))
+	})
+}
+
+func TestHTTPAuthToDb(t *testing.T) {
+	t.Run("Should: return nil", func(t *testing.T) {
+		assert.Nil(t, HTTPAuthToDb(nil))
+	})
+	t.Run("Should: convert correct", func(t *testing.T) {
+		assert.EqualValues(t, &scheduler_config_storage.HTTPAuth{
+			BasicUser:     "user",
+			BasicPassword: "pass",
+		}, HTTPAuthToDb(&apiPb.HttpAuth{
+			BasicUser:     "user",
+			BasicPassword: "pass",
+		}))
+		assert.EqualValues(t, &scheduler_config_storage.HTTPAuth{
+			OAuth2: &scheduler_config_storage.OAuth2Config{TokenURL: "u", ClientID: "id", ClientSecret: "secret", Scopes: []string{"read"}},
+		}, HTTPAuthToDb(&apiPb.HttpAuth{
+			Oauth2: &apiPb.HttpAuth_OAuth2{TokenUrl: "u", ClientId: "id", ClientSecret: "secret", Scopes: []string{"read"}},
+		}))
+	})
+}
+
+func TestHTTPAuthToProto(t *testing.T) {
+	t.Run("Should: return nil", func(t *testing.T) {
+		assert.Nil(t, HTTPAuthToProto(nil))
+	})
+	t.Run("Should: convert correct", func(t *testing.T) {
+		assert.EqualValues(t, &apiPb.HttpAuth{
+			BearerToken: "token",
+		}, HTTPAuthToProto(&scheduler_config_storage.HTTPAuth{
+			BearerToken: "token",
+		}))
+		assert.EqualValues(t, &apiPb.HttpAuth{
+			Oauth2: &apiPb.HttpAuth_OAuth2{TokenUrl: "u", ClientId: "id"},
+		}, HTTPAuthToProto(&scheduler_config_storage.HTTPAuth{
+			OAuth2: &scheduler_config_storage.OAuth2Config{TokenURL: "u", ClientID: "id"},
+		}))
+	})
+}
+
+func TestHTTPTLSToDb(t *testing.T) {
+	t.Run("Should: return nil", func(t *testing.T) {
+		assert.Nil(t, HTTPTLSToDb(nil))
+	})
+	t.Run("Should: convert correct", func(t *testing.T) {
+		assert.EqualValues(t, &scheduler_config_storage.HTTPTLS{
+			CABundle:           "ca",
+			InsecureSkipVerify: true,
+		}, HTTPTLSToDb(&apiPb.HttpTls{
+			CaBundle:           "ca",
+			InsecureSkipVerify: true,
+		}))
+	})
+}
+
+func TestHTTPTLSToProto(t *testing.T) {
+	t.Run("Should: return nil", func(t *testing.T) {
+		assert.Nil(t, HTTPTLSToProto(nil))
+	})
+	t.Run("Should: convert correct", func(t *testing.T) {
+		assert.EqualValues(t, &apiPb.HttpTls{
+			ClientCert: "cert",
+			ClientKey:  "key",
+		}, HTTPTLSToProto(&scheduler_config_storage.HTTPTLS{
+			ClientCert: "cert",
+			ClientKey:  "key",
+		}))
+	})
+}
+
+func TestHTTPScenarioToDb(t *testing.T) {
+	t.Run("Should: return nil", func(t *testing.T) {
+		assert.Nil(t, HTTPScenarioToDb(nil))
+	})
+	t.Run("Should: convert correct", func(t *testing.T) {
+		assert.EqualValues(t, &scheduler_config_storage.HTTPScenarioConfig{
+			Steps: []*scheduler_config_storage.HTTPScenarioStep{
+				{
+					Name:       "login",
+					Method:     "POST",
+					URL:        "https://squzy.dev/login",
+					Body:       &scheduler_config_storage.HTTPBody{Content: "a=b"},
+					StatusCode: 200,
+					Extract: []*scheduler_config_storage.HTTPExtract{
+						{Name: "token", Source: apiPb.HttpScenarioStep_Extract_JSON, Expression: "data.token"},
+					},
+				},
+			},
+			WarningTime: 1000,
+			Auth:        &scheduler_config_storage.HTTPAuth{BasicUser: "user"},
+		}, HTTPScenarioToDb(&apiPb.HttpScenarioConfig{
+			Steps: []*apiPb.HttpScenarioStep{
+				{
+					Name:       "login",
+					Method:     "POST",
+					Url:        "https://squzy.dev/login",
+					Body:       &apiPb.HttpBody{Content: "a=b"},
+					StatusCode: 200,
+					Extract: []*apiPb.HttpScenarioStep_Extract{
+						{Name: "token", Source: apiPb.HttpScenarioStep_Extract_JSON, Expression: "data.token"},
+					},
+				},
+			},
+			WarningTime: 1000,
+			Auth:        &apiPb.HttpAuth{BasicUser: "user"},
+		}))
+	})
+}
+
+func TestHTTPScenarioToProto(t *testing.T) {
+	t.Run("Should: return nil", func(t *testing.T) {
+		assert.Nil(t, HTTPScenarioToProto(nil))
+	})
+	t.Run("Should: convert correct", func(t *testing.T) {
+		assert.EqualValues(t, &apiPb.HttpScenarioConfig{
+			Steps: []*apiPb.HttpScenarioStep{
+				{
+					Method:     "GET",
+					Url:        "https://squzy.dev/me",
+					Headers:    map[string]string{"Authorization": "Bearer {{token}}"},
+					Assertions: &apiPb.HttpAssertions{BodyContains: []string{"squzy"}, Headers: []*apiPb.HttpAssertions_Header{}},
+					Extract:    []*apiPb.HttpScenarioStep_Extract{},
+				},
+			},
+			Tls: &apiPb.HttpTls{InsecureSkipVerify: true},
+		}, HTTPScenarioToProto(&scheduler_config_storage.HTTPScenarioConfig{
+			Steps: []*scheduler_config_storage.HTTPScenarioStep{
+				{
+					Method:     "GET",
+					URL:        "https://squzy.dev/me",
+					Headers:    map[string]string{"Authorization": "Bearer {{token}}"},
+					Assertions: &scheduler_config_storage.HTTPAssertions{BodyContains: []string{"squzy"}},
+				},
+			},
+			TLS: &scheduler_config_storage.HTTPTLS{InsecureSkipVerify: true},
+		}))
+	})
+}
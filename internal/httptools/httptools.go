@@ -4,15 +4,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/google/uuid"
 	"io/ioutil"
 	"net/http"
 	"github.com/squzy/squzy/internal/helpers"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
 type httpTool struct {
 	userAgent string
 	client    *http.Client
+	// Used when check has own timeout
+	timeoutClient *http.Client
+	mutex         sync.Mutex
+	// Tools of checks with auth or tls by hash of their config
+	checks      map[string]*checkTool
+	checksSweep time.Time
 }
 
 const (
@@ -22,6 +33,7 @@ const (
 	userAgentPrefix                 = "Squzy_monitoring"
 	logHeader                       = "Squzy_scheduler_id"
 	userAgentHeaderKey              = "User-Agent"
+	contentTypeHeaderKey            = "Content-Type"
 )
 
 var (
@@ -44,12 +56,28 @@ type HTTPTool interface {
 	SendRequestTimeout(req *http.Request, timeout time.Duration) (int, []byte, error)
 	SendRequestWithStatusCode(req *http.Request, expectedCode int) (int, []byte, error)
 	SendRequestTimeoutStatusCode(req *http.Request, timeout time.Duration, expectedCode int) (int, []byte, error)
-	CreateRequest(method string, url string, headers *map[string]string, schedulerID string) *http.Request
+	// Same as SendRequestTimeoutStatusCode, but response is returned to check headers, its body is already read
+	SendRequestTimeoutResponse(req *http.Request, timeout time.Duration, expectedCode int) (*http.Response, []byte, error)
+	// Body could be nil, headers have priority over body content type
+	CreateRequest(method string, url string, headers *map[string]string, body *scheduler_config_storage.HTTPBody, schedulerID string) *http.Request
+	// Returns tool which sends requests with auth and tls of check, checks with same config share one tool
+	ForCheck(auth *scheduler_config_storage.HTTPAuth, tlsConfig *scheduler_config_storage.HTTPTLS) (HTTPTool, error)
 }
 
-func (h *httpTool) CreateRequest(method string, url string, headers *map[string]string, logID string) *http.Request {
-	req, _ := http.NewRequest(method, url, nil)
+func (h *httpTool) CreateRequest(method string, url string, headers *map[string]string, body *scheduler_config_storage.HTTPBody, logID string) *http.Request {
+	if body == nil {
+		req, _ := http.NewRequest(method, url, nil)
+		return h.setHeaders(req, headers, logID)
+	}
+
+	req, _ := http.NewRequest(method, url, strings.NewReader(renderBody(body.Content, time.Now(), uuid.New().String())))
+	if body.ContentType != "" {
+		req.Header.Set(contentTypeHeaderKey, body.ContentType)
+	}
+	return h.setHeaders(req, headers, logID)
+}
 
+func (h *httpTool) setHeaders(req *http.Request, headers *map[string]string, logID string) *http.Request {
 	// Set user agent
 	req.Header.Set(userAgentHeaderKey, h.userAgent)
 
@@ -83,6 +111,16 @@ func (h *httpTool) SendRequestTimeoutStatusCode(req *http.Request, timeout time.
 	return h.sendRequestTimeout(req, timeout, true, expectedCode)
 }
 
+func (h *httpTool) SendRequestTimeoutResponse(req *http.Request, timeout time.Duration, expectedCode int) (*http.Response, []byte, error) {
+	// If timeout not present will be use method with custom http client
+	if timeout.Seconds() <= 0 {
+		return doReq(h.client, req, true, expectedCode)
+	}
+	ctx, cancel := helpers.TimeoutContext(context.Background(), timeout)
+	defer cancel()
+	return doReq(h.timeoutClient, req.WithContext(ctx), true, expectedCode)
+}
+
 func (h *httpTool) sendRequestTimeout(req *http.Request, timeout time.Duration, checkCode bool, code int) (int, []byte, error) {
 	// If timeout not present will be use method with custom http client
 	if timeout.Seconds() <= 0 {
@@ -91,14 +129,22 @@ func (h *httpTool) sendRequestTimeout(req *http.Request, timeout time.Duration,
 	ctx, cancel := helpers.TimeoutContext(context.Background(), timeout)
 	defer cancel()
 	reqTimeout := req.WithContext(ctx)
-	return sendReq(http.DefaultClient, reqTimeout, checkCode, code)
+	return sendReq(h.timeoutClient, reqTimeout, checkCode, code)
 }
 
 func sendReq(client *http.Client, req *http.Request, checkCode bool, statusCode int) (int, []byte, error) {
+	resp, data, err := doReq(client, req, checkCode, statusCode)
+	if resp == nil {
+		return 0, nil, err
+	}
+	return resp.StatusCode, data, err
+}
+
+func doReq(client *http.Client, req *http.Request, checkCode bool, statusCode int) (*http.Response, []byte, error) {
 	resp, err := client.Do(req)
 
 	if err != nil {
-		return 0, nil, err
+		return nil, nil, err
 	}
 
 	if resp != nil {
@@ -108,32 +154,47 @@ func sendReq(client *http.Client, req *http.Request, checkCode bool, statusCode
 	data, err := ioutil.ReadAll(resp.Body)
 
 	if err != nil {
-		return resp.StatusCode, nil, err
+		return resp, nil, err
 	}
 
 	if checkCode {
 		if statusCode != resp.StatusCode {
-			return resp.StatusCode, nil, notExpectedStatusCodeFn(req.URL.String(), resp.StatusCode, statusCode)
+			return resp, nil, notExpectedStatusCodeFn(req.URL.String(), resp.StatusCode, statusCode)
 		}
-		return resp.StatusCode, data, nil
+		return resp, data, nil
 	}
 
-	return resp.StatusCode, data, nil
+	return resp, data, nil
+}
+
+// Replace placeholders, so every request could be unique
+func renderBody(content string, now time.Time, id string) string {
+	return strings.NewReplacer(
+		"{{timestamp}}", strconv.FormatInt(now.Unix(), 10),
+		"{{timestamp_ms}}", strconv.FormatInt(now.UnixNano()/int64(time.Millisecond), 10),
+		"{{uuid}}", id,
+	).Replace(content)
 }
 
 func getUserAgent(version string) string {
 	return fmt.Sprintf("%s_%s", userAgentPrefix, version)
 }
 
+func newTransport() *http.Transport {
+	return &http.Transport{
+		MaxIdleConnsPerHost: MaxIdleConnectionsPerHost,
+		MaxIdleConns:        MaxIdleConnections,
+	}
+}
+
 func New(userAgentVersion string) HTTPTool {
 	return &httpTool{
 		userAgent: getUserAgent(userAgentVersion),
 		client: &http.Client{
-			Transport: &http.Transport{
-				MaxIdleConnsPerHost: MaxIdleConnectionsPerHost,
-				MaxIdleConns:        MaxIdleConnections,
-			},
-			Timeout: defaultTimeout,
+			Transport: newTransport(),
+			Timeout:   defaultTimeout,
 		},
+		timeoutClient: http.DefaultClient,
+		checks:        map[string]*checkTool{},
 	}
 }
@@ -0,0 +1,262 @@
+package httptools
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	authorizationHeaderKey = "Authorization"
+	maxTokenResponseSize   = 1 << 20
+	// Token is fetched again a bit before it expires
+	tokenExpiryDelta = 10 * time.Second
+	// Tool not used by any check so long is dropped, so rotated secrets do not keep connections and tokens
+	checkToolTTL = time.Hour
+	// Unused tools are looked for not more often
+	checkToolSweep = time.Minute
+)
+
+var (
+	errInvalidCABundle   = errors.New("INVALID_CA_BUNDLE")
+	errInvalidClientCert = errors.New("INVALID_CLIENT_CERT")
+	errOAuth2Token       = errors.New("UNABLE_TO_GET_OAUTH2_TOKEN")
+)
+
+type checkTool struct {
+	tool     *httpTool
+	lastUsed time.Time
+}
+
+// Sets auth header of check, oauth2 token is cached until it expires
+type authTransport struct {
+	base   http.RoundTripper
+	auth   *scheduler_config_storage.HTTPAuth
+	mutex  sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+func (h *httpTool) ForCheck(auth *scheduler_config_storage.HTTPAuth, tlsConfig *scheduler_config_storage.HTTPTLS) (HTTPTool, error) {
+	if auth == nil && tlsConfig == nil {
+		return h, nil
+	}
+
+	fingerprint, err := json.Marshal([]interface{}{auth, tlsConfig})
+	if err != nil {
+		return nil, err
+	}
+
+	// Secrets are not kept as map keys
+	sum := sha256.Sum256(fingerprint)
+	key := hex.EncodeToString(sum[:])
+	now := time.Now()
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.evictChecks(now)
+	if check, ok := h.checks[key]; ok {
+		check.lastUsed = now
+		return check.tool, nil
+	}
+
+	tool, err := h.newCheckTool(auth, tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+	h.checks[key] = &checkTool{
+		tool:     tool,
+		lastUsed: now,
+	}
+	return tool, nil
+}
+
+// Should be called under lock
+func (h *httpTool) evictChecks(now time.Time) {
+	if now.Sub(h.checksSweep) < checkToolSweep {
+		return
+	}
+	h.checksSweep = now
+	for key, check := range h.checks {
+		if now.Sub(check.lastUsed) < checkToolTTL {
+			continue
+		}
+		check.tool.client.CloseIdleConnections()
+		delete(h.checks, key)
+	}
+}
+
+// WithCookieJar returns tool which keeps cookies in jar, cookies set by redirects too, transport is shared with origin tool
+func (h *httpTool) WithCookieJar(jar http.CookieJar) HTTPTool {
+	client := *h.client
+	client.Jar = jar
+	timeoutClient := *h.timeoutClient
+	timeoutClient.Jar = jar
+	return &httpTool{
+		userAgent:     h.userAgent,
+		client:        &client,
+		timeoutClient: &timeoutClient,
+		checks:        map[string]*checkTool{},
+	}
+}
+
+func (h *httpTool) newCheckTool(auth *scheduler_config_storage.HTTPAuth, tlsConfig *scheduler_config_storage.HTTPTLS) (*httpTool, error) {
+	transport := newTransport()
+	if tlsConfig != nil {
+		config, err := newTLSConfig(tlsConfig)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = config
+	}
+
+	var roundTripper http.RoundTripper = transport
+	if auth != nil {
+		roundTripper = &authTransport{
+			base: transport,
+			auth: auth,
+		}
+	}
+
+	return &httpTool{
+		userAgent: h.userAgent,
+		client: &http.Client{
+			Transport: roundTripper,
+			Timeout:   defaultTimeout,
+		},
+		timeoutClient: &http.Client{
+			Transport: roundTripper,
+		},
+		checks: map[string]*checkTool{},
+	}, nil
+}
+
+func newTLSConfig(config *scheduler_config_storage.HTTPTLS) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+	if config.CABundle != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(config.CABundle)) {
+			return nil, errInvalidCABundle
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if config.ClientCert != "" || config.ClientKey != "" {
+		cert, err := tls.X509KeyPair([]byte(config.ClientCert), []byte(config.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errInvalidClientCert, err.Error())
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTripper should not modify request
+	req = req.Clone(req.Context())
+
+	switch {
+	case t.auth.OAuth2 != nil:
+		token, err := t.getToken(req.Context())
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set(authorizationHeaderKey, "Bearer "+token)
+	case t.auth.BearerToken != "":
+		req.Header.Set(authorizationHeaderKey, "Bearer "+t.auth.BearerToken)
+	case t.auth.BasicUser != "":
+		req.SetBasicAuth(t.auth.BasicUser, t.auth.BasicPassword)
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	// Token could be revoked before expiry, next request will get new one
+	if err == nil && t.auth.OAuth2 != nil && resp.StatusCode == http.StatusUnauthorized {
+		t.resetToken()
+	}
+	return resp, err
+}
+
+func (t *authTransport) getToken(ctx context.Context) (string, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.token != "" && (t.expiry.IsZero() || time.Now().Before(t.expiry)) {
+		return t.token, nil
+	}
+
+	token, expiresIn, err := fetchToken(ctx, t.base, t.auth.OAuth2)
+	if err != nil {
+		return "", err
+	}
+
+	t.token = token
+	t.expiry = time.Time{}
+	if expiresIn > 0 {
+		t.expiry = time.Now().Add(time.Duration(expiresIn)*time.Second - tokenExpiryDelta)
+	}
+	return t.token, nil
+}
+
+func (t *authTransport) resetToken() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.token = ""
+}
+
+// Client credentials grant, https://tools.ietf.org/html/rfc6749#section-4.4
+func fetchToken(ctx context.Context, roundTripper http.RoundTripper, config *scheduler_config_storage.OAuth2Config) (string, int64, error) {
+	form := url.Values{
+		"grant_type": {"client_credentials"},
+	}
+	if len(config.Scopes) > 0 {
+		form.Set("scope", strings.Join(config.Scopes, " "))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, config.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", 0, fmt.Errorf("%w: %s", errOAuth2Token, err.Error())
+	}
+	req.Header.Set(contentTypeHeaderKey, "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(config.ClientID), url.QueryEscape(config.ClientSecret))
+
+	resp, err := roundTripper.RoundTrip(req)
+	if err != nil {
+		return "", 0, fmt.Errorf("%w: %s", errOAuth2Token, err.Error())
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", 0, fmt.Errorf("%w: StatusCode: %d", errOAuth2Token, resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxTokenResponseSize))
+	if err != nil {
+		return "", 0, fmt.Errorf("%w: %s", errOAuth2Token, err.Error())
+	}
+
+	token := struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}{}
+	if err := json.Unmarshal(data, &token); err != nil {
+		return "", 0, fmt.Errorf("%w: %s", errOAuth2Token, err.Error())
+	}
+	if token.AccessToken == "" {
+		return "", 0, fmt.Errorf("%w: empty access_token", errOAuth2Token)
+	}
+	return token.AccessToken, token.ExpiresIn, nil
+}
@@ -0,0 +1,281 @@
+package httptools
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func clientCertPEM(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "squzy"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.Nil(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+}
+
+func serverCertPEM(ts *httptest.Server) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}))
+}
+
+func TestHttpTool_ForCheck(t *testing.T) {
+	t.Run("Should: return same tool without auth and tls", func(t *testing.T) {
+		h := New("version")
+		tool, err := h.ForCheck(nil, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, h, tool)
+	})
+	t.Run("Should: share tool between checks with same config", func(t *testing.T) {
+		h := New("version")
+		first, err := h.ForCheck(&scheduler_config_storage.HTTPAuth{BearerToken: "a"}, nil)
+		assert.Nil(t, err)
+		second, err := h.ForCheck(&scheduler_config_storage.HTTPAuth{BearerToken: "a"}, nil)
+		assert.Nil(t, err)
+		assert.Same(t, first, second)
+		third, err := h.ForCheck(&scheduler_config_storage.HTTPAuth{BearerToken: "b"}, nil)
+		assert.Nil(t, err)
+		assert.NotSame(t, first, third)
+	})
+	t.Run("Should: not keep secret in key of tool", func(t *testing.T) {
+		h := New("version").(*httpTool)
+		_, err := h.ForCheck(&scheduler_config_storage.HTTPAuth{BearerToken: "secret-token"}, nil)
+		assert.Nil(t, err)
+		for key := range h.checks {
+			assert.NotContains(t, key, "secret-token")
+		}
+	})
+	t.Run("Should: drop tool which is not used", func(t *testing.T) {
+		h := New("version").(*httpTool)
+		auth := &scheduler_config_storage.HTTPAuth{BearerToken: "a"}
+		first, err := h.ForCheck(auth, nil)
+		assert.Nil(t, err)
+		for _, check := range h.checks {
+			check.lastUsed = time.Now().Add(-checkToolTTL)
+		}
+		h.checksSweep = time.Time{}
+		_, err = h.ForCheck(&scheduler_config_storage.HTTPAuth{BearerToken: "b"}, nil)
+		assert.Nil(t, err)
+		assert.Len(t, h.checks, 1)
+		second, err := h.ForCheck(auth, nil)
+		assert.Nil(t, err)
+		assert.NotSame(t, first, second)
+	})
+	t.Run("Should: keep tool which is used", func(t *testing.T) {
+		h := New("version").(*httpTool)
+		auth := &scheduler_config_storage.HTTPAuth{BearerToken: "a"}
+		first, err := h.ForCheck(auth, nil)
+		assert.Nil(t, err)
+		h.checksSweep = time.Time{}
+		second, err := h.ForCheck(auth, nil)
+		assert.Nil(t, err)
+		assert.Same(t, first, second)
+	})
+	t.Run("Should: return error because invalid ca bundle", func(t *testing.T) {
+		_, err := New("version").ForCheck(nil, &scheduler_config_storage.HTTPTLS{CABundle: "ca"})
+		assert.Equal(t, errInvalidCABundle, err)
+	})
+	t.Run("Should: return error because invalid client cert", func(t *testing.T) {
+		cert, _ := clientCertPEM(t)
+		_, err := New("version").ForCheck(nil, &scheduler_config_storage.HTTPTLS{ClientCert: cert})
+		assert.ErrorIs(t, err, errInvalidClientCert)
+	})
+}
+
+func TestHttpTool_ForCheckTLS(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	t.Run("Should: return error because unknown authority", func(t *testing.T) {
+		_, _, err := New("version").SendRequestTimeoutStatusCode(newRequest(http.MethodGet, ts.URL, nil), time.Second, http.StatusOK)
+		assert.NotNil(t, err)
+	})
+	t.Run("Should: trust ca bundle", func(t *testing.T) {
+		tool, err := New("version").ForCheck(nil, &scheduler_config_storage.HTTPTLS{CABundle: serverCertPEM(ts)})
+		assert.Nil(t, err)
+		_, _, err = tool.SendRequestTimeoutStatusCode(newRequest(http.MethodGet, ts.URL, nil), time.Second, http.StatusOK)
+		assert.Nil(t, err)
+		_, _, err = tool.SendRequestWithStatusCode(newRequest(http.MethodGet, ts.URL, nil), http.StatusOK)
+		assert.Nil(t, err)
+	})
+	t.Run("Should: skip verify", func(t *testing.T) {
+		tool, err := New("version").ForCheck(nil, &scheduler_config_storage.HTTPTLS{InsecureSkipVerify: true})
+		assert.Nil(t, err)
+		_, _, err = tool.SendRequestTimeoutStatusCode(newRequest(http.MethodGet, ts.URL, nil), time.Second, http.StatusOK)
+		assert.Nil(t, err)
+	})
+	t.Run("Should: send client certificate", func(t *testing.T) {
+		cert, key := clientCertPEM(t)
+		pool := x509.NewCertPool()
+		pool.AppendCertsFromPEM([]byte(cert))
+		mtls := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		mtls.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: pool}
+		mtls.StartTLS()
+		defer mtls.Close()
+
+		tool, err := New("version").ForCheck(nil, &scheduler_config_storage.HTTPTLS{CABundle: serverCertPEM(mtls)})
+		assert.Nil(t, err)
+		_, _, err = tool.SendRequestTimeoutStatusCode(newRequest(http.MethodGet, mtls.URL, nil), time.Second, http.StatusOK)
+		assert.NotNil(t, err)
+
+		tool, err = New("version").ForCheck(nil, &scheduler_config_storage.HTTPTLS{
+			CABundle:   serverCertPEM(mtls),
+			ClientCert: cert,
+			ClientKey:  key,
+		})
+		assert.Nil(t, err)
+		_, _, err = tool.SendRequestTimeoutStatusCode(newRequest(http.MethodGet, mtls.URL, nil), time.Second, http.StatusOK)
+		assert.Nil(t, err)
+	})
+}
+
+func TestHttpTool_ForCheckAuth(t *testing.T) {
+	t.Run("Should: send basic auth", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			user, password, ok := r.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, "user", user)
+			assert.Equal(t, "pass", password)
+		}))
+		defer ts.Close()
+		tool, err := New("version").ForCheck(&scheduler_config_storage.HTTPAuth{BasicUser: "user", BasicPassword: "pass"}, nil)
+		assert.Nil(t, err)
+		_, _, err = tool.SendRequestTimeoutStatusCode(newRequest(http.MethodGet, ts.URL, nil), time.Second, http.StatusOK)
+		assert.Nil(t, err)
+	})
+	t.Run("Should: replace authorization header by bearer token", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer token", r.Header.Get(authorizationHeaderKey))
+		}))
+		defer ts.Close()
+		h := New("version")
+		tool, err := h.ForCheck(&scheduler_config_storage.HTTPAuth{BearerToken: "token"}, nil)
+		assert.Nil(t, err)
+		req := tool.CreateRequest(http.MethodGet, ts.URL, &map[string]string{authorizationHeaderKey: "Basic a"}, nil, "1")
+		_, _, err = tool.SendRequestTimeoutStatusCode(req, time.Second, http.StatusOK)
+		assert.Nil(t, err)
+		assert.Equal(t, "Basic a", req.Header.Get(authorizationHeaderKey))
+	})
+}
+
+func TestHttpTool_WithCookieJar(t *testing.T) {
+	t.Run("Should: keep auth of check and cookies only in own jar", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer token", r.Header.Get(authorizationHeaderKey))
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "s1"})
+		}))
+		defer ts.Close()
+		check, err := New("version").ForCheck(&scheduler_config_storage.HTTPAuth{BearerToken: "token"}, nil)
+		assert.Nil(t, err)
+		jar, _ := cookiejar.New(nil)
+		tool := check.(*httpTool).WithCookieJar(jar)
+		req := newRequest(http.MethodGet, ts.URL, nil)
+		_, _, err = tool.SendRequestTimeoutStatusCode(req, time.Second, http.StatusOK)
+		assert.Nil(t, err)
+		assert.Len(t, jar.Cookies(req.URL), 1)
+		assert.Nil(t, check.(*httpTool).client.Jar)
+		assert.Nil(t, check.(*httpTool).timeoutClient.Jar)
+	})
+}
+
+func TestHttpTool_ForCheckOAuth2(t *testing.T) {
+	var fetched int32
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, secret, _ := r.BasicAuth()
+		if id != "id" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		assert.Nil(t, r.ParseForm())
+		assert.Equal(t, "client_credentials", r.Form.Get("grant_type"))
+		assert.Equal(t, "read write", r.Form.Get("scope"))
+		n := atomic.AddInt32(&fetched, 1)
+		_, _ = fmt.Fprintf(w, `{"access_token":"token%d","token_type":"bearer","expires_in":3600}`, n)
+	}))
+	defer tokenServer.Close()
+
+	var revoked int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&revoked) == 1 && r.Header.Get(authorizationHeaderKey) == "Bearer token1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	auth := &scheduler_config_storage.HTTPAuth{OAuth2: &scheduler_config_storage.OAuth2Config{
+		TokenURL:     tokenServer.URL,
+		ClientID:     "id",
+		ClientSecret: "secret",
+		Scopes:       []string{"read", "write"},
+	}}
+
+	t.Run("Should: fetch token once and cache it", func(t *testing.T) {
+		tool, err := New("version").ForCheck(auth, nil)
+		assert.Nil(t, err)
+		for i := 0; i < 3; i++ {
+			_, _, err = tool.SendRequestTimeoutStatusCode(newRequest(http.MethodGet, ts.URL, nil), time.Second, http.StatusOK)
+			assert.Nil(t, err)
+		}
+		assert.Equal(t, int32(1), atomic.LoadInt32(&fetched))
+
+		atomic.StoreInt32(&revoked, 1)
+		_, _, err = tool.SendRequestTimeoutStatusCode(newRequest(http.MethodGet, ts.URL, nil), time.Second, http.StatusOK)
+		assert.NotNil(t, err)
+		_, _, err = tool.SendRequestTimeoutStatusCode(newRequest(http.MethodGet, ts.URL, nil), time.Second, http.StatusOK)
+		assert.Nil(t, err)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&fetched))
+	})
+	t.Run("Should: return error because token is not issued", func(t *testing.T) {
+		tool, err := New("version").ForCheck(&scheduler_config_storage.HTTPAuth{OAuth2: &scheduler_config_storage.OAuth2Config{
+			TokenURL: tokenServer.URL,
+			ClientID: "wrong",
+		}}, nil)
+		assert.Nil(t, err)
+		_, _, err = tool.SendRequestTimeoutStatusCode(newRequest(http.MethodGet, ts.URL, nil), time.Second, http.StatusOK)
+		assert.ErrorIs(t, err, errOAuth2Token)
+	})
+	t.Run("Should: return error because response has no token", func(t *testing.T) {
+		empty := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{}`))
+		}))
+		defer empty.Close()
+		tool, err := New("version").ForCheck(&scheduler_config_storage.HTTPAuth{OAuth2: &scheduler_config_storage.OAuth2Config{
+			TokenURL: empty.URL,
+		}}, nil)
+		assert.Nil(t, err)
+		_, _, err = tool.SendRequestTimeoutStatusCode(newRequest(http.MethodGet, ts.URL, nil), time.Second, http.StatusOK)
+		assert.ErrorIs(t, err, errOAuth2Token)
+	})
+}
@@ -1,6 +1,7 @@
 package httptools
 
 import (
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
 	"github.com/stretchr/testify/assert"
 	"io"
 	"math"
@@ -113,28 +114,65 @@ func TestHttpTool_CreateRequest(t *testing.T) {
 		m := map[string]string{
 			"trata": "trata",
 		}
-		rq := h.CreateRequest(http.MethodGet, "http://test.ru", &m, "23")
+		rq := h.CreateRequest(http.MethodGet, "http://test.ru", &m, nil, "23")
 		assert.Equal(t, "http://test.ru", rq.URL.String())
 		assert.Equal(t, http.MethodGet, rq.Method)
 	})
 	t.Run("Should: create request without headers", func(t *testing.T) {
 		h := New("veriosn")
-		rq := h.CreateRequest(http.MethodGet, "http://test.ru", nil, "")
+		rq := h.CreateRequest(http.MethodGet, "http://test.ru", nil, nil, "")
 		assert.Equal(t, "http://test.ru", rq.URL.String())
 		assert.Equal(t, http.MethodGet, rq.Method)
 	})
 	t.Run("Should: create log header if present", func(t *testing.T) {
 		h := New("veriosn")
-		rq := h.CreateRequest(http.MethodGet, "http://test.ru", nil, "12")
+		rq := h.CreateRequest(http.MethodGet, "http://test.ru", nil, nil, "12")
 		assert.Equal(t, "http://test.ru", rq.URL.String())
 		assert.Equal(t, rq.Header.Get(logHeader), "12")
 	})
 	t.Run("Should: create with user agent", func(t *testing.T) {
 		h := New("version")
-		rq := h.CreateRequest(http.MethodGet, "http://test.ru", nil, "12")
+		rq := h.CreateRequest(http.MethodGet, "http://test.ru", nil, nil, "12")
 		assert.Equal(t, "http://test.ru", rq.URL.String())
 		assert.Equal(t, rq.Header.Get(userAgentHeaderKey), getUserAgent("version"))
 	})
+	t.Run("Should: create request with rendered body and content type", func(t *testing.T) {
+		h := New("version")
+		rq := h.CreateRequest(http.MethodPost, "http://test.ru", nil, &scheduler_config_storage.HTTPBody{
+			Content:     `{"ts":{{timestamp}}}`,
+			ContentType: "application/json",
+		}, "12")
+		assert.Equal(t, http.MethodPost, rq.Method)
+		assert.Equal(t, "application/json", rq.Header.Get(contentTypeHeaderKey))
+		data, err := io.ReadAll(rq.Body)
+		assert.Nil(t, err)
+		assert.NotContains(t, string(data), "{{timestamp}}")
+	})
+	t.Run("Should: prefer content type from headers", func(t *testing.T) {
+		h := New("version")
+		m := map[string]string{
+			"Content-Type": "text/plain",
+		}
+		rq := h.CreateRequest(http.MethodPut, "http://test.ru", &m, &scheduler_config_storage.HTTPBody{
+			Content:     "a=b",
+			ContentType: "application/x-www-form-urlencoded",
+		}, "")
+		assert.Equal(t, "text/plain", rq.Header.Get(contentTypeHeaderKey))
+	})
+}
+
+func TestRenderBody(t *testing.T) {
+	t.Run("Should: replace all placeholders", func(t *testing.T) {
+		now := time.Unix(1600000000, 123000000)
+		assert.Equal(
+			t,
+			`{"id":"abc","ts":1600000000,"ms":1600000000123,"same":"abc"}`,
+			renderBody(`{"id":"{{uuid}}","ts":{{timestamp}},"ms":{{timestamp_ms}},"same":"{{uuid}}"}`, now, "abc"),
+		)
+	})
+	t.Run("Should: keep content without placeholders", func(t *testing.T) {
+		assert.Equal(t, "a={{b}}", renderBody("a={{b}}", time.Now(), "abc"))
+	})
 }
 
 func TestHttpTool_SendRequestTimeout(t *testing.T) {
@@ -208,3 +246,32 @@ func TestHttpTool_SendRequestTimeoutStatusCode(t *testing.T) {
 		assert.Equal(t, []uint8([]byte(nil)), body)
 	})
 }
+
+func TestHttpTool_SendRequestTimeoutResponse(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer ts.Close()
+	t.Run("Should: return response with headers", func(t *testing.T) {
+		j := New("")
+		resp, body, err := j.SendRequestTimeoutResponse(newRequest(http.MethodGet, ts.URL, nil), time.Second, http.StatusOK)
+		assert.Nil(t, err)
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+		assert.Equal(t, []byte("{}"), body)
+	})
+	t.Run("Should: return error because status code is not expected", func(t *testing.T) {
+		j := New("")
+		resp, body, err := j.SendRequestTimeoutResponse(newRequest(http.MethodGet, ts.URL, nil), 0, http.StatusCreated)
+		assert.NotNil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Nil(t, body)
+	})
+	t.Run("Should: return error because server is not available", func(t *testing.T) {
+		j := New("")
+		resp, _, err := j.SendRequestTimeoutResponse(newRequest(http.MethodGet, "http://localhost:1", nil), time.Second, http.StatusOK)
+		assert.NotNil(t, err)
+		assert.Nil(t, resp)
+	})
+}
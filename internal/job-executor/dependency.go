@@ -0,0 +1,51 @@
+package job_executor
+
+import (
+	"context"
+	"github.com/squzy/squzy/internal/job"
+	"github.com/squzy/squzy/internal/logger"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+)
+
+// Parent which is skipped itself is failing too, so whole chain below broken check is skipped
+func isFailing(code apiPb.SchedulerCode) bool {
+	return code == apiPb.SchedulerCode_ERROR || code == apiPb.SchedulerCode_SKIPPED
+}
+
+// Failed check is marked as SKIPPED if one of parents is failing now,
+// so incident is opened only for root cause. Parent status is taken from its last execution
+func (e *executor) skipByDependency(config *scheduler_config_storage.SchedulerConfig, result job.CheckError) {
+	snapshot := getSnapshot(result)
+	if snapshot == nil || snapshot.Code != apiPb.SchedulerCode_ERROR {
+		return
+	}
+	for _, parentID := range config.ParentIDs {
+		parent, err := e.configStorage.Get(context.Background(), parentID)
+		if err != nil || parent == nil {
+			// Removed parent should not hide errors
+			continue
+		}
+		if !isFailing(parent.LastCode) {
+			continue
+		}
+		snapshot.Code = apiPb.SchedulerCode_SKIPPED
+		if snapshot.Meta == nil {
+			snapshot.Meta = &apiPb.SchedulerSnapshot_MetaData{}
+		}
+		snapshot.Meta.SkippedBy = parentID.Hex()
+		return
+	}
+}
+
+// Code is saved only when it is changed, so DB is not touched on every run
+func (e *executor) saveLastCode(config *scheduler_config_storage.SchedulerConfig, result job.CheckError) {
+	snapshot := getSnapshot(result)
+	if snapshot == nil || snapshot.Code == config.LastCode {
+		return
+	}
+	err := e.configStorage.SetLastCode(context.Background(), config.ID, snapshot.Code)
+	if err != nil {
+		logger.Errorf("Could not save last code for scheduler id %s: %s", config.ID.Hex(), err.Error())
+	}
+}
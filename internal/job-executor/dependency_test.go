@@ -0,0 +1,171 @@
+package job_executor
+
+import (
+	"context"
+	"errors"
+	"github.com/squzy/squzy/internal/job"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"testing"
+)
+
+type configStorageParents struct {
+	configStorageMockOk
+	codes   map[primitive.ObjectID]apiPb.SchedulerCode
+	saved   map[primitive.ObjectID]apiPb.SchedulerCode
+	recover map[primitive.ObjectID]int32
+}
+
+func (c *configStorageParents) Get(ctx context.Context, schedulerID primitive.ObjectID) (*scheduler_config_storage.SchedulerConfig, error) {
+	code, ok := c.codes[schedulerID]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return &scheduler_config_storage.SchedulerConfig{ID: schedulerID, LastCode: code}, nil
+}
+
+func (c *configStorageParents) SetLastCode(ctx context.Context, schedulerID primitive.ObjectID, code apiPb.SchedulerCode) error {
+	c.saved[schedulerID] = code
+	return nil
+}
+
+func (c *configStorageParents) SetRecoverLeft(ctx context.Context, schedulerID primitive.ObjectID, left int32) error {
+	c.recover[schedulerID] = left
+	return nil
+}
+
+type maintenanceCheckerByID struct {
+	ids map[primitive.ObjectID]bool
+}
+
+func (m maintenanceCheckerByID) Check(config *scheduler_config_storage.SchedulerConfig) (apiPb.MaintenanceMode, bool) {
+	return apiPb.MaintenanceMode_MAINTENANCE_MODE_MARK, m.ids[config.ID]
+}
+
+func (c *configStorageParents) PushRecentCode(ctx context.Context, schedulerID primitive.ObjectID, code apiPb.SchedulerCode, window int32) error {
+	return nil
+}
+
+func TestExecutor_ExecuteWithConfigDependency(t *testing.T) {
+	okParent := primitive.NewObjectID()
+	failingParent := primitive.NewObjectID()
+	skippedParent := primitive.NewObjectID()
+	removedParent := primitive.NewObjectID()
+	code := apiPb.SchedulerCode_ERROR
+	execTCP := func(schedulerId string, timeout int32, config *scheduler_config_storage.TCPConfig) job.CheckError {
+		return newCheckResult(code, "connection refused")
+	}
+	newExecutor := func() (ConfigExecutor, *externalStorageCapture, *configStorageParents) {
+		storage := &externalStorageCapture{}
+		configStorage := &configStorageParents{
+			codes: map[primitive.ObjectID]apiPb.SchedulerCode{
+				okParent:      apiPb.SchedulerCode_OK,
+				failingParent: apiPb.SchedulerCode_ERROR,
+				skippedParent: apiPb.SchedulerCode_SKIPPED,
+			},
+			saved:   map[primitive.ObjectID]apiPb.SchedulerCode{},
+			recover: map[primitive.ObjectID]int32{},
+		}
+		s := NewExecutor(storage, nil, nil, nil, configStorage, execTCP, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		return s, storage, configStorage
+	}
+	t.Run("Should: skip check because parent is failing", func(t *testing.T) {
+		code = apiPb.SchedulerCode_ERROR
+		s, storage, configStorage := newExecutor()
+		config := &scheduler_config_storage.SchedulerConfig{
+			ID:        primitive.NewObjectID(),
+			Type:      apiPb.SchedulerType_TCP,
+			ParentIDs: []primitive.ObjectID{removedParent, okParent, failingParent},
+		}
+		s.ExecuteWithConfig(config)
+		snapshot := storage.logs[0].Snapshot
+		assert.Equal(t, apiPb.SchedulerCode_SKIPPED, snapshot.Code)
+		assert.Equal(t, failingParent.Hex(), snapshot.Meta.SkippedBy)
+		assert.Equal(t, "connection refused", snapshot.Error.Message)
+		assert.Equal(t, apiPb.SchedulerCode_SKIPPED, configStorage.saved[config.ID])
+	})
+	t.Run("Should: skip check because parent is skipped", func(t *testing.T) {
+		code = apiPb.SchedulerCode_ERROR
+		s, storage, _ := newExecutor()
+		s.ExecuteWithConfig(&scheduler_config_storage.SchedulerConfig{
+			ID:        primitive.NewObjectID(),
+			Type:      apiPb.SchedulerType_TCP,
+			ParentIDs: []primitive.ObjectID{skippedParent},
+		})
+		assert.Equal(t, apiPb.SchedulerCode_SKIPPED, storage.logs[0].Snapshot.Code)
+	})
+	t.Run("Should: keep error because parents are fine", func(t *testing.T) {
+		code = apiPb.SchedulerCode_ERROR
+		s, storage, configStorage := newExecutor()
+		config := &scheduler_config_storage.SchedulerConfig{
+			ID:        primitive.NewObjectID(),
+			Type:      apiPb.SchedulerType_TCP,
+			ParentIDs: []primitive.ObjectID{okParent, removedParent},
+		}
+		s.ExecuteWithConfig(config)
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, storage.logs[0].Snapshot.Code)
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, configStorage.saved[config.ID])
+	})
+	t.Run("Should: keep ok while parent is failing", func(t *testing.T) {
+		code = apiPb.SchedulerCode_OK
+		s, storage, _ := newExecutor()
+		s.ExecuteWithConfig(&scheduler_config_storage.SchedulerConfig{
+			ID:        primitive.NewObjectID(),
+			Type:      apiPb.SchedulerType_TCP,
+			ParentIDs: []primitive.ObjectID{failingParent},
+		})
+		assert.Equal(t, apiPb.SchedulerCode_OK, storage.logs[0].Snapshot.Code)
+	})
+	t.Run("Should: not save same code", func(t *testing.T) {
+		code = apiPb.SchedulerCode_OK
+		s, _, configStorage := newExecutor()
+		s.ExecuteWithConfig(&scheduler_config_storage.SchedulerConfig{
+			ID:       primitive.NewObjectID(),
+			Type:     apiPb.SchedulerType_TCP,
+			LastCode: apiPb.SchedulerCode_OK,
+		})
+		assert.Len(t, configStorage.saved, 0)
+	})
+	t.Run("Should: write result even if code is not saved", func(t *testing.T) {
+		code = apiPb.SchedulerCode_OK
+		storage := &externalStorageCapture{}
+		s := NewExecutor(storage, nil, nil, nil, configStorageMockError{}, execTCP, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		s.ExecuteWithConfig(&scheduler_config_storage.SchedulerConfig{
+			ID:   primitive.NewObjectID(),
+			Type: apiPb.SchedulerType_TCP,
+		})
+		assert.Len(t, storage.logs, 1)
+	})
+	t.Run("Should: not skip dependent check because parent failed during maintenance", func(t *testing.T) {
+		code = apiPb.SchedulerCode_ERROR
+		parentID := primitive.NewObjectID()
+		storage := &externalStorageCapture{}
+		configStorage := &configStorageParents{
+			codes:   map[primitive.ObjectID]apiPb.SchedulerCode{},
+			saved:   map[primitive.ObjectID]apiPb.SchedulerCode{},
+			recover: map[primitive.ObjectID]int32{},
+		}
+		s := NewExecutor(storage, nil, nil, nil, configStorage, execTCP, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			maintenanceCheckerByID{ids: map[primitive.ObjectID]bool{parentID: true}}, nil)
+		s.ExecuteWithConfig(&scheduler_config_storage.SchedulerConfig{
+			ID:              parentID,
+			Type:            apiPb.SchedulerType_TCP,
+			FailureInterval: 10,
+		})
+		assert.Equal(t, apiPb.SchedulerCode_MAINTENANCE, storage.logs[0].Snapshot.Code)
+		assert.Equal(t, apiPb.SchedulerCode_MAINTENANCE, configStorage.saved[parentID])
+		assert.NotContains(t, configStorage.recover, parentID)
+
+		configStorage.codes[parentID] = configStorage.saved[parentID]
+		child := &scheduler_config_storage.SchedulerConfig{
+			ID:        primitive.NewObjectID(),
+			Type:      apiPb.SchedulerType_TCP,
+			ParentIDs: []primitive.ObjectID{parentID},
+		}
+		s.ExecuteWithConfig(child)
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, storage.logs[1].Snapshot.Code)
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, configStorage.saved[child.ID])
+	})
+}
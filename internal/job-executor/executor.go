@@ -7,6 +7,8 @@ import (
 	"github.com/squzy/squzy/internal/httptools"
 	"github.com/squzy/squzy/internal/job"
 	"github.com/squzy/squzy/internal/logger"
+	"github.com/squzy/squzy/internal/maintenance"
+	"github.com/squzy/squzy/internal/probe"
 	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
 	"github.com/squzy/squzy/internal/semaphore"
 	sitemap_storage "github.com/squzy/squzy/internal/sitemap-storage"
@@ -14,6 +16,7 @@ import (
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"google.golang.org/grpc"
+	"time"
 )
 
 type HTTPExecutor func(schedulerId string,
@@ -58,6 +61,14 @@ type MysqlExecutor func(schedulerId string, config *scheduler_config_storage.DbC
 
 type PostgresExecutor func(schedulerId string, config *scheduler_config_storage.DbConfig, dbC job.DBConnector) job.CheckError
 
+type DNSExecutor func(schedulerId string, timeout int32, config *scheduler_config_storage.DNSConfig) job.CheckError
+
+type UDPExecutor func(schedulerId string, timeout int32, config *scheduler_config_storage.TCPConfig) job.CheckError
+
+type RedisExecutor func(schedulerId string, timeout int32, config *scheduler_config_storage.RedisConfig) job.CheckError
+
+type HTTPScenarioExecutor func(schedulerId string, timeout int32, config *scheduler_config_storage.HTTPScenarioConfig, httpTool httptools.HTTPTool) job.CheckError
+
 type executor struct {
 	externalStorage    storage.Storage
 	siteMapStorage     sitemap_storage.SiteMapStorage
@@ -74,9 +85,25 @@ type executor struct {
 	execMongo          MongoExecutor
 	execMysql          MysqlExecutor
 	execPostgres       PostgresExecutor
+	execDNS            DNSExecutor
+	execUDP            UDPExecutor
+	execRedis          RedisExecutor
+	execHTTPScenario   HTTPScenarioExecutor
+	maintenanceChecker maintenance.Checker
+	probes             probe.Hub
+	recoveryListener   RecoveryListener
+	sleep              func(time.Duration)
 }
 
 func (e *executor) Execute(schedulerID primitive.ObjectID) {
+	config := e.GetConfig(schedulerID)
+	if config == nil {
+		return
+	}
+	e.ExecuteWithConfig(config)
+}
+
+func (e *executor) GetConfig(schedulerID primitive.ObjectID) *scheduler_config_storage.SchedulerConfig {
 	config, err := e.configStorage.Get(context.Background(), schedulerID)
 	if err != nil || config == nil {
 		msg := schedulerID.Hex()
@@ -84,50 +111,155 @@ func (e *executor) Execute(schedulerID primitive.ObjectID) {
 			msg += err.Error()
 		}
 		logger.Errorf("Could not get config for schedulerID: %s", msg)
+		return nil
+	}
+	return config
+}
+
+func (e *executor) ExecuteWithConfig(config *scheduler_config_storage.SchedulerConfig) {
+	inMaintenance := false
+	if e.maintenanceChecker != nil {
+		var mode apiPb.MaintenanceMode
+		mode, inMaintenance = e.maintenanceChecker.Check(config)
+		if inMaintenance && mode == apiPb.MaintenanceMode_MAINTENANCE_MODE_SKIP {
+			logger.Infof("Job skipped because of maintenance for scheduler id %s", config.ID)
+			return
+		}
+	}
+	result := e.run(config)
+	if result == nil {
 		return
 	}
+	// Failure in maintenance is not outage, so it should not skip dependents or start recovery
+	if inMaintenance {
+		markMaintenance(result)
+	}
+	e.skipByDependency(config, result)
+	e.saveLastCode(config, result)
+	e.saveRecoverLeft(config, result)
+	// Maintenance results are not compared by flap detection
+	e.saveRecentCode(config, result)
+	_ = e.externalStorage.Write(result)
+}
+
+func (e *executor) Test(config *scheduler_config_storage.SchedulerConfig) *apiPb.SchedulerResponse {
+	result := e.executeLocal(config)
+	if result == nil {
+		return nil
+	}
+	return result.GetLogData()
+}
+
+// Checks with locations are executed by probes, they apply retry policy themselves
+func (e *executor) run(config *scheduler_config_storage.SchedulerConfig) job.CheckError {
+	if len(config.Locations) > 0 && e.probes != nil {
+		return e.probes.Execute(config)
+	}
+	return e.executeLocal(config)
+}
+
+func (e *executor) executeLocal(config *scheduler_config_storage.SchedulerConfig) job.CheckError {
+	return executeWithRetry(config.RetryPolicy, func() job.CheckError {
+		return e.execute(config)
+	}, e.sleep)
+}
+
+// Return nil if type of config is not supported
+func (e *executor) execute(config *scheduler_config_storage.SchedulerConfig) job.CheckError {
+	schedulerID := config.ID
 	id := schedulerID.Hex()
+	var result job.CheckError
 	switch config.Type {
 	case apiPb.SchedulerType_TCP:
-		_ = e.externalStorage.Write(e.execTCP(id, config.Timeout, config.TCPConfig))
+		result = e.execTCP(id, config.Timeout, config.TCPConfig)
 		logger.Infof("TCP job executed is used for scheduler id %s", schedulerID)
 	case apiPb.SchedulerType_GRPC:
-		_ = e.externalStorage.Write(e.execGrpc(id, config.Timeout, config.GrpcConfig, grpc.WithInsecure()))
+		result = e.execGrpc(id, config.Timeout, config.GrpcConfig, grpc.WithInsecure())
 		logger.Infof("gRPC job executed is used for scheduler id %s", schedulerID)
 	case apiPb.SchedulerType_HTTP:
-		_ = e.externalStorage.Write(e.execHTTP(id, config.Timeout, config.HTTPConfig, e.httpTool))
+		result = e.execHTTP(id, config.Timeout, config.HTTPConfig, e.httpTool)
 		logger.Infof("HTTP job executed is used for scheduler id %s", schedulerID)
 	case apiPb.SchedulerType_SITE_MAP:
-		_ = e.externalStorage.Write(e.execSiteMap(id, config.Timeout, config.SiteMapConfig, e.siteMapStorage, e.httpTool, e.semaphoreFactoryFn))
+		result = e.execSiteMap(id, config.Timeout, config.SiteMapConfig, e.siteMapStorage, e.httpTool, e.semaphoreFactoryFn)
 		logger.Infof("Site map job executed is used for scheduler id %s", schedulerID)
 	case apiPb.SchedulerType_HTTP_JSON_VALUE:
-		_ = e.externalStorage.Write(e.execHTTPValue(id, config.Timeout, config.HTTPValueConfig, e.httpTool))
+		result = e.execHTTPValue(id, config.Timeout, config.HTTPValueConfig, e.httpTool)
 		logger.Infof("HTTP JSON job executed is used for scheduler id %s", schedulerID)
 	case apiPb.SchedulerType_SSL_EXPIRATION:
-		_ = e.externalStorage.Write(e.execSSLExpiration(id, config.Timeout, config.SslExpirationConfig, nil))
+		result = e.execSSLExpiration(id, config.Timeout, config.SslExpirationConfig, nil)
 		logger.Infof("SSL Expiration job executed is used for scheduler id %s", schedulerID)
 	case apiPb.SchedulerType_CASSANDRA:
 		cTools := cassandra_tools.NewCassandraTools(config.Db.Cluster, config.Db.User, config.Db.Password, config.Timeout)
-		_ = e.externalStorage.Write(e.execCassandra(id, config.Db, cTools))
+		result = e.execCassandra(id, config.Db, cTools)
 		logger.Infof("CASSANDRA job executed is used for scheduler id %s", schedulerID)
 	case apiPb.SchedulerType_MONGO:
-		_ = e.externalStorage.Write(e.execMongo(id, config.Db, job.NewMongoConnection()))
+		result = e.execMongo(id, config.Db, job.NewMongoConnection())
 		logger.Infof("MONGO job executed is used for scheduler id %s", schedulerID)
 	case apiPb.SchedulerType_MYSQL:
-		_ = e.externalStorage.Write(e.execMysql(id, config.Db, job.NewDBConnection()))
+		result = e.execMysql(id, config.Db, job.NewDBConnection())
 		logger.Infof("MYSQL job executed is used for scheduler id %s", schedulerID)
 	case apiPb.SchedulerType_POSTGRES:
-		_ = e.externalStorage.Write(e.execPostgres(id, config.Db, job.NewDBConnection()))
+		result = e.execPostgres(id, config.Db, job.NewDBConnection())
 		logger.Infof("POSTGRES job executed is used for scheduler id %s", schedulerID)
+	case apiPb.SchedulerType_DNS:
+		result = e.execDNS(id, config.Timeout, config.DNSConfig)
+		logger.Infof("DNS job executed is used for scheduler id %s", schedulerID)
+	case apiPb.SchedulerType_UDP:
+		result = e.execUDP(id, config.Timeout, config.UDPConfig)
+		logger.Infof("UDP job executed is used for scheduler id %s", schedulerID)
+	case apiPb.SchedulerType_REDIS:
+		result = e.execRedis(id, config.Timeout, config.RedisConfig)
+		logger.Infof("REDIS job executed is used for scheduler id %s", schedulerID)
+	case apiPb.SchedulerType_HTTP_SCENARIO:
+		result = e.execHTTPScenario(id, config.Timeout, config.HTTPScenarioConfig, e.httpTool)
+		logger.Infof("HTTP_SCENARIO job executed is used for scheduler id %s", schedulerID)
 	default:
 		logger.Errorf("Incorrect config type passed to job executor: %s", config.Type)
 	}
+	return result
+}
+
+// Snapshot keeps error and meta, only code is replaced so storage and incidents could ignore it
+func markMaintenance(result job.CheckError) {
+	snapshot := getSnapshot(result)
+	if snapshot == nil {
+		return
+	}
+	snapshot.Code = apiPb.SchedulerCode_MAINTENANCE
 }
 
 type JobExecutor interface {
 	Execute(schedulerID primitive.ObjectID)
 }
 
+// RecoveryListener plans next run of check with failure interval right after its result is saved
+type RecoveryListener interface {
+	Reschedule(schedulerID primitive.ObjectID, failing bool)
+}
+
+// RecoveryNotifier is implemented by executors which report state of failing checks
+type RecoveryNotifier interface {
+	SetRecoveryListener(listener RecoveryListener)
+}
+
+// Tester runs check synchronously from squzy monitoring itself, locations, maintenance windows are ignored
+// and result is not written to storage
+type Tester interface {
+	// Return nil if type of config is not supported
+	Test(config *scheduler_config_storage.SchedulerConfig) *apiPb.SchedulerResponse
+}
+
+// ConfigExecutor allows to load config and execute check separately,
+// so pool can know type of check before it takes worker
+type ConfigExecutor interface {
+	JobExecutor
+	Tester
+	RecoveryNotifier
+	// Return nil if config could not be loaded
+	GetConfig(schedulerID primitive.ObjectID) *scheduler_config_storage.SchedulerConfig
+	ExecuteWithConfig(config *scheduler_config_storage.SchedulerConfig)
+}
+
 func NewExecutor(
 	externalStorage storage.Storage,
 	siteMapStorage sitemap_storage.SiteMapStorage,
@@ -144,7 +276,13 @@ func NewExecutor(
 	execMongo MongoExecutor,
 	execMysql MysqlExecutor,
 	execPostgres PostgresExecutor,
-) JobExecutor {
+	execDNS DNSExecutor,
+	execUDP UDPExecutor,
+	execRedis RedisExecutor,
+	execHTTPScenario HTTPScenarioExecutor,
+	maintenanceChecker maintenance.Checker,
+	probes probe.Hub,
+) ConfigExecutor {
 	return &executor{
 		externalStorage:    externalStorage,
 		siteMapStorage:     siteMapStorage,
@@ -161,5 +299,12 @@ func NewExecutor(
 		execMongo:          execMongo,
 		execMysql:          execMysql,
 		execPostgres:       execPostgres,
+		execDNS:            execDNS,
+		execUDP:            execUDP,
+		execRedis:          execRedis,
+		execHTTPScenario:   execHTTPScenario,
+		maintenanceChecker: maintenanceChecker,
+		probes:             probes,
+		sleep:              time.Sleep,
 	}
 }
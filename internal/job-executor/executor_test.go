@@ -7,6 +7,7 @@ import (
 	cassandra_tools "github.com/squzy/squzy/internal/cassandra-tools"
 	"github.com/squzy/squzy/internal/httptools"
 	"github.com/squzy/squzy/internal/job"
+	"github.com/squzy/squzy/internal/labels"
 	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
 	"github.com/squzy/squzy/internal/semaphore"
 	sitemap_storage "github.com/squzy/squzy/internal/sitemap-storage"
@@ -60,6 +61,26 @@ func (c configStorageMockOk) GetAllForSync(ctx context.Context) ([]*scheduler_co
 	panic("implement me")
 }
 
+func (c configStorageMockOk) SetLastCode(ctx context.Context, schedulerID primitive.ObjectID, code apiPb.SchedulerCode) error {
+	return nil
+}
+
+func (c configStorageMockOk) SetRecoverLeft(ctx context.Context, schedulerID primitive.ObjectID, left int32) error {
+	return nil
+}
+
+func (c configStorageMockOk) PushRecentCode(ctx context.Context, schedulerID primitive.ObjectID, code apiPb.SchedulerCode, window int32) error {
+	return nil
+}
+
+func (c configStorageMockOk) Update(ctx context.Context, config *scheduler_config_storage.SchedulerConfig) error {
+	panic("implement me")
+}
+
+func (c configStorageMockOk) GetBySelector(ctx context.Context, selector labels.Selector) ([]*scheduler_config_storage.SchedulerConfig, error) {
+	panic("implement me")
+}
+
 type configStorageMockError struct {
 }
 
@@ -91,6 +112,26 @@ func (c configStorageMockError) GetAllForSync(ctx context.Context) ([]*scheduler
 	panic("implement me")
 }
 
+func (c configStorageMockError) SetLastCode(ctx context.Context, schedulerID primitive.ObjectID, code apiPb.SchedulerCode) error {
+	return errors.New("cant set code")
+}
+
+func (c configStorageMockError) SetRecoverLeft(ctx context.Context, schedulerID primitive.ObjectID, left int32) error {
+	return errors.New("cant set code")
+}
+
+func (c configStorageMockError) PushRecentCode(ctx context.Context, schedulerID primitive.ObjectID, code apiPb.SchedulerCode, window int32) error {
+	return errors.New("cant set code")
+}
+
+func (c configStorageMockError) Update(ctx context.Context, config *scheduler_config_storage.SchedulerConfig) error {
+	panic("implement me")
+}
+
+func (c configStorageMockError) GetBySelector(ctx context.Context, selector labels.Selector) ([]*scheduler_config_storage.SchedulerConfig, error) {
+	panic("implement me")
+}
+
 type fnMock struct {
 	executed bool
 }
@@ -158,6 +199,26 @@ func (m *fnMock) PostgresMock(schedulerId string,
 	return nil
 }
 
+func (m *fnMock) DNSMock(schedulerId string, timeout int32, config *scheduler_config_storage.DNSConfig) job.CheckError {
+	m.executed = true
+	return nil
+}
+
+func (m *fnMock) UDPMock(schedulerId string, timeout int32, config *scheduler_config_storage.TCPConfig) job.CheckError {
+	m.executed = true
+	return nil
+}
+
+func (m *fnMock) RedisMock(schedulerId string, timeout int32, config *scheduler_config_storage.RedisConfig) job.CheckError {
+	m.executed = true
+	return nil
+}
+
+func (m *fnMock) HTTPScenarioMock(schedulerId string, timeout int32, config *scheduler_config_storage.HTTPScenarioConfig, httpTool httptools.HTTPTool) job.CheckError {
+	m.executed = true
+	return nil
+}
+
 func TestNewExecutor(t *testing.T) {
 	t.Run("Should: implement interface", func(t *testing.T) {
 		s := NewExecutor(
@@ -176,6 +237,12 @@ func TestNewExecutor(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 		assert.Implements(t, (*JobExecutor)(nil), s)
 	})
@@ -200,6 +267,12 @@ func TestExecutor_Execute(t *testing.T) {
 			fnMock.MongoMock,
 			fnMock.MysqlMock,
 			fnMock.PostgresMock,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 		s.Execute(primitive.NewObjectID())
 		assert.Equal(t, false, fnMock.executed)
@@ -224,6 +297,12 @@ func TestExecutor_Execute(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 		s.Execute(primitive.NewObjectID())
 		assert.Equal(t, true, fnMock.executed)
@@ -248,6 +327,12 @@ func TestExecutor_Execute(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 		s.Execute(primitive.NewObjectID())
 		assert.Equal(t, true, fnMock.executed)
@@ -272,6 +357,12 @@ func TestExecutor_Execute(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 		s.Execute(primitive.NewObjectID())
 		assert.Equal(t, true, fnMock.executed)
@@ -296,6 +387,12 @@ func TestExecutor_Execute(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 		s.Execute(primitive.NewObjectID())
 		assert.Equal(t, true, fnMock.executed)
@@ -320,6 +417,12 @@ func TestExecutor_Execute(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 		s.Execute(primitive.NewObjectID())
 		assert.Equal(t, true, fnMock.executed)
@@ -344,6 +447,12 @@ func TestExecutor_Execute(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 		s.Execute(primitive.NewObjectID())
 		assert.Equal(t, true, fnMock.executed)
@@ -368,6 +477,12 @@ func TestExecutor_Execute(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 		s.Execute(primitive.NewObjectID())
 		assert.Equal(t, true, fnMock.executed)
@@ -392,6 +507,12 @@ func TestExecutor_Execute(t *testing.T) {
 			fnMock.MongoMock,
 			nil,
 			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 		s.Execute(primitive.NewObjectID())
 		assert.Equal(t, true, fnMock.executed)
@@ -416,6 +537,12 @@ func TestExecutor_Execute(t *testing.T) {
 			nil,
 			fnMock.MysqlMock,
 			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 		s.Execute(primitive.NewObjectID())
 		assert.Equal(t, true, fnMock.executed)
@@ -440,6 +567,132 @@ func TestExecutor_Execute(t *testing.T) {
 			nil,
 			nil,
 			fnMock.PostgresMock,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+		s.Execute(primitive.NewObjectID())
+		assert.Equal(t, true, fnMock.executed)
+	})
+	t.Run("Should: execute DNS mock", func(t *testing.T) {
+		fnMock := &fnMock{}
+		s := NewExecutor(
+			&externalStorageMock{},
+			nil,
+			nil,
+			nil,
+			&configStorageMockOk{
+				apiPb.SchedulerType_DNS,
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			fnMock.DNSMock,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+		s.Execute(primitive.NewObjectID())
+		assert.Equal(t, true, fnMock.executed)
+	})
+	t.Run("Should: execute UDP mock", func(t *testing.T) {
+		fnMock := &fnMock{}
+		s := NewExecutor(
+			&externalStorageMock{},
+			nil,
+			nil,
+			nil,
+			&configStorageMockOk{
+				apiPb.SchedulerType_UDP,
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			fnMock.UDPMock,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+		s.Execute(primitive.NewObjectID())
+		assert.Equal(t, true, fnMock.executed)
+	})
+	t.Run("Should: execute REDIS mock", func(t *testing.T) {
+		fnMock := &fnMock{}
+		s := NewExecutor(
+			&externalStorageMock{},
+			nil,
+			nil,
+			nil,
+			&configStorageMockOk{
+				apiPb.SchedulerType_REDIS,
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			fnMock.RedisMock,
+			nil,
+			nil,
+			nil,
+		)
+		s.Execute(primitive.NewObjectID())
+		assert.Equal(t, true, fnMock.executed)
+	})
+	t.Run("Should: execute HTTP_SCENARIO mock", func(t *testing.T) {
+		fnMock := &fnMock{}
+		s := NewExecutor(
+			&externalStorageMock{},
+			nil,
+			nil,
+			nil,
+			&configStorageMockOk{
+				apiPb.SchedulerType_HTTP_SCENARIO,
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			fnMock.HTTPScenarioMock,
+			nil,
+			nil,
 		)
 		s.Execute(primitive.NewObjectID())
 		assert.Equal(t, true, fnMock.executed)
@@ -464,8 +717,201 @@ func TestExecutor_Execute(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 		s.Execute(primitive.NewObjectID())
 		assert.Equal(t, false, fnMock.executed)
 	})
 }
+
+func TestExecutor_GetConfig(t *testing.T) {
+	t.Run("Should: return nil because cant get config", func(t *testing.T) {
+		s := NewExecutor(nil, nil, nil, nil, &configStorageMockError{}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		assert.Nil(t, s.GetConfig(primitive.NewObjectID()))
+	})
+	t.Run("Should: return config", func(t *testing.T) {
+		s := NewExecutor(nil, nil, nil, nil, &configStorageMockOk{apiPb.SchedulerType_TCP}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		config := s.GetConfig(primitive.NewObjectID())
+		assert.NotNil(t, config)
+		assert.Equal(t, apiPb.SchedulerType_TCP, config.Type)
+	})
+}
+
+type maintenanceCheckerMock struct {
+	mode   apiPb.MaintenanceMode
+	active bool
+}
+
+func (m maintenanceCheckerMock) Check(config *scheduler_config_storage.SchedulerConfig) (apiPb.MaintenanceMode, bool) {
+	return m.mode, m.active
+}
+
+type checkErrorMock struct {
+	log *apiPb.SchedulerResponse
+}
+
+func (c checkErrorMock) GetLogData() *apiPb.SchedulerResponse {
+	return c.log
+}
+
+type externalStorageCapture struct {
+	logs []*apiPb.SchedulerResponse
+}
+
+func (e *externalStorageCapture) Write(log job.CheckError) error {
+	e.logs = append(e.logs, log.GetLogData())
+	return nil
+}
+
+func TestExecutor_ExecuteWithConfigMaintenance(t *testing.T) {
+	config := &scheduler_config_storage.SchedulerConfig{
+		ID:   primitive.NewObjectID(),
+		Type: apiPb.SchedulerType_TCP,
+	}
+	executed := false
+	execTCP := func(schedulerId string, timeout int32, config *scheduler_config_storage.TCPConfig) job.CheckError {
+		executed = true
+		return checkErrorMock{log: &apiPb.SchedulerResponse{
+			Snapshot: &apiPb.SchedulerSnapshot{
+				Code:  apiPb.SchedulerCode_ERROR,
+				Error: &apiPb.SchedulerSnapshot_Error{Message: "error"},
+			},
+		}}
+	}
+	newExecutor := func(storage *externalStorageCapture, checker maintenanceCheckerMock) ConfigExecutor {
+		return NewExecutor(storage, nil, nil, nil, configStorageMockOk{}, execTCP, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, checker, nil)
+	}
+	t.Run("Should: mark snapshot as maintenance", func(t *testing.T) {
+		executed = false
+		storage := &externalStorageCapture{}
+		s := newExecutor(storage, maintenanceCheckerMock{mode: apiPb.MaintenanceMode_MAINTENANCE_MODE_MARK, active: true})
+		s.ExecuteWithConfig(config)
+		assert.True(t, executed)
+		assert.Len(t, storage.logs, 1)
+		assert.Equal(t, apiPb.SchedulerCode_MAINTENANCE, storage.logs[0].Snapshot.Code)
+		assert.Equal(t, "error", storage.logs[0].Snapshot.Error.Message)
+	})
+	t.Run("Should: not execute job", func(t *testing.T) {
+		executed = false
+		storage := &externalStorageCapture{}
+		s := newExecutor(storage, maintenanceCheckerMock{mode: apiPb.MaintenanceMode_MAINTENANCE_MODE_SKIP, active: true})
+		s.ExecuteWithConfig(config)
+		assert.False(t, executed)
+		assert.Len(t, storage.logs, 0)
+	})
+	t.Run("Should: keep code outside of maintenance", func(t *testing.T) {
+		storage := &externalStorageCapture{}
+		s := newExecutor(storage, maintenanceCheckerMock{})
+		s.ExecuteWithConfig(config)
+		assert.Len(t, storage.logs, 1)
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, storage.logs[0].Snapshot.Code)
+	})
+}
+
+func TestExecutor_Test(t *testing.T) {
+	execTCP := func(schedulerId string, timeout int32, config *scheduler_config_storage.TCPConfig) job.CheckError {
+		return checkErrorMock{log: &apiPb.SchedulerResponse{
+			SchedulerId: schedulerId,
+			Snapshot: &apiPb.SchedulerSnapshot{
+				Code:  apiPb.SchedulerCode_ERROR,
+				Error: &apiPb.SchedulerSnapshot_Error{Message: "error"},
+			},
+		}}
+	}
+	t.Run("Should: return result without writing it", func(t *testing.T) {
+		storage := &externalStorageCapture{}
+		s := NewExecutor(storage, nil, nil, nil, nil, execTCP, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			maintenanceCheckerMock{mode: apiPb.MaintenanceMode_MAINTENANCE_MODE_SKIP, active: true}, nil)
+		id := primitive.NewObjectID()
+		res := s.Test(&scheduler_config_storage.SchedulerConfig{
+			ID:   id,
+			Type: apiPb.SchedulerType_TCP,
+		})
+		assert.Equal(t, id.Hex(), res.SchedulerId)
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res.Snapshot.Code)
+		assert.Len(t, storage.logs, 0)
+	})
+	t.Run("Should: return nil because type is not supported", func(t *testing.T) {
+		s := NewExecutor(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		assert.Nil(t, s.Test(&scheduler_config_storage.SchedulerConfig{}))
+	})
+	t.Run("Should: execute check locally even with locations", func(t *testing.T) {
+		probes := &probeHubMock{}
+		s := NewExecutor(nil, nil, nil, nil, nil, execTCP, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, probes)
+		res := s.Test(&scheduler_config_storage.SchedulerConfig{
+			ID:        primitive.NewObjectID(),
+			Type:      apiPb.SchedulerType_TCP,
+			Locations: []string{"eu"},
+		})
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res.Snapshot.Code)
+		assert.False(t, probes.executed)
+	})
+}
+
+type probeHubMock struct {
+	executed bool
+}
+
+func (p *probeHubMock) Execute(config *scheduler_config_storage.SchedulerConfig) job.CheckError {
+	p.executed = true
+	return checkErrorMock{log: &apiPb.SchedulerResponse{
+		SchedulerId: config.ID.Hex(),
+		Snapshot: &apiPb.SchedulerSnapshot{
+			Code: apiPb.SchedulerCode_OK,
+		},
+	}}
+}
+
+func (p *probeHubMock) Subscribe(location string) (<-chan *apiPb.ProbeTask, func()) {
+	panic("implement me")
+}
+
+func (p *probeHubMock) Report(rq *apiPb.ProbeResultRequest) error {
+	panic("implement me")
+}
+
+func (p *probeHubMock) Authorize(ctx context.Context) error {
+	panic("implement me")
+}
+
+func TestExecutor_ExecuteWithConfigLocations(t *testing.T) {
+	executed := false
+	execTCP := func(schedulerId string, timeout int32, config *scheduler_config_storage.TCPConfig) job.CheckError {
+		executed = true
+		return checkErrorMock{log: &apiPb.SchedulerResponse{
+			Snapshot: &apiPb.SchedulerSnapshot{Code: apiPb.SchedulerCode_ERROR},
+		}}
+	}
+	t.Run("Should: execute check by probes", func(t *testing.T) {
+		executed = false
+		storage := &externalStorageCapture{}
+		probes := &probeHubMock{}
+		s := NewExecutor(storage, nil, nil, nil, configStorageMockOk{}, execTCP, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, probes)
+		s.ExecuteWithConfig(&scheduler_config_storage.SchedulerConfig{
+			ID:        primitive.NewObjectID(),
+			Type:      apiPb.SchedulerType_TCP,
+			Locations: []string{"eu"},
+		})
+		assert.True(t, probes.executed)
+		assert.False(t, executed)
+		assert.Len(t, storage.logs, 1)
+		assert.Equal(t, apiPb.SchedulerCode_OK, storage.logs[0].Snapshot.Code)
+	})
+	t.Run("Should: execute check locally without probes", func(t *testing.T) {
+		executed = false
+		storage := &externalStorageCapture{}
+		s := NewExecutor(storage, nil, nil, nil, configStorageMockOk{}, execTCP, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		s.ExecuteWithConfig(&scheduler_config_storage.SchedulerConfig{
+			ID:        primitive.NewObjectID(),
+			Type:      apiPb.SchedulerType_TCP,
+			Locations: []string{"eu"},
+		})
+		assert.True(t, executed)
+		assert.Len(t, storage.logs, 1)
+	})
+}
@@ -0,0 +1,25 @@
+package job_executor
+
+import (
+	"context"
+	"github.com/squzy/squzy/internal/flapping"
+	"github.com/squzy/squzy/internal/job"
+	"github.com/squzy/squzy/internal/logger"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+)
+
+// Recent codes are saved on every run, so it is done only for schedulers with flap detection
+func (e *executor) saveRecentCode(config *scheduler_config_storage.SchedulerConfig, result job.CheckError) {
+	snapshot := getSnapshot(result)
+	if snapshot == nil || config.FlapDetection == nil {
+		return
+	}
+	window := config.FlapDetection.Window
+	if window <= 0 {
+		window = flapping.DefaultWindow
+	}
+	err := e.configStorage.PushRecentCode(context.Background(), config.ID, snapshot.Code, window)
+	if err != nil {
+		logger.Errorf("Could not save recent code for scheduler id %s: %s", config.ID.Hex(), err.Error())
+	}
+}
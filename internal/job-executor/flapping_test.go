@@ -0,0 +1,61 @@
+package job_executor
+
+import (
+	"context"
+	"github.com/squzy/squzy/internal/job"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"testing"
+)
+
+type configStorageRecent struct {
+	configStorageMockOk
+	codes   []apiPb.SchedulerCode
+	windows []int32
+}
+
+func (c *configStorageRecent) PushRecentCode(ctx context.Context, schedulerID primitive.ObjectID, code apiPb.SchedulerCode, window int32) error {
+	c.codes = append(c.codes, code)
+	c.windows = append(c.windows, window)
+	return nil
+}
+
+func TestExecutor_ExecuteWithConfigFlapping(t *testing.T) {
+	execTCP := func(schedulerId string, timeout int32, config *scheduler_config_storage.TCPConfig) job.CheckError {
+		return newCheckResult(apiPb.SchedulerCode_ERROR, "")
+	}
+	newExecutor := func() (ConfigExecutor, *configStorageRecent) {
+		configStorage := &configStorageRecent{}
+		s := NewExecutor(&externalStorageCapture{}, nil, nil, nil, configStorage, execTCP, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		return s, configStorage
+	}
+	t.Run("Should: save code with window", func(t *testing.T) {
+		s, configStorage := newExecutor()
+		s.ExecuteWithConfig(&scheduler_config_storage.SchedulerConfig{
+			ID:            primitive.NewObjectID(),
+			Type:          apiPb.SchedulerType_TCP,
+			FlapDetection: &scheduler_config_storage.FlapDetection{Window: 10},
+		})
+		assert.Equal(t, []apiPb.SchedulerCode{apiPb.SchedulerCode_ERROR}, configStorage.codes)
+		assert.Equal(t, []int32{10}, configStorage.windows)
+	})
+	t.Run("Should: use default window", func(t *testing.T) {
+		s, configStorage := newExecutor()
+		s.ExecuteWithConfig(&scheduler_config_storage.SchedulerConfig{
+			ID:            primitive.NewObjectID(),
+			Type:          apiPb.SchedulerType_TCP,
+			FlapDetection: &scheduler_config_storage.FlapDetection{},
+		})
+		assert.Equal(t, []int32{21}, configStorage.windows)
+	})
+	t.Run("Should: not save code without flap detection", func(t *testing.T) {
+		s, configStorage := newExecutor()
+		s.ExecuteWithConfig(&scheduler_config_storage.SchedulerConfig{
+			ID:   primitive.NewObjectID(),
+			Type: apiPb.SchedulerType_TCP,
+		})
+		assert.Len(t, configStorage.codes, 0)
+	})
+}
@@ -0,0 +1,248 @@
+package job_executor
+
+import (
+	"container/list"
+	"github.com/squzy/squzy/internal/logger"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"sync"
+	"time"
+)
+
+// What to do when scheduler tick comes while previous check of same scheduler still queued or running
+type OverrunPolicy string
+
+const (
+	// Drop new tick
+	OverrunSkip OverrunPolicy = "skip"
+	// Put new tick into queue, it will run right after previous one
+	OverrunQueue OverrunPolicy = "queue"
+)
+
+const (
+	defaultWorkers   = 1
+	defaultQueueSize = 1
+)
+
+type PoolOptions struct {
+	// How many checks can run at the same time
+	Workers int
+	// How many checks can wait for worker, new ticks dropped when queue is full
+	QueueSize int
+	// Max running checks per type, types without limit bounded only by workers
+	TypeLimits map[apiPb.SchedulerType]int
+	Overrun    OverrunPolicy
+	// How often stats should be logged, 0 means never
+	StatsInterval time.Duration
+}
+
+type Stats struct {
+	QueueDepth int
+	Running    int
+	// How long the oldest queued check waits for worker
+	Lag time.Duration
+	// Ticks dropped because of overrun policy
+	Skipped uint64
+	// Ticks dropped because queue was full
+	Dropped uint64
+}
+
+// Pool runs checks with bounded amount of workers, Execute never blocks on check itself
+type Pool interface {
+	JobExecutor
+	RecoveryNotifier
+	Stats() *Stats
+	Stop()
+}
+
+type task struct {
+	config     *scheduler_config_storage.SchedulerConfig
+	enqueuedAt time.Time
+}
+
+type pool struct {
+	mutex    sync.Mutex
+	cond     *sync.Cond
+	queue    *list.List
+	executor ConfigExecutor
+	opts     *PoolOptions
+	// Queued and running ticks per scheduler
+	inFlight map[primitive.ObjectID]int
+	running  map[primitive.ObjectID]bool
+	byType   map[apiPb.SchedulerType]int
+	busy     int
+	skipped  uint64
+	dropped  uint64
+	stopped  bool
+	wg       sync.WaitGroup
+	quitCh   chan struct{}
+	stopOnce sync.Once
+}
+
+func NewPool(executor ConfigExecutor, opts *PoolOptions) Pool {
+	if opts.Workers < 1 {
+		opts.Workers = defaultWorkers
+	}
+	if opts.QueueSize < 1 {
+		opts.QueueSize = defaultQueueSize
+	}
+	if opts.Overrun != OverrunQueue {
+		opts.Overrun = OverrunSkip
+	}
+	p := &pool{
+		queue:    list.New(),
+		executor: executor,
+		opts:     opts,
+		inFlight: map[primitive.ObjectID]int{},
+		running:  map[primitive.ObjectID]bool{},
+		byType:   map[apiPb.SchedulerType]int{},
+		quitCh:   make(chan struct{}),
+	}
+	p.cond = sync.NewCond(&p.mutex)
+	for i := 0; i < opts.Workers; i++ {
+		p.wg.Add(1)
+		go p.work()
+	}
+	if opts.StatsInterval > 0 {
+		go p.report()
+	}
+	return p
+}
+
+func (p *pool) Execute(schedulerID primitive.ObjectID) {
+	p.mutex.Lock()
+	if p.opts.Overrun == OverrunSkip && p.inFlight[schedulerID] > 0 {
+		p.skipped++
+		p.mutex.Unlock()
+		logger.Infof("Previous check still in progress, tick skipped for scheduler id %s", schedulerID.Hex())
+		return
+	}
+	// Reserve slot before config is loaded, so concurrent tick of same scheduler would see it
+	p.inFlight[schedulerID]++
+	p.mutex.Unlock()
+
+	config := p.executor.GetConfig(schedulerID)
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	switch {
+	case config == nil, p.stopped:
+		p.release(schedulerID)
+	case p.queue.Len() >= p.opts.QueueSize:
+		p.dropped++
+		p.release(schedulerID)
+		logger.Errorf("Job queue is full, tick dropped for scheduler id %s", schedulerID.Hex())
+	default:
+		p.queue.PushBack(&task{
+			config:     config,
+			enqueuedAt: time.Now(),
+		})
+		p.cond.Signal()
+	}
+}
+
+// Should be called under lock
+func (p *pool) release(schedulerID primitive.ObjectID) {
+	p.inFlight[schedulerID]--
+	if p.inFlight[schedulerID] <= 0 {
+		delete(p.inFlight, schedulerID)
+	}
+}
+
+func (p *pool) SetRecoveryListener(listener RecoveryListener) {
+	p.executor.SetRecoveryListener(listener)
+}
+
+func (p *pool) Stats() *Stats {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	stats := &Stats{
+		QueueDepth: p.queue.Len(),
+		Skipped:    p.skipped,
+		Dropped:    p.dropped,
+		Running:    p.busy,
+	}
+	if front := p.queue.Front(); front != nil {
+		stats.Lag = time.Since(front.Value.(*task).enqueuedAt)
+	}
+	return stats
+}
+
+// Should wait for running checks, queued checks are dropped
+func (p *pool) Stop() {
+	p.stopOnce.Do(func() {
+		p.mutex.Lock()
+		p.stopped = true
+		p.queue.Init()
+		p.cond.Broadcast()
+		p.mutex.Unlock()
+		close(p.quitCh)
+		p.wg.Wait()
+	})
+}
+
+func (p *pool) work() {
+	defer p.wg.Done()
+	for {
+		p.mutex.Lock()
+		t := p.next()
+		for t == nil && !p.stopped {
+			p.cond.Wait()
+			t = p.next()
+		}
+		if t == nil {
+			p.mutex.Unlock()
+			return
+		}
+		id := t.config.ID
+		p.running[id] = true
+		p.byType[t.config.Type]++
+		p.busy++
+		p.mutex.Unlock()
+
+		p.executor.ExecuteWithConfig(t.config)
+
+		p.mutex.Lock()
+		delete(p.running, id)
+		p.byType[t.config.Type]--
+		p.busy--
+		p.release(id)
+		// Finished check could unblock task of same type or same scheduler
+		p.cond.Broadcast()
+		p.mutex.Unlock()
+	}
+}
+
+// Take first task which is allowed to run, should be called under lock
+func (p *pool) next() *task {
+	for el := p.queue.Front(); el != nil; el = el.Next() {
+		t := el.Value.(*task)
+		if p.running[t.config.ID] {
+			continue
+		}
+		if limit, ok := p.opts.TypeLimits[t.config.Type]; ok && limit > 0 && p.byType[t.config.Type] >= limit {
+			continue
+		}
+		p.queue.Remove(el)
+		return t
+	}
+	return nil
+}
+
+func (p *pool) report() {
+	ticker := time.NewTicker(p.opts.StatsInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s := p.Stats()
+			logger.Infof(
+				"Job pool: queue depth %d, running %d, lag %s, skipped %d, dropped %d",
+				s.QueueDepth, s.Running, s.Lag, s.Skipped, s.Dropped,
+			)
+		case <-p.quitCh:
+			return
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package job_executor
+
+import (
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"sync"
+	"testing"
+	"time"
+)
+
+type configExecutorMock struct {
+	mutex      sync.Mutex
+	types      map[primitive.ObjectID]apiPb.SchedulerType
+	noConfig   bool
+	duration   time.Duration
+	running    int
+	maxRunning map[apiPb.SchedulerType]int
+	byType     map[apiPb.SchedulerType]int
+	executed   []primitive.ObjectID
+	listener   RecoveryListener
+}
+
+func newConfigExecutorMock(duration time.Duration) *configExecutorMock {
+	return &configExecutorMock{
+		types:      map[primitive.ObjectID]apiPb.SchedulerType{},
+		duration:   duration,
+		maxRunning: map[apiPb.SchedulerType]int{},
+		byType:     map[apiPb.SchedulerType]int{},
+	}
+}
+
+func (c *configExecutorMock) Execute(schedulerID primitive.ObjectID) {
+	panic("implement me")
+}
+
+func (c *configExecutorMock) GetConfig(schedulerID primitive.ObjectID) *scheduler_config_storage.SchedulerConfig {
+	if c.noConfig {
+		return nil
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return &scheduler_config_storage.SchedulerConfig{
+		ID:   schedulerID,
+		Type: c.types[schedulerID],
+	}
+}
+
+func (c *configExecutorMock) ExecuteWithConfig(config *scheduler_config_storage.SchedulerConfig) {
+	c.mutex.Lock()
+	c.byType[config.Type]++
+	if c.byType[config.Type] > c.maxRunning[config.Type] {
+		c.maxRunning[config.Type] = c.byType[config.Type]
+	}
+	c.mutex.Unlock()
+
+	time.Sleep(c.duration)
+
+	c.mutex.Lock()
+	c.byType[config.Type]--
+	c.executed = append(c.executed, config.ID)
+	c.mutex.Unlock()
+}
+
+func (c *configExecutorMock) Test(config *scheduler_config_storage.SchedulerConfig) *apiPb.SchedulerResponse {
+	panic("implement me")
+}
+
+func (c *configExecutorMock) SetRecoveryListener(listener RecoveryListener) {
+	c.listener = listener
+}
+
+func (c *configExecutorMock) getExecuted() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return len(c.executed)
+}
+
+func TestNewPool(t *testing.T) {
+	t.Run("Should: implement interface", func(t *testing.T) {
+		p := NewPool(newConfigExecutorMock(0), &PoolOptions{})
+		defer p.Stop()
+		assert.Implements(t, (*Pool)(nil), p)
+	})
+	t.Run("Should: use defaults", func(t *testing.T) {
+		opts := &PoolOptions{Overrun: "unknown"}
+		p := NewPool(newConfigExecutorMock(0), opts)
+		defer p.Stop()
+		assert.Equal(t, &PoolOptions{
+			Workers:   defaultWorkers,
+			QueueSize: defaultQueueSize,
+			Overrun:   OverrunSkip,
+		}, opts)
+	})
+}
+
+func TestPool_SetRecoveryListener(t *testing.T) {
+	t.Run("Should: pass listener to executor", func(t *testing.T) {
+		mock := newConfigExecutorMock(0)
+		p := NewPool(mock, &PoolOptions{})
+		defer p.Stop()
+		listener := &recoveryListenerMock{}
+		p.SetRecoveryListener(listener)
+		assert.Equal(t, listener, mock.listener)
+	})
+}
+
+func TestPool_Execute(t *testing.T) {
+	t.Run("Should: execute check with worker", func(t *testing.T) {
+		mock := newConfigExecutorMock(0)
+		p := NewPool(mock, &PoolOptions{Workers: 2, QueueSize: 10})
+		p.Execute(primitive.NewObjectID())
+		p.Execute(primitive.NewObjectID())
+		time.Sleep(time.Millisecond * 50)
+		p.Stop()
+		assert.Equal(t, 2, mock.getExecuted())
+	})
+	t.Run("Should: not queue check without config", func(t *testing.T) {
+		mock := newConfigExecutorMock(0)
+		mock.noConfig = true
+		p := NewPool(mock, &PoolOptions{Workers: 1, QueueSize: 10})
+		defer p.Stop()
+		id := primitive.NewObjectID()
+		p.Execute(id)
+		p.Execute(id)
+		assert.Equal(t, &Stats{}, p.Stats())
+	})
+	t.Run("Should: drop check when queue is full", func(t *testing.T) {
+		mock := newConfigExecutorMock(time.Millisecond * 100)
+		p := NewPool(mock, &PoolOptions{Workers: 1, QueueSize: 1})
+		p.Execute(primitive.NewObjectID())
+		time.Sleep(time.Millisecond * 20)
+		p.Execute(primitive.NewObjectID())
+		p.Execute(primitive.NewObjectID())
+		stats := p.Stats()
+		assert.Equal(t, 1, stats.QueueDepth)
+		assert.Equal(t, 1, stats.Running)
+		assert.Equal(t, uint64(1), stats.Dropped)
+		assert.True(t, stats.Lag > 0)
+		p.Stop()
+		assert.Equal(t, 1, mock.getExecuted())
+	})
+	t.Run("Should: skip tick while previous check is running", func(t *testing.T) {
+		mock := newConfigExecutorMock(time.Millisecond * 50)
+		p := NewPool(mock, &PoolOptions{Workers: 2, QueueSize: 10, Overrun: OverrunSkip})
+		id := primitive.NewObjectID()
+		p.Execute(id)
+		p.Execute(id)
+		time.Sleep(time.Millisecond * 100)
+		assert.Equal(t, uint64(1), p.Stats().Skipped)
+		p.Execute(id)
+		time.Sleep(time.Millisecond * 100)
+		p.Stop()
+		assert.Equal(t, 2, mock.getExecuted())
+	})
+	t.Run("Should: queue tick and run it after previous check", func(t *testing.T) {
+		mock := newConfigExecutorMock(time.Millisecond * 50)
+		p := NewPool(mock, &PoolOptions{Workers: 2, QueueSize: 10, Overrun: OverrunQueue})
+		id := primitive.NewObjectID()
+		p.Execute(id)
+		p.Execute(id)
+		time.Sleep(time.Millisecond * 20)
+		stats := p.Stats()
+		assert.Equal(t, 1, stats.Running)
+		assert.Equal(t, 1, stats.QueueDepth)
+		time.Sleep(time.Millisecond * 150)
+		p.Stop()
+		assert.Equal(t, 2, mock.getExecuted())
+		assert.Equal(t, uint64(0), p.Stats().Skipped)
+	})
+	t.Run("Should: respect concurrency limit per type", func(t *testing.T) {
+		mock := newConfigExecutorMock(time.Millisecond * 30)
+		p := NewPool(mock, &PoolOptions{
+			Workers:   4,
+			QueueSize: 10,
+			TypeLimits: map[apiPb.SchedulerType]int{
+				apiPb.SchedulerType_SITE_MAP: 1,
+			},
+		})
+		for i := 0; i < 3; i++ {
+			siteMap := primitive.NewObjectID()
+			tcp := primitive.NewObjectID()
+			mock.mutex.Lock()
+			mock.types[siteMap] = apiPb.SchedulerType_SITE_MAP
+			mock.types[tcp] = apiPb.SchedulerType_TCP
+			mock.mutex.Unlock()
+			p.Execute(siteMap)
+			p.Execute(tcp)
+		}
+		time.Sleep(time.Millisecond * 200)
+		p.Stop()
+		assert.Equal(t, 6, mock.getExecuted())
+		mock.mutex.Lock()
+		defer mock.mutex.Unlock()
+		assert.Equal(t, 1, mock.maxRunning[apiPb.SchedulerType_SITE_MAP])
+		assert.Equal(t, 3, mock.maxRunning[apiPb.SchedulerType_TCP])
+	})
+}
+
+func TestPool_Stop(t *testing.T) {
+	t.Run("Should: stop twice and ignore new checks", func(t *testing.T) {
+		mock := newConfigExecutorMock(0)
+		p := NewPool(mock, &PoolOptions{Workers: 1, QueueSize: 1, StatsInterval: time.Millisecond})
+		time.Sleep(time.Millisecond * 5)
+		p.Stop()
+		p.Stop()
+		p.Execute(primitive.NewObjectID())
+		assert.Equal(t, 0, mock.getExecuted())
+		assert.Equal(t, &Stats{}, p.Stats())
+	})
+}
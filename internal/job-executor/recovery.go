@@ -0,0 +1,46 @@
+package job_executor
+
+import (
+	"context"
+	"github.com/squzy/squzy/internal/job"
+	"github.com/squzy/squzy/internal/logger"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+)
+
+const (
+	defaultRecoverAfter = 1
+)
+
+// Failing check resets counter of OK results which are required to get usual schedule back,
+// scheduler uses failure interval while counter is positive
+func (e *executor) saveRecoverLeft(config *scheduler_config_storage.SchedulerConfig, result job.CheckError) {
+	snapshot := getSnapshot(result)
+	if snapshot == nil || config.FailureInterval <= 0 {
+		return
+	}
+	left := config.RecoverLeft
+	switch {
+	case isFailing(snapshot.Code):
+		left = config.RecoverAfter
+		if left < defaultRecoverAfter {
+			left = defaultRecoverAfter
+		}
+	case (snapshot.Code == apiPb.SchedulerCode_OK || snapshot.Code == apiPb.SchedulerCode_WARNING) && left > 0:
+		left--
+	}
+	if left != config.RecoverLeft {
+		err := e.configStorage.SetRecoverLeft(context.Background(), config.ID, left)
+		if err != nil {
+			logger.Errorf("Could not save recover state for scheduler id %s: %s", config.ID.Hex(), err.Error())
+		}
+	}
+	// Next run is planned after result, other replicas could plan it with stale state
+	if e.recoveryListener != nil {
+		e.recoveryListener.Reschedule(config.ID, left > 0)
+	}
+}
+
+func (e *executor) SetRecoveryListener(listener RecoveryListener) {
+	e.recoveryListener = listener
+}
@@ -0,0 +1,136 @@
+package job_executor
+
+import (
+	"context"
+	"github.com/squzy/squzy/internal/job"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"testing"
+)
+
+type configStorageRecover struct {
+	configStorageMockOk
+	saved []int32
+}
+
+func (c *configStorageRecover) SetRecoverLeft(ctx context.Context, schedulerID primitive.ObjectID, left int32) error {
+	c.saved = append(c.saved, left)
+	return nil
+}
+
+type recoveryListenerMock struct {
+	failing []bool
+}
+
+func (r *recoveryListenerMock) Reschedule(schedulerID primitive.ObjectID, failing bool) {
+	r.failing = append(r.failing, failing)
+}
+
+func TestExecutor_ExecuteWithConfigRecover(t *testing.T) {
+	code := apiPb.SchedulerCode_ERROR
+	execTCP := func(schedulerId string, timeout int32, config *scheduler_config_storage.TCPConfig) job.CheckError {
+		return newCheckResult(code, "")
+	}
+	newExecutor := func() (ConfigExecutor, *configStorageRecover) {
+		configStorage := &configStorageRecover{}
+		s := NewExecutor(&externalStorageCapture{}, nil, nil, nil, configStorage, execTCP, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		return s, configStorage
+	}
+	t.Run("Should: start recovering after error", func(t *testing.T) {
+		code = apiPb.SchedulerCode_ERROR
+		s, configStorage := newExecutor()
+		s.ExecuteWithConfig(&scheduler_config_storage.SchedulerConfig{
+			ID:              primitive.NewObjectID(),
+			Type:            apiPb.SchedulerType_TCP,
+			FailureInterval: 15,
+			RecoverAfter:    3,
+		})
+		assert.Equal(t, []int32{3}, configStorage.saved)
+	})
+	t.Run("Should: require one ok result by default", func(t *testing.T) {
+		code = apiPb.SchedulerCode_SKIPPED
+		s, configStorage := newExecutor()
+		s.ExecuteWithConfig(&scheduler_config_storage.SchedulerConfig{
+			ID:              primitive.NewObjectID(),
+			Type:            apiPb.SchedulerType_TCP,
+			FailureInterval: 15,
+		})
+		assert.Equal(t, []int32{1}, configStorage.saved)
+	})
+	t.Run("Should: count ok result", func(t *testing.T) {
+		code = apiPb.SchedulerCode_OK
+		s, configStorage := newExecutor()
+		s.ExecuteWithConfig(&scheduler_config_storage.SchedulerConfig{
+			ID:              primitive.NewObjectID(),
+			Type:            apiPb.SchedulerType_TCP,
+			FailureInterval: 15,
+			RecoverAfter:    3,
+			RecoverLeft:     2,
+		})
+		assert.Equal(t, []int32{1}, configStorage.saved)
+	})
+	t.Run("Should: count warning result", func(t *testing.T) {
+		code = apiPb.SchedulerCode_WARNING
+		s, configStorage := newExecutor()
+		s.ExecuteWithConfig(&scheduler_config_storage.SchedulerConfig{
+			ID:              primitive.NewObjectID(),
+			Type:            apiPb.SchedulerType_TCP,
+			FailureInterval: 15,
+			RecoverAfter:    3,
+			RecoverLeft:     2,
+		})
+		assert.Equal(t, []int32{1}, configStorage.saved)
+	})
+	t.Run("Should: not save state of healthy check", func(t *testing.T) {
+		code = apiPb.SchedulerCode_OK
+		s, configStorage := newExecutor()
+		s.ExecuteWithConfig(&scheduler_config_storage.SchedulerConfig{
+			ID:              primitive.NewObjectID(),
+			Type:            apiPb.SchedulerType_TCP,
+			FailureInterval: 15,
+		})
+		assert.Len(t, configStorage.saved, 0)
+	})
+	t.Run("Should: not save state without failure interval", func(t *testing.T) {
+		code = apiPb.SchedulerCode_ERROR
+		s, configStorage := newExecutor()
+		s.ExecuteWithConfig(&scheduler_config_storage.SchedulerConfig{
+			ID:   primitive.NewObjectID(),
+			Type: apiPb.SchedulerType_TCP,
+		})
+		assert.Len(t, configStorage.saved, 0)
+	})
+	t.Run("Should: not save same state twice", func(t *testing.T) {
+		code = apiPb.SchedulerCode_ERROR
+		s, configStorage := newExecutor()
+		s.ExecuteWithConfig(&scheduler_config_storage.SchedulerConfig{
+			ID:              primitive.NewObjectID(),
+			Type:            apiPb.SchedulerType_TCP,
+			FailureInterval: 15,
+			RecoverLeft:     1,
+		})
+		assert.Len(t, configStorage.saved, 0)
+	})
+	t.Run("Should: notify listener after every result", func(t *testing.T) {
+		s, _ := newExecutor()
+		listener := &recoveryListenerMock{}
+		s.SetRecoveryListener(listener)
+		config := &scheduler_config_storage.SchedulerConfig{
+			ID:              primitive.NewObjectID(),
+			Type:            apiPb.SchedulerType_TCP,
+			FailureInterval: 15,
+			RecoverLeft:     1,
+		}
+		code = apiPb.SchedulerCode_ERROR
+		s.ExecuteWithConfig(config)
+		code = apiPb.SchedulerCode_OK
+		s.ExecuteWithConfig(config)
+		s.ExecuteWithConfig(&scheduler_config_storage.SchedulerConfig{
+			ID:   primitive.NewObjectID(),
+			Type: apiPb.SchedulerType_TCP,
+		})
+		assert.Equal(t, []bool{true, false}, listener.failing)
+	})
+}
@@ -0,0 +1,68 @@
+package job_executor
+
+import (
+	"github.com/squzy/squzy/internal/job"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"strings"
+	"time"
+)
+
+// Execute check again while it fails with retryable error and attempts are not over.
+// Attempts count and errors of failed attempts are written to snapshot meta
+func executeWithRetry(
+	policy *scheduler_config_storage.RetryPolicy,
+	run func() job.CheckError,
+	sleep func(time.Duration),
+) job.CheckError {
+	if policy == nil || policy.Attempts <= 1 {
+		return run()
+	}
+	backoffs := policy.Backoffs()
+	attemptErrors := []string{}
+	attempt := int32(0)
+	var result job.CheckError
+	for attempt < policy.Attempts {
+		attempt++
+		result = run()
+		snapshot := getSnapshot(result)
+		if snapshot == nil || snapshot.Code != apiPb.SchedulerCode_ERROR {
+			break
+		}
+		message := snapshot.GetError().GetMessage()
+		attemptErrors = append(attemptErrors, message)
+		if attempt == policy.Attempts || !isRetryable(policy, message) {
+			break
+		}
+		sleep(backoffs[attempt-1])
+	}
+	snapshot := getSnapshot(result)
+	if snapshot == nil {
+		return result
+	}
+	if snapshot.Meta == nil {
+		snapshot.Meta = &apiPb.SchedulerSnapshot_MetaData{}
+	}
+	snapshot.Meta.Attempts = attempt
+	snapshot.Meta.AttemptErrors = attemptErrors
+	return result
+}
+
+func isRetryable(policy *scheduler_config_storage.RetryPolicy, message string) bool {
+	if len(policy.RetryableErrors) == 0 {
+		return true
+	}
+	for _, retryable := range policy.RetryableErrors {
+		if strings.Contains(message, retryable) {
+			return true
+		}
+	}
+	return false
+}
+
+func getSnapshot(result job.CheckError) *apiPb.SchedulerSnapshot {
+	if result == nil {
+		return nil
+	}
+	return result.GetLogData().GetSnapshot()
+}
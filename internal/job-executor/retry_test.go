@@ -0,0 +1,106 @@
+package job_executor
+
+import (
+	"github.com/squzy/squzy/internal/job"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func newCheckResult(code apiPb.SchedulerCode, message string) job.CheckError {
+	snapshot := &apiPb.SchedulerSnapshot{
+		Code: code,
+		Meta: &apiPb.SchedulerSnapshot_MetaData{},
+	}
+	if message != "" {
+		snapshot.Error = &apiPb.SchedulerSnapshot_Error{Message: message}
+	}
+	return checkErrorMock{log: &apiPb.SchedulerResponse{Snapshot: snapshot}}
+}
+
+// Return results one by one, last result is repeated
+func sequence(results ...job.CheckError) (func() job.CheckError, *int) {
+	calls := 0
+	return func() job.CheckError {
+		calls++
+		if calls > len(results) {
+			return results[len(results)-1]
+		}
+		return results[calls-1]
+	}, &calls
+}
+
+func TestExecuteWithRetry(t *testing.T) {
+	noSleep := func(time.Duration) {}
+	t.Run("Should: run once without policy", func(t *testing.T) {
+		run, calls := sequence(newCheckResult(apiPb.SchedulerCode_ERROR, "CONNECTION_TIMEOUT"))
+		res := executeWithRetry(nil, run, noSleep)
+		assert.Equal(t, 1, *calls)
+		assert.Equal(t, int32(0), res.GetLogData().Snapshot.Meta.Attempts)
+	})
+	t.Run("Should: stop after success", func(t *testing.T) {
+		run, calls := sequence(
+			newCheckResult(apiPb.SchedulerCode_ERROR, "CONNECTION_TIMEOUT"),
+			newCheckResult(apiPb.SchedulerCode_OK, ""),
+		)
+		res := executeWithRetry(&scheduler_config_storage.RetryPolicy{Attempts: 3}, run, noSleep)
+		assert.Equal(t, 2, *calls)
+		snapshot := res.GetLogData().Snapshot
+		assert.Equal(t, apiPb.SchedulerCode_OK, snapshot.Code)
+		assert.Equal(t, int32(2), snapshot.Meta.Attempts)
+		assert.Equal(t, []string{"CONNECTION_TIMEOUT"}, snapshot.Meta.AttemptErrors)
+	})
+	t.Run("Should: return last error when attempts are over", func(t *testing.T) {
+		run, calls := sequence(
+			newCheckResult(apiPb.SchedulerCode_ERROR, "first"),
+			newCheckResult(apiPb.SchedulerCode_ERROR, "second"),
+		)
+		res := executeWithRetry(&scheduler_config_storage.RetryPolicy{Attempts: 2}, run, noSleep)
+		assert.Equal(t, 2, *calls)
+		snapshot := res.GetLogData().Snapshot
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, snapshot.Code)
+		assert.Equal(t, "second", snapshot.Error.Message)
+		assert.Equal(t, []string{"first", "second"}, snapshot.Meta.AttemptErrors)
+	})
+	t.Run("Should: not retry error which is not retryable", func(t *testing.T) {
+		run, calls := sequence(newCheckResult(apiPb.SchedulerCode_ERROR, "WRONG_CONNECTION_CONFIGURATION"))
+		res := executeWithRetry(&scheduler_config_storage.RetryPolicy{
+			Attempts:        3,
+			RetryableErrors: []string{"TIMEOUT", "connection refused"},
+		}, run, noSleep)
+		assert.Equal(t, 1, *calls)
+		assert.Equal(t, int32(1), res.GetLogData().Snapshot.Meta.Attempts)
+	})
+	t.Run("Should: increase backoff", func(t *testing.T) {
+		run, _ := sequence(newCheckResult(apiPb.SchedulerCode_ERROR, "CONNECTION_TIMEOUT"))
+		delays := []time.Duration{}
+		executeWithRetry(&scheduler_config_storage.RetryPolicy{
+			Attempts:          4,
+			Backoff:           100,
+			BackoffMultiplier: 2,
+			RetryableErrors:   []string{"TIMEOUT"},
+		}, run, func(d time.Duration) {
+			delays = append(delays, d)
+		})
+		assert.Equal(t, []time.Duration{time.Millisecond * 100, time.Millisecond * 200, time.Millisecond * 400}, delays)
+	})
+	t.Run("Should: limit backoff", func(t *testing.T) {
+		run, _ := sequence(newCheckResult(apiPb.SchedulerCode_ERROR, "CONNECTION_TIMEOUT"))
+		delays := []time.Duration{}
+		executeWithRetry(&scheduler_config_storage.RetryPolicy{
+			Attempts:          3,
+			Backoff:           50000,
+			BackoffMultiplier: 10,
+		}, run, func(d time.Duration) {
+			delays = append(delays, d)
+		})
+		assert.Equal(t, []time.Duration{time.Second * 50, time.Minute}, delays)
+	})
+	t.Run("Should: not fail on empty result", func(t *testing.T) {
+		run, calls := sequence(nil)
+		assert.Nil(t, executeWithRetry(&scheduler_config_storage.RetryPolicy{Attempts: 3}, run, noSleep))
+		assert.Equal(t, 1, *calls)
+	})
+}
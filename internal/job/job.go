@@ -2,7 +2,10 @@ package job
 
 import (
 	"errors"
+	"fmt"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"time"
 )
 
 var (
@@ -18,8 +21,39 @@ var (
 	cassandraPingError       = errors.New("NO_PING_CASSANDRA")
 	mysqlConnectionError     = errors.New("UNABLE_TO_CONNECT_MYSQL")
 	mysqlPingError           = errors.New("NO_PING_MYSQL")
+	redisConnectionError     = errors.New("UNABLE_TO_CONNECT_REDIS")
+	redisAuthError           = errors.New("REDIS_AUTH_FAILED")
+	redisPingError           = errors.New("NO_PING_REDIS")
+	redisInfoError           = errors.New("NO_INFO_REDIS")
+	redisThresholdError      = errors.New("REDIS_THRESHOLD_EXCEEDED")
+	redisMasterLinkDownError = errors.New("REDIS_MASTER_LINK_DOWN")
+
+	errSlowResponse    = errors.New("SLOW_RESPONSE")
+	errSslExpiresSoon  = errors.New("SSL_EXPIRES_SOON")
+	errValueOutOfRange = errors.New("VALUE_OUT_OF_RANGE")
 )
 
 type CheckError interface {
 	GetLogData() *apiPb.SchedulerResponse
 }
+
+// Passed check is WARNING when it took longer than warningTime milliseconds, 0 means not checked
+func slowResponse(startTime *timestamp.Timestamp, endTime *timestamp.Timestamp, warningTime int32) error {
+	if warningTime <= 0 {
+		return nil
+	}
+	limit := time.Duration(warningTime) * time.Millisecond
+	duration := endTime.AsTime().Sub(startTime.AsTime())
+	if duration <= limit {
+		return nil
+	}
+	return fmt.Errorf("%w: %s longer than %s", errSlowResponse, duration.String(), limit.String())
+}
+
+// Code of passed check, warning is kept as description
+func passedCode(warning error) (apiPb.SchedulerCode, string) {
+	if warning != nil {
+		return apiPb.SchedulerCode_WARNING, warning.Error()
+	}
+	return apiPb.SchedulerCode_OK, ""
+}
@@ -37,7 +37,7 @@ func newCassandraError(schedulerID string, startTime, endTime *timestamp.Timesta
 
 func (s *cassandraError) GetLogData() *apiPb.SchedulerResponse {
 	var err *apiPb.SchedulerSnapshot_Error
-	if s.code == apiPb.SchedulerCode_ERROR {
+	if s.code != apiPb.SchedulerCode_OK {
 		err = &apiPb.SchedulerSnapshot_Error{
 			Message: s.description,
 		}
@@ -0,0 +1,311 @@
+package job
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/squzy/squzy/internal/helpers"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"golang.org/x/net/dns/dnsmessage"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"math/rand"
+	"net"
+	"strings"
+	"time"
+)
+
+const (
+	dnsPort = "53"
+	// Max size of dns message over udp without EDNS
+	dnsUDPSize = 512
+)
+
+var (
+	errDNSNotFound     = errors.New("DNS_NXDOMAIN")
+	errDNSFailed       = errors.New("DNS_FAILED")
+	errDNSNoAnswer     = errors.New("DNS_NO_ANSWER")
+	errDNSMismatch     = errors.New("DNS_MISMATCH")
+	errDNSTTLTooBig    = errors.New("DNS_TTL_TOO_BIG")
+	errDNSWrongType    = errors.New("DNS_WRONG_RECORD_TYPE")
+	errDNSWrongMessage = errors.New("DNS_WRONG_MESSAGE")
+
+	dnsTypes = map[apiPb.DnsConfig_RecordType]dnsmessage.Type{
+		apiPb.DnsConfig_A:     dnsmessage.TypeA,
+		apiPb.DnsConfig_AAAA:  dnsmessage.TypeAAAA,
+		apiPb.DnsConfig_CNAME: dnsmessage.TypeCNAME,
+		apiPb.DnsConfig_MX:    dnsmessage.TypeMX,
+		apiPb.DnsConfig_TXT:   dnsmessage.TypeTXT,
+		apiPb.DnsConfig_NS:    dnsmessage.TypeNS,
+		apiPb.DnsConfig_SRV:   dnsmessage.TypeSRV,
+	}
+)
+
+type dnsError struct {
+	schedulerID string
+	startTime   *timestamp.Timestamp
+	endTime     *timestamp.Timestamp
+	code        apiPb.SchedulerCode
+	description string
+	value       *structpb.Value
+}
+
+func (e *dnsError) GetLogData() *apiPb.SchedulerResponse {
+	var err *apiPb.SchedulerSnapshot_Error
+	if e.code != apiPb.SchedulerCode_OK {
+		err = &apiPb.SchedulerSnapshot_Error{
+			Message: e.description,
+		}
+	}
+	return &apiPb.SchedulerResponse{
+		SchedulerId: e.schedulerID,
+		Snapshot: &apiPb.SchedulerSnapshot{
+			Code:  e.code,
+			Error: err,
+			Type:  apiPb.SchedulerType_DNS,
+			Meta: &apiPb.SchedulerSnapshot_MetaData{
+				StartTime: e.startTime,
+				EndTime:   e.endTime,
+				Value:     e.value,
+			},
+		},
+	}
+}
+
+func newDNSError(schedulerID string, startTime *timestamp.Timestamp, endTime *timestamp.Timestamp, code apiPb.SchedulerCode, description string, value *structpb.Value) CheckError {
+	return &dnsError{
+		schedulerID: schedulerID,
+		startTime:   startTime,
+		endTime:     endTime,
+		code:        code,
+		description: description,
+		value:       value,
+	}
+}
+
+type dnsAnswer struct {
+	value string
+	ttl   uint32
+}
+
+// ExecDNS resolves record directly by resolver, answers are kept as list of strings
+func ExecDNS(schedulerID string, timeout int32, config *scheduler_config_storage.DNSConfig) CheckError {
+	startTime := timestamp.Now()
+	answers, err := resolve(config, helpers.DurationNotNegative(timeout))
+	if err != nil {
+		return newDNSError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, err.Error(), nil)
+	}
+	values := []*structpb.Value{}
+	for _, answer := range answers {
+		values = append(values, structpb.NewStringValue(answer.value))
+	}
+	value := &structpb.Value{
+		Kind: &structpb.Value_ListValue{
+			ListValue: &structpb.ListValue{
+				Values: values,
+			},
+		},
+	}
+	if err := checkAnswers(config, answers); err != nil {
+		return newDNSError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, err.Error(), value)
+	}
+	return newDNSError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_OK, "", value)
+}
+
+func checkAnswers(config *scheduler_config_storage.DNSConfig, answers []*dnsAnswer) error {
+	if len(answers) == 0 {
+		return errDNSNoAnswer
+	}
+	got := []string{}
+	for _, answer := range answers {
+		if config.MaxTTL > 0 && answer.ttl > uint32(config.MaxTTL) {
+			return fmt.Errorf("%w: %s has ttl %d", errDNSTTLTooBig, answer.value, answer.ttl)
+		}
+		got = append(got, answer.value)
+	}
+	for _, expected := range config.Expected {
+		if !containsAnswer(got, expected) {
+			return fmt.Errorf("%w: %s not found in %s", errDNSMismatch, expected, strings.Join(got, ", "))
+		}
+	}
+	return nil
+}
+
+// Names are compared without trailing dot and case
+func containsAnswer(answers []string, expected string) bool {
+	expected = strings.TrimSuffix(expected, ".")
+	for _, answer := range answers {
+		if strings.EqualFold(answer, expected) {
+			return true
+		}
+	}
+	return false
+}
+
+func resolve(config *scheduler_config_storage.DNSConfig, timeout time.Duration) ([]*dnsAnswer, error) {
+	recordType, ok := dnsTypes[config.RecordType]
+	if !ok {
+		return nil, errDNSWrongType
+	}
+	name, err := dnsmessage.NewName(fqdn(config.Name))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errDNSWrongMessage, err.Error())
+	}
+	id := uint16(rand.Intn(1 << 16))
+	query := dnsmessage.Message{
+		Header: dnsmessage.Header{ID: id, RecursionDesired: true},
+		Questions: []dnsmessage.Question{
+			{Name: name, Type: recordType, Class: dnsmessage.ClassINET},
+		},
+	}
+	packed, err := query.Pack()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errDNSWrongMessage, err.Error())
+	}
+	address := resolverAddress(config.Resolver)
+	deadline := time.Now().Add(timeout)
+	response, err := exchangeUDP(address, packed, deadline)
+	if err != nil {
+		return nil, err
+	}
+	// Answer does not fit into udp message, so it is asked again by tcp
+	if response.Truncated {
+		response, err = exchangeTCP(address, packed, deadline)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if response.ID != id {
+		return nil, errDNSWrongMessage
+	}
+	switch response.RCode {
+	case dnsmessage.RCodeSuccess:
+	case dnsmessage.RCodeNameError:
+		return nil, errDNSNotFound
+	default:
+		return nil, fmt.Errorf("%w: %s", errDNSFailed, response.RCode.String())
+	}
+	answers := []*dnsAnswer{}
+	for _, resource := range response.Answers {
+		if resource.Header.Type != recordType {
+			continue
+		}
+		answers = append(answers, &dnsAnswer{
+			value: resourceValue(resource.Body),
+			ttl:   resource.Header.TTL,
+		})
+	}
+	return answers, nil
+}
+
+func resourceValue(body dnsmessage.ResourceBody) string {
+	switch r := body.(type) {
+	case *dnsmessage.AResource:
+		return net.IP(r.A[:]).String()
+	case *dnsmessage.AAAAResource:
+		return net.IP(r.AAAA[:]).String()
+	case *dnsmessage.CNAMEResource:
+		return trimDot(r.CNAME)
+	case *dnsmessage.MXResource:
+		return fmt.Sprintf("%d %s", r.Pref, trimDot(r.MX))
+	case *dnsmessage.TXTResource:
+		return strings.Join(r.TXT, "")
+	case *dnsmessage.NSResource:
+		return trimDot(r.NS)
+	case *dnsmessage.SRVResource:
+		return fmt.Sprintf("%d %d %d %s", r.Priority, r.Weight, r.Port, trimDot(r.Target))
+	}
+	return ""
+}
+
+func trimDot(name dnsmessage.Name) string {
+	return strings.TrimSuffix(name.String(), ".")
+}
+
+func fqdn(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+func resolverAddress(resolver string) string {
+	if _, _, err := net.SplitHostPort(resolver); err == nil {
+		return resolver
+	}
+	return net.JoinHostPort(strings.Trim(resolver, "[]"), dnsPort)
+}
+
+func exchangeUDP(address string, query []byte, deadline time.Time) (*dnsmessage.Message, error) {
+	conn, err := net.DialTimeout("udp", address, time.Until(deadline))
+	if err != nil {
+		return nil, dnsConnError(err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	_ = conn.SetDeadline(deadline)
+	if _, err := conn.Write(query); err != nil {
+		return nil, dnsConnError(err)
+	}
+	buf := make([]byte, dnsUDPSize)
+	n, err := conn.Read(buf)
+	if err != nil {
+		return nil, dnsConnError(err)
+	}
+	return parseDNS(buf[:n])
+}
+
+// Messages over tcp are prefixed by length
+func exchangeTCP(address string, query []byte, deadline time.Time) (*dnsmessage.Message, error) {
+	conn, err := net.DialTimeout("tcp", address, time.Until(deadline))
+	if err != nil {
+		return nil, dnsConnError(err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	_ = conn.SetDeadline(deadline)
+	msg := make([]byte, 2+len(query))
+	binary.BigEndian.PutUint16(msg, uint16(len(query)))
+	copy(msg[2:], query)
+	if _, err := conn.Write(msg); err != nil {
+		return nil, dnsConnError(err)
+	}
+	length := make([]byte, 2)
+	if _, err := io.ReadFull(conn, length); err != nil {
+		return nil, dnsConnError(err)
+	}
+	buf := make([]byte, binary.BigEndian.Uint16(length))
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		return nil, dnsConnError(err)
+	}
+	return parseDNS(buf)
+}
+
+// Truncated message could be cut in the middle of record, so only header is returned
+func parseDNS(buf []byte) (*dnsmessage.Message, error) {
+	var parser dnsmessage.Parser
+	header, err := parser.Start(buf)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errDNSWrongMessage, err.Error())
+	}
+	if header.Truncated {
+		return &dnsmessage.Message{Header: header}, nil
+	}
+	msg := &dnsmessage.Message{}
+	if err := msg.Unpack(buf); err != nil {
+		return nil, fmt.Errorf("%w: %s", errDNSWrongMessage, err.Error())
+	}
+	return msg, nil
+}
+
+func dnsConnError(err error) error {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return errConnTimeoutError
+	}
+	return errWrongConnectConfigError
+}
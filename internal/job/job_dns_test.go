@@ -0,0 +1,260 @@
+package job
+
+import (
+	"encoding/binary"
+	"errors"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/dns/dnsmessage"
+	"io"
+	"net"
+	"testing"
+)
+
+type dnsHandler func(question dnsmessage.Question) (dnsmessage.RCode, []dnsmessage.Resource)
+
+type dnsServer struct {
+	udp     net.PacketConn
+	tcp     net.Listener
+	handler dnsHandler
+	// Udp answers are sent truncated, so client should ask again by tcp
+	truncate bool
+}
+
+func newDNSServer(t *testing.T, handler dnsHandler, truncate bool) *dnsServer {
+	udp, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	tcp, err := net.Listen("tcp", udp.LocalAddr().String())
+	assert.Nil(t, err)
+	s := &dnsServer{udp: udp, tcp: tcp, handler: handler, truncate: truncate}
+	go s.serveUDP()
+	go s.serveTCP()
+	return s
+}
+
+func (s *dnsServer) Addr() string {
+	return s.udp.LocalAddr().String()
+}
+
+func (s *dnsServer) Close() {
+	_ = s.udp.Close()
+	_ = s.tcp.Close()
+}
+
+func (s *dnsServer) answer(query []byte, truncate bool) []byte {
+	msg := dnsmessage.Message{}
+	if err := msg.Unpack(query); err != nil {
+		return nil
+	}
+	question := msg.Questions[0]
+	rcode, answers := s.handler(question)
+	res := dnsmessage.Message{
+		Header: dnsmessage.Header{
+			ID:                 msg.ID,
+			Response:           true,
+			RecursionAvailable: true,
+			RCode:              rcode,
+			Truncated:          truncate,
+		},
+		Questions: msg.Questions,
+	}
+	if !truncate {
+		res.Answers = answers
+	}
+	packed, _ := res.Pack()
+	return packed
+}
+
+func (s *dnsServer) serveUDP() {
+	buf := make([]byte, dnsUDPSize)
+	for {
+		n, addr, err := s.udp.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		_, _ = s.udp.WriteTo(s.answer(buf[:n], s.truncate), addr)
+	}
+}
+
+func (s *dnsServer) serveTCP() {
+	for {
+		conn, err := s.tcp.Accept()
+		if err != nil {
+			return
+		}
+		length := make([]byte, 2)
+		if _, err := io.ReadFull(conn, length); err == nil {
+			query := make([]byte, binary.BigEndian.Uint16(length))
+			if _, err := io.ReadFull(conn, query); err == nil {
+				res := s.answer(query, false)
+				msg := make([]byte, 2+len(res))
+				binary.BigEndian.PutUint16(msg, uint16(len(res)))
+				copy(msg[2:], res)
+				_, _ = conn.Write(msg)
+			}
+		}
+		_ = conn.Close()
+	}
+}
+
+func dnsResource(question dnsmessage.Question, ttl uint32, body dnsmessage.ResourceBody) dnsmessage.Resource {
+	return dnsmessage.Resource{
+		Header: dnsmessage.ResourceHeader{
+			Name:  question.Name,
+			Type:  question.Type,
+			Class: dnsmessage.ClassINET,
+			TTL:   ttl,
+		},
+		Body: body,
+	}
+}
+
+func successDNSHandler(question dnsmessage.Question) (dnsmessage.RCode, []dnsmessage.Resource) {
+	if question.Name.String() != "squzy.dev." {
+		return dnsmessage.RCodeNameError, nil
+	}
+	switch question.Type {
+	case dnsmessage.TypeA:
+		return dnsmessage.RCodeSuccess, []dnsmessage.Resource{
+			dnsResource(question, 300, &dnsmessage.AResource{A: [4]byte{10, 0, 0, 1}}),
+			dnsResource(question, 60, &dnsmessage.AResource{A: [4]byte{10, 0, 0, 2}}),
+		}
+	case dnsmessage.TypeMX:
+		return dnsmessage.RCodeSuccess, []dnsmessage.Resource{
+			dnsResource(question, 60, &dnsmessage.MXResource{Pref: 10, MX: dnsmessage.MustNewName("mail.squzy.dev.")}),
+		}
+	case dnsmessage.TypeTXT:
+		return dnsmessage.RCodeSuccess, []dnsmessage.Resource{
+			dnsResource(question, 60, &dnsmessage.TXTResource{TXT: []string{"v=spf1 ", "-all"}}),
+		}
+	case dnsmessage.TypeSRV:
+		return dnsmessage.RCodeSuccess, []dnsmessage.Resource{
+			dnsResource(question, 60, &dnsmessage.SRVResource{Priority: 1, Weight: 2, Port: 9090, Target: dnsmessage.MustNewName("api.squzy.dev.")}),
+		}
+	}
+	return dnsmessage.RCodeSuccess, nil
+}
+
+func TestExecDNS(t *testing.T) {
+	server := newDNSServer(t, successDNSHandler, false)
+	defer server.Close()
+	t.Run("Should: return answers", func(t *testing.T) {
+		res := ExecDNS("", 1, &scheduler_config_storage.DNSConfig{
+			Resolver:   server.Addr(),
+			Name:       "squzy.dev",
+			RecordType: apiPb.DnsConfig_A,
+			Expected:   []string{"10.0.0.2"},
+			MaxTTL:     300,
+		}).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_OK, res.Snapshot.Code)
+		assert.Equal(t, apiPb.SchedulerType_DNS, res.Snapshot.Type)
+		assert.Nil(t, res.Snapshot.Error)
+		assert.Equal(t, []interface{}{"10.0.0.1", "10.0.0.2"}, res.Snapshot.Meta.Value.GetListValue().AsSlice())
+	})
+	t.Run("Should: format records of every type", func(t *testing.T) {
+		tt := map[apiPb.DnsConfig_RecordType]string{
+			apiPb.DnsConfig_MX:  "10 mail.squzy.dev",
+			apiPb.DnsConfig_TXT: "v=spf1 -all",
+			apiPb.DnsConfig_SRV: "1 2 9090 api.squzy.dev",
+		}
+		for recordType, expected := range tt {
+			res := ExecDNS("", 1, &scheduler_config_storage.DNSConfig{
+				Resolver:   server.Addr(),
+				Name:       "squzy.dev.",
+				RecordType: recordType,
+				Expected:   []string{expected},
+			}).GetLogData()
+			assert.Equal(t, apiPb.SchedulerCode_OK, res.Snapshot.Code)
+		}
+	})
+	t.Run("Should: return error because domain not exist", func(t *testing.T) {
+		res := ExecDNS("", 1, &scheduler_config_storage.DNSConfig{
+			Resolver:   server.Addr(),
+			Name:       "unknown.dev",
+			RecordType: apiPb.DnsConfig_A,
+		}).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res.Snapshot.Code)
+		assert.Equal(t, errDNSNotFound.Error(), res.Snapshot.Error.Message)
+	})
+	t.Run("Should: return error because no answer", func(t *testing.T) {
+		res := ExecDNS("", 1, &scheduler_config_storage.DNSConfig{
+			Resolver:   server.Addr(),
+			Name:       "squzy.dev",
+			RecordType: apiPb.DnsConfig_AAAA,
+		}).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res.Snapshot.Code)
+		assert.Equal(t, errDNSNoAnswer.Error(), res.Snapshot.Error.Message)
+	})
+	t.Run("Should: return error because answer is not expected", func(t *testing.T) {
+		res := ExecDNS("", 1, &scheduler_config_storage.DNSConfig{
+			Resolver:   server.Addr(),
+			Name:       "squzy.dev",
+			RecordType: apiPb.DnsConfig_A,
+			Expected:   []string{"10.0.0.3"},
+		}).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res.Snapshot.Code)
+		assert.Equal(t, "DNS_MISMATCH: 10.0.0.3 not found in 10.0.0.1, 10.0.0.2", res.Snapshot.Error.Message)
+		assert.Len(t, res.Snapshot.Meta.Value.GetListValue().Values, 2)
+	})
+	t.Run("Should: return error because ttl is too big", func(t *testing.T) {
+		res := ExecDNS("", 1, &scheduler_config_storage.DNSConfig{
+			Resolver:   server.Addr(),
+			Name:       "squzy.dev",
+			RecordType: apiPb.DnsConfig_A,
+			MaxTTL:     100,
+		}).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res.Snapshot.Code)
+		assert.Equal(t, "DNS_TTL_TOO_BIG: 10.0.0.1 has ttl 300", res.Snapshot.Error.Message)
+	})
+	t.Run("Should: return error because record type is unknown", func(t *testing.T) {
+		res := ExecDNS("", 1, &scheduler_config_storage.DNSConfig{
+			Resolver: server.Addr(),
+			Name:     "squzy.dev",
+		}).GetLogData()
+		assert.Equal(t, errDNSWrongType.Error(), res.Snapshot.Error.Message)
+	})
+}
+
+func TestExecDNSTruncated(t *testing.T) {
+	t.Run("Should: ask again by tcp", func(t *testing.T) {
+		server := newDNSServer(t, successDNSHandler, true)
+		defer server.Close()
+		res := ExecDNS("", 1, &scheduler_config_storage.DNSConfig{
+			Resolver:   server.Addr(),
+			Name:       "squzy.dev",
+			RecordType: apiPb.DnsConfig_A,
+		}).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_OK, res.Snapshot.Code)
+		assert.Len(t, res.Snapshot.Meta.Value.GetListValue().Values, 2)
+	})
+}
+
+func TestExecDNSTimeout(t *testing.T) {
+	t.Run("Should: return error because resolver not answer", func(t *testing.T) {
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		assert.Nil(t, err)
+		defer conn.Close()
+		res := ExecDNS("", 1, &scheduler_config_storage.DNSConfig{
+			Resolver:   conn.LocalAddr().String(),
+			Name:       "squzy.dev",
+			RecordType: apiPb.DnsConfig_A,
+		}).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res.Snapshot.Code)
+		assert.Equal(t, errConnTimeoutError.Error(), res.Snapshot.Error.Message)
+	})
+}
+
+func TestResolverAddress(t *testing.T) {
+	t.Run("Should: use default port", func(t *testing.T) {
+		assert.Equal(t, "8.8.8.8:53", resolverAddress("8.8.8.8"))
+		assert.Equal(t, "[::1]:53", resolverAddress("::1"))
+		assert.Equal(t, "127.0.0.1:5353", resolverAddress("127.0.0.1:5353"))
+	})
+}
+
+func TestDNSConnError(t *testing.T) {
+	t.Run("Should: return wrong config error", func(t *testing.T) {
+		assert.Equal(t, errWrongConnectConfigError, dnsConnError(errors.New("refused")))
+	})
+}
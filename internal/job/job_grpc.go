@@ -26,7 +26,7 @@ type grpcError struct {
 
 func (s *grpcError) GetLogData() *apiPb.SchedulerResponse {
 	var err *apiPb.SchedulerSnapshot_Error
-	if s.code == apiPb.SchedulerCode_ERROR {
+	if s.code != apiPb.SchedulerCode_OK {
 		err = &apiPb.SchedulerSnapshot_Error{
 			Message: s.description,
 		}
@@ -87,5 +87,7 @@ func ExecGrpc(schedulerID string, timeout int32, config *scheduler_config_storag
 	if res.Status != health_check.HealthCheckResponse_SERVING {
 		return newGrpcError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, errGrpcNotServing.Error())
 	}
-	return newGrpcError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_OK, "")
+	endTime := timestamp.Now()
+	code, description := passedCode(slowResponse(startTime, endTime, config.WarningTime))
+	return newGrpcError(schedulerID, startTime, endTime, code, description)
 }
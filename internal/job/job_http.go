@@ -1,6 +1,7 @@
 package job
 
 import (
+	"errors"
 	"github.com/squzy/squzy/internal/helpers"
 	"github.com/squzy/squzy/internal/httptools"
 	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
@@ -18,7 +19,7 @@ type httpError struct {
 
 func (e *httpError) GetLogData() *apiPb.SchedulerResponse {
 	var err *apiPb.SchedulerSnapshot_Error
-	if e.code == apiPb.SchedulerCode_ERROR {
+	if e.code != apiPb.SchedulerCode_OK {
 		err = &apiPb.SchedulerSnapshot_Error{
 			Message: e.description,
 		}
@@ -49,9 +50,20 @@ func newHTTPError(schedulerID string, startTime *timestamp.Timestamp, endTime *t
 
 func ExecHTTP(schedulerID string, timeout int32, config *scheduler_config_storage.HTTPConfig, httpTool httptools.HTTPTool) CheckError {
 	startTime := timestamp.Now()
-	req := httpTool.CreateRequest(config.Method, config.URL, &config.Headers, schedulerID)
+	tool, err := httpTool.ForCheck(config.Auth, config.TLS)
+	if err != nil {
+		return newHTTPError(
+			schedulerID,
+			startTime,
+			timestamp.Now(),
+			apiPb.SchedulerCode_ERROR,
+			err.Error(),
+		)
+	}
 
-	_, _, err := httpTool.SendRequestTimeoutStatusCode(req, helpers.DurationFromSecond(timeout), int(config.StatusCode))
+	req := tool.CreateRequest(config.Method, config.URL, &config.Headers, config.Body, schedulerID)
+
+	resp, body, err := tool.SendRequestTimeoutResponse(req, helpers.DurationFromSecond(timeout), int(config.StatusCode))
 
 	if err != nil {
 		return newHTTPError(
@@ -63,11 +75,25 @@ func ExecHTTP(schedulerID string, timeout int32, config *scheduler_config_storag
 		)
 	}
 
+	endTime := timestamp.Now()
+	// Failed assertions are joined by new line
+	if errs := checkAssertions(config.Assertions, resp, body, endTime.AsTime().Sub(startTime.AsTime())); len(errs) > 0 {
+		return newHTTPError(
+			schedulerID,
+			startTime,
+			endTime,
+			apiPb.SchedulerCode_ERROR,
+			errors.Join(errs...).Error(),
+		)
+	}
+
+	code, description := passedCode(slowResponse(startTime, endTime, config.WarningTime))
+
 	return newHTTPError(
 		schedulerID,
 		startTime,
-		timestamp.Now(),
-		apiPb.SchedulerCode_OK,
-		"",
+		endTime,
+		code,
+		description,
 	)
 }
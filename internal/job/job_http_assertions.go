@@ -0,0 +1,76 @@
+package job
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	"net/http"
+	"regexp"
+	"time"
+)
+
+var (
+	errAssertionFailed = errors.New("ASSERTION_FAILED")
+)
+
+// Every failed assertion is returned, so all of them could be reported at once
+func checkAssertions(assertions *scheduler_config_storage.HTTPAssertions, resp *http.Response, body []byte, duration time.Duration) []error {
+	if assertions == nil {
+		return nil
+	}
+	errs := []error{}
+	for _, expected := range assertions.BodyContains {
+		if !bytes.Contains(body, []byte(expected)) {
+			errs = append(errs, fmt.Errorf("%w: body does not contain %q", errAssertionFailed, expected))
+		}
+	}
+	for _, unexpected := range assertions.BodyNotContains {
+		if bytes.Contains(body, []byte(unexpected)) {
+			errs = append(errs, fmt.Errorf("%w: body contains %q", errAssertionFailed, unexpected))
+		}
+	}
+	if assertions.BodyRegex != "" {
+		if err := matchRegex(assertions.BodyRegex, string(body)); err != nil {
+			errs = append(errs, fmt.Errorf("%w: body %s", errAssertionFailed, err.Error()))
+		}
+	}
+	for _, header := range assertions.Headers {
+		if err := checkHeader(header, resp); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	limit := time.Duration(assertions.MaxResponseTime) * time.Millisecond
+	if limit > 0 && duration > limit {
+		errs = append(errs, fmt.Errorf("%w: response took %s, longer than %s", errAssertionFailed, duration.String(), limit.String()))
+	}
+	return errs
+}
+
+func checkHeader(header *scheduler_config_storage.HeaderAssertion, resp *http.Response) error {
+	var value string
+	if resp != nil {
+		value = resp.Header.Get(header.Name)
+	}
+	if header.Regex != "" {
+		if err := matchRegex(header.Regex, value); err != nil {
+			return fmt.Errorf("%w: header %s %s", errAssertionFailed, header.Name, err.Error())
+		}
+		return nil
+	}
+	if value != header.Equals {
+		return fmt.Errorf("%w: header %s is %q, expected %q", errAssertionFailed, header.Name, value, header.Equals)
+	}
+	return nil
+}
+
+func matchRegex(expr string, value string) error {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return fmt.Errorf("regex %q is wrong", expr)
+	}
+	if !re.MatchString(value) {
+		return fmt.Errorf("does not match %q", expr)
+	}
+	return nil
+}
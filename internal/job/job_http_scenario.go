@@ -0,0 +1,222 @@
+package job
+
+import (
+	"errors"
+	"fmt"
+	"github.com/squzy/squzy/internal/helpers"
+	"github.com/squzy/squzy/internal/httptools"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/tidwall/gjson"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"net/http"
+	"net/http/cookiejar"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	defaultStepStatusCode = http.StatusOK
+)
+
+var (
+	errStepFailed    = errors.New("SCENARIO_STEP_FAILED")
+	errExtractFailed = errors.New("EXTRACT_FAILED")
+)
+
+type httpScenarioError struct {
+	schedulerID string
+	startTime   *timestamp.Timestamp
+	endTime     *timestamp.Timestamp
+	code        apiPb.SchedulerCode
+	description string
+	value       *structpb.Value
+}
+
+func (e *httpScenarioError) GetLogData() *apiPb.SchedulerResponse {
+	var err *apiPb.SchedulerSnapshot_Error
+	if e.code != apiPb.SchedulerCode_OK {
+		err = &apiPb.SchedulerSnapshot_Error{
+			Message: e.description,
+		}
+	}
+	return &apiPb.SchedulerResponse{
+		SchedulerId: e.schedulerID,
+		Snapshot: &apiPb.SchedulerSnapshot{
+			Code:  e.code,
+			Error: err,
+			Type:  apiPb.SchedulerType_HTTP_SCENARIO,
+			Meta: &apiPb.SchedulerSnapshot_MetaData{
+				StartTime: e.startTime,
+				EndTime:   e.endTime,
+				Value:     e.value,
+			},
+		},
+	}
+}
+
+func newHTTPScenarioError(schedulerID string, startTime *timestamp.Timestamp, endTime *timestamp.Timestamp, code apiPb.SchedulerCode, description string, value *structpb.Value) CheckError {
+	return &httpScenarioError{
+		schedulerID: schedulerID,
+		startTime:   startTime,
+		endTime:     endTime,
+		code:        code,
+		description: description,
+		value:       value,
+	}
+}
+
+// Implemented by tool of httptools, scenario steps share cookies through it
+type cookieJarTool interface {
+	WithCookieJar(jar http.CookieJar) httptools.HTTPTool
+}
+
+// Timeout is applied to every step, value of snapshot has timings of executed steps
+func ExecHTTPScenario(schedulerID string, timeout int32, config *scheduler_config_storage.HTTPScenarioConfig, httpTool httptools.HTTPTool) CheckError {
+	startTime := timestamp.Now()
+	tool, err := httpTool.ForCheck(config.Auth, config.TLS)
+	if err != nil {
+		return newHTTPScenarioError(
+			schedulerID,
+			startTime,
+			timestamp.Now(),
+			apiPb.SchedulerCode_ERROR,
+			err.Error(),
+			nil,
+		)
+	}
+
+	// Cookies live only during one run of scenario
+	if jarTool, ok := tool.(cookieJarTool); ok {
+		jar, _ := cookiejar.New(nil)
+		tool = jarTool.WithCookieJar(jar)
+	}
+	variables := map[string]string{}
+	steps := []interface{}{}
+
+	for i, step := range config.Steps {
+		result, err := execStep(schedulerID, timeout, step, tool, variables)
+		steps = append(steps, result)
+		if err != nil {
+			return newHTTPScenarioError(
+				schedulerID,
+				startTime,
+				timestamp.Now(),
+				apiPb.SchedulerCode_ERROR,
+				fmt.Errorf("%w: step %d %q: %s", errStepFailed, i+1, step.Name, err.Error()).Error(),
+				stepsValue(steps),
+			)
+		}
+	}
+
+	endTime := timestamp.Now()
+	code, description := passedCode(slowResponse(startTime, endTime, config.WarningTime))
+
+	return newHTTPScenarioError(
+		schedulerID,
+		startTime,
+		endTime,
+		code,
+		description,
+		stepsValue(steps),
+	)
+}
+
+func execStep(schedulerID string, timeout int32, step *scheduler_config_storage.HTTPScenarioStep, httpTool httptools.HTTPTool, variables map[string]string) (map[string]interface{}, error) {
+	replacer := variablesReplacer(variables)
+
+	headers := map[string]string{}
+	for k, v := range step.Headers {
+		headers[k] = replacer.Replace(v)
+	}
+	var body *scheduler_config_storage.HTTPBody
+	if step.Body != nil {
+		body = &scheduler_config_storage.HTTPBody{
+			Content:     replacer.Replace(step.Body.Content),
+			ContentType: step.Body.ContentType,
+		}
+	}
+
+	req := httpTool.CreateRequest(step.Method, replacer.Replace(step.URL), &headers, body, schedulerID)
+
+	statusCode := step.StatusCode
+	if statusCode == 0 {
+		statusCode = defaultStepStatusCode
+	}
+
+	start := time.Now()
+	resp, data, err := httpTool.SendRequestTimeoutResponse(req, helpers.DurationFromSecond(timeout), int(statusCode))
+	duration := time.Since(start)
+
+	result := map[string]interface{}{
+		"name": step.Name,
+		// In milliseconds
+		"duration": duration.Milliseconds(),
+	}
+	if resp != nil {
+		result["statusCode"] = resp.StatusCode
+	}
+	if err != nil {
+		return result, err
+	}
+
+	if errs := checkAssertions(step.Assertions, resp, data, duration); len(errs) > 0 {
+		return result, errors.Join(errs...)
+	}
+
+	for _, extract := range step.Extract {
+		value, err := extractVariable(extract, resp, data)
+		if err != nil {
+			return result, err
+		}
+		variables[extract.Name] = value
+	}
+	return result, nil
+}
+
+func variablesReplacer(variables map[string]string) *strings.Replacer {
+	pairs := []string{}
+	for name, value := range variables {
+		pairs = append(pairs, "{{"+name+"}}", value)
+	}
+	return strings.NewReplacer(pairs...)
+}
+
+func extractVariable(extract *scheduler_config_storage.HTTPExtract, resp *http.Response, body []byte) (string, error) {
+	switch extract.Source {
+	case apiPb.HttpScenarioStep_Extract_JSON:
+		res := gjson.GetBytes(body, extract.Expression)
+		if !res.Exists() {
+			return "", fmt.Errorf("%w: %s, value by path=`%s` not exist", errExtractFailed, extract.Name, extract.Expression)
+		}
+		return res.String(), nil
+	case apiPb.HttpScenarioStep_Extract_HEADER:
+		value := resp.Header.Get(extract.Expression)
+		if value == "" {
+			return "", fmt.Errorf("%w: %s, header %s not exist", errExtractFailed, extract.Name, extract.Expression)
+		}
+		return value, nil
+	case apiPb.HttpScenarioStep_Extract_REGEX:
+		re, err := regexp.Compile(extract.Expression)
+		if err != nil {
+			return "", fmt.Errorf("%w: %s, regex %q is wrong", errExtractFailed, extract.Name, extract.Expression)
+		}
+		match := re.FindSubmatch(body)
+		if match == nil {
+			return "", fmt.Errorf("%w: %s, body does not match %q", errExtractFailed, extract.Name, extract.Expression)
+		}
+		// First group is used when regex has it
+		if len(match) > 1 {
+			return string(match[1]), nil
+		}
+		return string(match[0]), nil
+	}
+	return "", fmt.Errorf("%w: %s, unknown source %s", errExtractFailed, extract.Name, extract.Source.String())
+}
+
+func stepsValue(steps []interface{}) *structpb.Value {
+	value, _ := structpb.NewValue(steps)
+	return value
+}
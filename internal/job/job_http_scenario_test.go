@@ -0,0 +1,216 @@
+package job
+
+import (
+	"fmt"
+	"github.com/squzy/squzy/internal/httptools"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func scenarioServer() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.FormValue("user") != "squzy" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "s1"})
+		w.Header().Set("X-Request-Id", "r1")
+		_, _ = w.Write([]byte(`{"data":{"token":"t1"}}`))
+	})
+	mux.HandleFunc("/me", func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie("session")
+		if err != nil || cookie.Value != "s1" || r.Header.Get("Authorization") != "Bearer t1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(fmt.Sprintf("<p>Hello squzy, request %s</p>", r.Header.Get("X-Request-Id"))))
+	})
+	mux.HandleFunc("/sso", func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "sso", Value: "s2"})
+		http.Redirect(w, r, "/landing", http.StatusFound)
+	})
+	mux.HandleFunc("/landing", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := r.Cookie("sso"); err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	})
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(time.Millisecond * 50)
+	})
+	return httptest.NewServer(mux)
+}
+
+func loginStep(url string) *scheduler_config_storage.HTTPScenarioStep {
+	return &scheduler_config_storage.HTTPScenarioStep{
+		Name:   "login",
+		Method: http.MethodPost,
+		URL:    url + "/login",
+		Body: &scheduler_config_storage.HTTPBody{
+			Content:     "user=squzy",
+			ContentType: "application/x-www-form-urlencoded",
+		},
+		Extract: []*scheduler_config_storage.HTTPExtract{
+			{Name: "token", Source: apiPb.HttpScenarioStep_Extract_JSON, Expression: "data.token"},
+			{Name: "request", Source: apiPb.HttpScenarioStep_Extract_HEADER, Expression: "X-Request-Id"},
+		},
+	}
+}
+
+func TestExecHTTPScenario(t *testing.T) {
+	ts := scenarioServer()
+	defer ts.Close()
+	tool := httptools.New("test")
+
+	t.Run("Should: pass variables and cookies to next steps", func(t *testing.T) {
+		s := ExecHTTPScenario("", 0, &scheduler_config_storage.HTTPScenarioConfig{
+			Steps: []*scheduler_config_storage.HTTPScenarioStep{
+				loginStep(ts.URL),
+				{
+					Name:   "me",
+					Method: http.MethodGet,
+					URL:    ts.URL + "/me",
+					Headers: map[string]string{
+						"Authorization": "Bearer {{token}}",
+						"X-Request-Id":  "{{request}}",
+					},
+					Assertions: &scheduler_config_storage.HTTPAssertions{BodyContains: []string{"squzy"}},
+					Extract: []*scheduler_config_storage.HTTPExtract{
+						{Name: "greeting", Source: apiPb.HttpScenarioStep_Extract_REGEX, Expression: "<p>(.*)</p>"},
+					},
+				},
+			},
+		}, tool)
+		res := s.GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_OK, res.Snapshot.Code)
+		assert.Equal(t, apiPb.SchedulerType_HTTP_SCENARIO, res.Snapshot.Type)
+		steps := res.Snapshot.Meta.Value.GetListValue().Values
+		assert.Len(t, steps, 2)
+		assert.Equal(t, "me", steps[1].GetStructValue().Fields["name"].GetStringValue())
+		assert.Equal(t, float64(http.StatusOK), steps[1].GetStructValue().Fields["statusCode"].GetNumberValue())
+		assert.NotNil(t, steps[1].GetStructValue().Fields["duration"])
+	})
+	t.Run("Should: keep cookies set by redirects", func(t *testing.T) {
+		s := ExecHTTPScenario("", 0, &scheduler_config_storage.HTTPScenarioConfig{
+			Steps: []*scheduler_config_storage.HTTPScenarioStep{
+				{Name: "sso", Method: http.MethodGet, URL: ts.URL + "/sso"},
+				{Name: "landing", Method: http.MethodGet, URL: ts.URL + "/landing"},
+			},
+		}, tool)
+		assert.Equal(t, apiPb.SchedulerCode_OK, s.GetLogData().Snapshot.Code)
+	})
+	t.Run("Should: not keep cookies between runs", func(t *testing.T) {
+		s := ExecHTTPScenario("", 0, &scheduler_config_storage.HTTPScenarioConfig{
+			Steps: []*scheduler_config_storage.HTTPScenarioStep{
+				{Name: "landing", Method: http.MethodGet, URL: ts.URL + "/landing"},
+			},
+		}, tool)
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, s.GetLogData().Snapshot.Code)
+	})
+	t.Run("Should: report failed step", func(t *testing.T) {
+		s := ExecHTTPScenario("", 0, &scheduler_config_storage.HTTPScenarioConfig{
+			Steps: []*scheduler_config_storage.HTTPScenarioStep{
+				loginStep(ts.URL),
+				{Name: "me", Method: http.MethodGet, URL: ts.URL + "/me"},
+				{Name: "never", Method: http.MethodGet, URL: ts.URL + "/me"},
+			},
+		}, tool)
+		res := s.GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res.Snapshot.Code)
+		assert.True(t, strings.HasPrefix(res.Snapshot.Error.Message, `SCENARIO_STEP_FAILED: step 2 "me": ErrCode: NOT_EXPECTED_STATUS_CODE`))
+		steps := res.Snapshot.Meta.Value.GetListValue().Values
+		assert.Len(t, steps, 2)
+		assert.Equal(t, float64(http.StatusUnauthorized), steps[1].GetStructValue().Fields["statusCode"].GetNumberValue())
+	})
+	t.Run("Should: report failed assertions of step", func(t *testing.T) {
+		s := ExecHTTPScenario("", 0, &scheduler_config_storage.HTTPScenarioConfig{
+			Steps: []*scheduler_config_storage.HTTPScenarioStep{
+				{
+					Name:       "login",
+					Method:     http.MethodPost,
+					URL:        ts.URL + "/login?user=squzy",
+					Assertions: &scheduler_config_storage.HTTPAssertions{BodyContains: []string{"admin"}},
+				},
+			},
+		}, tool)
+		res := s.GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res.Snapshot.Code)
+		assert.Equal(t, `SCENARIO_STEP_FAILED: step 1 "login": ASSERTION_FAILED: body does not contain "admin"`, res.Snapshot.Error.Message)
+	})
+	t.Run("Should: report failed extract", func(t *testing.T) {
+		s := ExecHTTPScenario("", 0, &scheduler_config_storage.HTTPScenarioConfig{
+			Steps: []*scheduler_config_storage.HTTPScenarioStep{
+				{
+					Name:   "login",
+					Method: http.MethodPost,
+					URL:    ts.URL + "/login?user=squzy",
+					Extract: []*scheduler_config_storage.HTTPExtract{
+						{Name: "token", Source: apiPb.HttpScenarioStep_Extract_JSON, Expression: "token"},
+					},
+				},
+			},
+		}, tool)
+		res := s.GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res.Snapshot.Code)
+		assert.Equal(t, "SCENARIO_STEP_FAILED: step 1 \"login\": EXTRACT_FAILED: token, value by path=`token` not exist", res.Snapshot.Error.Message)
+	})
+	t.Run("Should: return warning because scenario is slow", func(t *testing.T) {
+		s := ExecHTTPScenario("", 0, &scheduler_config_storage.HTTPScenarioConfig{
+			Steps: []*scheduler_config_storage.HTTPScenarioStep{
+				{Method: http.MethodGet, URL: ts.URL + "/slow"},
+			},
+			WarningTime: 1,
+		}, tool)
+		assert.Equal(t, apiPb.SchedulerCode_WARNING, s.GetLogData().Snapshot.Code)
+	})
+	t.Run("Should: return error because invalid tls config", func(t *testing.T) {
+		s := ExecHTTPScenario("", 0, &scheduler_config_storage.HTTPScenarioConfig{
+			TLS: &scheduler_config_storage.HTTPTLS{CABundle: "ca"},
+		}, tool)
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, s.GetLogData().Snapshot.Code)
+		assert.Nil(t, s.GetLogData().Snapshot.Meta.Value)
+	})
+}
+
+func TestExtractVariable(t *testing.T) {
+	resp := &http.Response{Header: http.Header{"Location": []string{"/orders/1"}}}
+	body := []byte(`{"id": 15, "name": "squzy"}`)
+	t.Run("Should: extract value", func(t *testing.T) {
+		value, err := extractVariable(&scheduler_config_storage.HTTPExtract{Source: apiPb.HttpScenarioStep_Extract_JSON, Expression: "id"}, resp, body)
+		assert.Nil(t, err)
+		assert.Equal(t, "15", value)
+		value, err = extractVariable(&scheduler_config_storage.HTTPExtract{Source: apiPb.HttpScenarioStep_Extract_HEADER, Expression: "Location"}, resp, body)
+		assert.Nil(t, err)
+		assert.Equal(t, "/orders/1", value)
+		value, err = extractVariable(&scheduler_config_storage.HTTPExtract{Source: apiPb.HttpScenarioStep_Extract_REGEX, Expression: `"name": "(\w+)"`}, resp, body)
+		assert.Nil(t, err)
+		assert.Equal(t, "squzy", value)
+		value, err = extractVariable(&scheduler_config_storage.HTTPExtract{Source: apiPb.HttpScenarioStep_Extract_REGEX, Expression: `\d+`}, resp, body)
+		assert.Nil(t, err)
+		assert.Equal(t, "15", value)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		for _, extract := range []*scheduler_config_storage.HTTPExtract{
+			{Source: apiPb.HttpScenarioStep_Extract_HEADER, Expression: "X-Token"},
+			{Source: apiPb.HttpScenarioStep_Extract_REGEX, Expression: "("},
+			{Source: apiPb.HttpScenarioStep_Extract_REGEX, Expression: "token"},
+			{Source: apiPb.HttpScenarioStep_Extract_EXTRACT_SOURCE_UNSPECIFIED},
+		} {
+			_, err := extractVariable(extract, resp, body)
+			assert.ErrorIs(t, err, errExtractFailed)
+		}
+	})
+}
+
+func TestVariablesReplacer(t *testing.T) {
+	t.Run("Should: replace only known variables", func(t *testing.T) {
+		replacer := variablesReplacer(map[string]string{"token": "t1"})
+		assert.Equal(t, "Bearer t1 {{uuid}}", replacer.Replace("Bearer {{token}} {{uuid}}"))
+	})
+}
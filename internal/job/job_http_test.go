@@ -5,27 +5,39 @@ import (
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"github.com/stretchr/testify/assert"
 	"net/http"
+	"strings"
+	"github.com/squzy/squzy/internal/httptools"
 	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
 	"testing"
 	"time"
 )
 
 type httpToolsMock struct {
+	header http.Header
+	body   []byte
 }
 
 func (h httpToolsMock) SendRequestTimeoutStatusCode(req *http.Request, timeout time.Duration, expectedCode int) (int, []byte, error) {
 	return 0, nil, nil
 }
 
+func (h httpToolsMock) SendRequestTimeoutResponse(req *http.Request, timeout time.Duration, expectedCode int) (*http.Response, []byte, error) {
+	return &http.Response{StatusCode: expectedCode, Header: h.header}, h.body, nil
+}
+
 func (h httpToolsMock) SendRequestTimeout(req *http.Request, timeout time.Duration) (int, []byte, error) {
 	panic("implement me")
 }
 
-func (h httpToolsMock) CreateRequest(method string, url string, headers *map[string]string, log string) *http.Request {
+func (h httpToolsMock) CreateRequest(method string, url string, headers *map[string]string, body *scheduler_config_storage.HTTPBody, log string) *http.Request {
 	req, _ := http.NewRequest(method, url, nil)
 	return req
 }
 
+func (h httpToolsMock) ForCheck(auth *scheduler_config_storage.HTTPAuth, tlsConfig *scheduler_config_storage.HTTPTLS) (httptools.HTTPTool, error) {
+	return h, nil
+}
+
 type httpToolsMockError struct {
 }
 
@@ -33,6 +45,10 @@ func (h httpToolsMockError) SendRequestTimeoutStatusCode(req *http.Request, time
 	return 0, nil, errors.New("safsaf")
 }
 
+func (h httpToolsMockError) SendRequestTimeoutResponse(req *http.Request, timeout time.Duration, expectedCode int) (*http.Response, []byte, error) {
+	return nil, nil, errors.New("safsaf")
+}
+
 func (h httpToolsMockError) SendRequestTimeout(req *http.Request, timeout time.Duration) (int, []byte, error) {
 	panic("implement me")
 }
@@ -45,11 +61,15 @@ func (h httpToolsMockError) GetWithRedirects(url string) (int, []byte, error) {
 	panic("implement me")
 }
 
-func (h httpToolsMockError) CreateRequest(method string, url string, headers *map[string]string, log string) *http.Request {
+func (h httpToolsMockError) CreateRequest(method string, url string, headers *map[string]string, body *scheduler_config_storage.HTTPBody, log string) *http.Request {
 	rq, _ := http.NewRequest(method, url, nil)
 	return rq
 }
 
+func (h httpToolsMockError) ForCheck(auth *scheduler_config_storage.HTTPAuth, tlsConfig *scheduler_config_storage.HTTPTLS) (httptools.HTTPTool, error) {
+	return h, nil
+}
+
 func (h httpToolsMockError) SendRequest(req *http.Request) (int, []byte, error) {
 	panic("implement me")
 }
@@ -85,4 +105,81 @@ func TestExecHttp(t *testing.T) {
 		s := ExecHTTP("", 0, &scheduler_config_storage.HTTPConfig{Method: http.MethodGet, Headers: map[string]string{}, StatusCode: http.StatusOK}, &httpToolsMockError{})
 		assert.Equal(t, apiPb.SchedulerCode_ERROR, s.GetLogData().Snapshot.Code)
 	})
+	t.Run("Should: return error because invalid tls config", func(t *testing.T) {
+		s := ExecHTTP("", 0, &scheduler_config_storage.HTTPConfig{
+			Method:     http.MethodGet,
+			StatusCode: http.StatusOK,
+			TLS:        &scheduler_config_storage.HTTPTLS{CABundle: "ca"},
+		}, httptools.New("test"))
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, s.GetLogData().Snapshot.Code)
+		assert.Equal(t, "INVALID_CA_BUNDLE", s.GetLogData().Snapshot.Error.Message)
+	})
+}
+
+func TestExecHttpAssertions(t *testing.T) {
+	tool := &httpToolsMock{
+		header: http.Header{"Content-Type": []string{"text/html; charset=utf-8"}},
+		body:   []byte("<h1>Service unavailable</h1>"),
+	}
+	t.Run("Should: not return error because assertions passed", func(t *testing.T) {
+		s := ExecHTTP("", 0, &scheduler_config_storage.HTTPConfig{
+			Method:     http.MethodGet,
+			StatusCode: http.StatusOK,
+			Assertions: &scheduler_config_storage.HTTPAssertions{
+				BodyContains:    []string{"Service"},
+				BodyNotContains: []string{"Error"},
+				BodyRegex:       "^<h1>",
+				Headers: []*scheduler_config_storage.HeaderAssertion{
+					{Name: "Content-Type", Regex: "^text/html"},
+					{Name: "Content-Type", Equals: "text/html; charset=utf-8"},
+				},
+				MaxResponseTime: 1000,
+			},
+		}, tool)
+		assert.Equal(t, apiPb.SchedulerCode_OK, s.GetLogData().Snapshot.Code)
+	})
+	t.Run("Should: report every failed assertion", func(t *testing.T) {
+		s := ExecHTTP("", 0, &scheduler_config_storage.HTTPConfig{
+			Method:     http.MethodGet,
+			StatusCode: http.StatusOK,
+			Assertions: &scheduler_config_storage.HTTPAssertions{
+				BodyContains:    []string{"OK"},
+				BodyNotContains: []string{"unavailable"},
+				BodyRegex:       "^{",
+				Headers: []*scheduler_config_storage.HeaderAssertion{
+					{Name: "Content-Type", Equals: "application/json"},
+					{Name: "Server", Regex: "^nginx"},
+				},
+			},
+		}, tool)
+		res := s.GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res.Snapshot.Code)
+		assert.Equal(t, strings.Join([]string{
+			`ASSERTION_FAILED: body does not contain "OK"`,
+			`ASSERTION_FAILED: body contains "unavailable"`,
+			`ASSERTION_FAILED: body does not match "^{"`,
+			`ASSERTION_FAILED: header Content-Type is "text/html; charset=utf-8", expected "application/json"`,
+			`ASSERTION_FAILED: header Server does not match "^nginx"`,
+		}, "\n"), res.Snapshot.Error.Message)
+	})
+}
+
+func TestCheckAssertions(t *testing.T) {
+	t.Run("Should: return nil because assertions are not set", func(t *testing.T) {
+		assert.Nil(t, checkAssertions(nil, nil, nil, time.Hour))
+	})
+	t.Run("Should: return error because response is slow", func(t *testing.T) {
+		errs := checkAssertions(&scheduler_config_storage.HTTPAssertions{MaxResponseTime: 100}, nil, nil, time.Second)
+		assert.Len(t, errs, 1)
+		assert.EqualError(t, errs[0], "ASSERTION_FAILED: response took 1s, longer than 100ms")
+	})
+	t.Run("Should: return error because regex is wrong", func(t *testing.T) {
+		errs := checkAssertions(&scheduler_config_storage.HTTPAssertions{
+			BodyRegex: "(",
+			Headers:   []*scheduler_config_storage.HeaderAssertion{{Name: "Server", Equals: "nginx"}},
+		}, nil, nil, 0)
+		assert.Len(t, errs, 2)
+		assert.EqualError(t, errs[0], `ASSERTION_FAILED: body regex "(" is wrong`)
+		assert.ErrorIs(t, errs[1], errAssertionFailed)
+	})
 }
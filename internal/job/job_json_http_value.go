@@ -1,6 +1,7 @@
 package job
 
 import (
+	"errors"
 	"fmt"
 	"github.com/squzy/squzy/internal/helpers"
 	"github.com/squzy/squzy/internal/httptools"
@@ -9,6 +10,7 @@ import (
 	"github.com/tidwall/gjson"
 	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"strconv"
 	"time"
 )
 
@@ -29,7 +31,7 @@ var (
 
 func (e *jsonHTTPError) GetLogData() *apiPb.SchedulerResponse {
 	var err *apiPb.SchedulerSnapshot_Error
-	if e.code == apiPb.SchedulerCode_ERROR {
+	if e.code != apiPb.SchedulerCode_OK {
 		err = &apiPb.SchedulerSnapshot_Error{
 			Message: e.description,
 		}
@@ -51,9 +53,21 @@ func (e *jsonHTTPError) GetLogData() *apiPb.SchedulerResponse {
 
 func ExecHTTPValue(schedulerID string, timeout int32, config *scheduler_config_storage.HTTPValueConfig, httpTool httptools.HTTPTool) CheckError {
 	startTime := timestamp.Now()
-	req := httpTool.CreateRequest(config.Method, config.URL, &config.Headers, schedulerID)
+	tool, err := httpTool.ForCheck(config.Auth, config.TLS)
+	if err != nil {
+		return newJSONHTTPError(
+			schedulerID,
+			startTime,
+			timestamp.Now(),
+			apiPb.SchedulerCode_ERROR,
+			err.Error(),
+			nil,
+		)
+	}
 
-	_, data, err := httpTool.SendRequestTimeout(req, helpers.DurationFromSecond(timeout))
+	req := tool.CreateRequest(config.Method, config.URL, &config.Headers, config.Body, schedulerID)
+
+	_, data, err := tool.SendRequestTimeout(req, helpers.DurationFromSecond(timeout))
 
 	if err != nil {
 		return newJSONHTTPError(
@@ -71,16 +85,21 @@ func ExecHTTPValue(schedulerID string, timeout int32, config *scheduler_config_s
 	results := []*structpb.Value{}
 
 	if len(config.Selectors) == 0 {
+		endTime := timestamp.Now()
+		code, description := passedCode(slowResponse(startTime, endTime, config.WarningTime))
 		return newJSONHTTPError(
 			schedulerID,
 			startTime,
-			timestamp.Now(),
-			apiPb.SchedulerCode_OK,
-			"",
+			endTime,
+			code,
+			description,
 			nil,
 		)
 	}
 
+	var warning error
+	failed := []error{}
+
 	for _, value := range config.Selectors {
 		res := gjson.Get(jsonString, value.Path)
 		if !res.Exists() {
@@ -93,6 +112,7 @@ func ExecHTTPValue(schedulerID string, timeout int32, config *scheduler_config_s
 				nil,
 			)
 		}
+		failed = append(failed, checkSelectorAssertion(value, res)...)
 		switch value.Type {
 		case apiPb.HttpJsonValueConfig_STRING:
 			results = append(results, &structpb.Value{
@@ -107,6 +127,9 @@ func ExecHTTPValue(schedulerID string, timeout int32, config *scheduler_config_s
 				},
 			})
 		case apiPb.HttpJsonValueConfig_NUMBER:
+			if warning == nil {
+				warning = outOfRange(value, res.Float())
+			}
 			results = append(results, &structpb.Value{
 				Kind: &structpb.Value_NumberValue{
 					NumberValue: res.Float(),
@@ -133,13 +156,23 @@ func ExecHTTPValue(schedulerID string, timeout int32, config *scheduler_config_s
 		}
 	}
 
+	endTime := timestamp.Now()
+	if warning == nil {
+		warning = slowResponse(startTime, endTime, config.WarningTime)
+	}
+	code, description := passedCode(warning)
+	if len(failed) > 0 {
+		code = apiPb.SchedulerCode_ERROR
+		description = errors.Join(failed...).Error()
+	}
+
 	if len(config.Selectors) == 1 {
 		return newJSONHTTPError(
 			schedulerID,
 			startTime,
-			timestamp.Now(),
-			apiPb.SchedulerCode_OK,
-			"",
+			endTime,
+			code,
+			description,
 			results[0],
 		)
 	}
@@ -147,9 +180,9 @@ func ExecHTTPValue(schedulerID string, timeout int32, config *scheduler_config_s
 	return newJSONHTTPError(
 		schedulerID,
 		startTime,
-		timestamp.Now(),
-		apiPb.SchedulerCode_OK,
-		"",
+		endTime,
+		code,
+		description,
 		&structpb.Value{
 			Kind: &structpb.Value_ListValue{
 				ListValue: &structpb.ListValue{
@@ -160,6 +193,55 @@ func ExecHTTPValue(schedulerID string, timeout int32, config *scheduler_config_s
 	)
 }
 
+// Passed check is WARNING when number is out of range of selector
+func outOfRange(selector *scheduler_config_storage.Selectors, value float64) error {
+	if selector.WarningMin != nil && value < *selector.WarningMin {
+		return fmt.Errorf("%w: value by path=`%s` is %v, min is %v", errValueOutOfRange, selector.Path, value, *selector.WarningMin)
+	}
+	if selector.WarningMax != nil && value > *selector.WarningMax {
+		return fmt.Errorf("%w: value by path=`%s` is %v, max is %v", errValueOutOfRange, selector.Path, value, *selector.WarningMax)
+	}
+	return nil
+}
+
+// Every set field of assertion is checked, so all failures could be reported at once
+func checkSelectorAssertion(selector *scheduler_config_storage.Selectors, res gjson.Result) []error {
+	assertion := selector.Assertion
+	if assertion == nil {
+		return nil
+	}
+	errs := []error{}
+	value := res.String()
+	if assertion.Equals != nil && value != *assertion.Equals {
+		errs = append(errs, fmt.Errorf("%w: value by path=`%s` is %q, expected %q", errAssertionFailed, selector.Path, value, *assertion.Equals))
+	}
+	if assertion.NotEquals != nil && value == *assertion.NotEquals {
+		errs = append(errs, fmt.Errorf("%w: value by path=`%s` is %q, expected not %q", errAssertionFailed, selector.Path, value, *assertion.NotEquals))
+	}
+	if assertion.GreaterThan != nil || assertion.LessThan != nil {
+		number, err := strconv.ParseFloat(value, 64)
+		switch {
+		case err != nil:
+			errs = append(errs, fmt.Errorf("%w: value by path=`%s` is %q, expected number", errAssertionFailed, selector.Path, value))
+		case assertion.GreaterThan != nil && number <= *assertion.GreaterThan:
+			errs = append(errs, fmt.Errorf("%w: value by path=`%s` is %v, expected greater than %v", errAssertionFailed, selector.Path, number, *assertion.GreaterThan))
+		case assertion.LessThan != nil && number >= *assertion.LessThan:
+			errs = append(errs, fmt.Errorf("%w: value by path=`%s` is %v, expected less than %v", errAssertionFailed, selector.Path, number, *assertion.LessThan))
+		}
+	}
+	if assertion.Regex != "" {
+		if err := matchRegex(assertion.Regex, value); err != nil {
+			errs = append(errs, fmt.Errorf("%w: value by path=`%s` is %q, %s", errAssertionFailed, selector.Path, value, err.Error()))
+		}
+	}
+	if assertion.BoolEquals != nil {
+		if actual, err := strconv.ParseBool(value); err != nil || actual != *assertion.BoolEquals {
+			errs = append(errs, fmt.Errorf("%w: value by path=`%s` is %q, expected %v", errAssertionFailed, selector.Path, value, *assertion.BoolEquals))
+		}
+	}
+	return errs
+}
+
 func newJSONHTTPError(schedulerID string, startTime *timestamp.Timestamp, endTime *timestamp.Timestamp, code apiPb.SchedulerCode, description string, value *structpb.Value) CheckError {
 	return &jsonHTTPError{
 		schedulerID: schedulerID,
@@ -2,9 +2,11 @@ package job
 
 import (
 	"errors"
+	"github.com/squzy/squzy/internal/httptools"
 	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
 	structpb "google.golang.org/protobuf/types/known/structpb"
 	"net/http"
 	"testing"
@@ -18,6 +20,10 @@ func (m mockError) SendRequestTimeoutStatusCode(req *http.Request, timeout time.
 	panic("implement me")
 }
 
+func (m mockError) SendRequestTimeoutResponse(req *http.Request, timeout time.Duration, expectedCode int) (*http.Response, []byte, error) {
+	panic("implement me")
+}
+
 func (m mockError) SendRequestTimeout(req *http.Request, timeout time.Duration) (int, []byte, error) {
 	return 0, nil, errors.New("afsaf")
 }
@@ -29,6 +35,10 @@ func (m mockSuccess) SendRequestTimeoutStatusCode(req *http.Request, timeout tim
 	panic("implement me")
 }
 
+func (m mockSuccess) SendRequestTimeoutResponse(req *http.Request, timeout time.Duration, expectedCode int) (*http.Response, []byte, error) {
+	panic("implement me")
+}
+
 func (m mockSuccess) SendRequestTimeout(req *http.Request, timeout time.Duration) (int, []byte, error) {
 	return 0, []byte(`{"name":"John", "age":31, "city":"New York", "success": true, "time": "2012-04-23T18:25:43.511Z", "raw": {"name":"ahha"}}`), nil
 }
@@ -41,11 +51,15 @@ func (m mockSuccess) SendRequestWithStatusCode(req *http.Request, expectedCode i
 	panic("implement me")
 }
 
-func (m mockSuccess) CreateRequest(method string, url string, headers *map[string]string, logId string) *http.Request {
+func (m mockSuccess) CreateRequest(method string, url string, headers *map[string]string, body *scheduler_config_storage.HTTPBody, logId string) *http.Request {
 	req, _ := http.NewRequest(method, url, nil)
 	return req
 }
 
+func (m mockSuccess) ForCheck(auth *scheduler_config_storage.HTTPAuth, tlsConfig *scheduler_config_storage.HTTPTLS) (httptools.HTTPTool, error) {
+	return m, nil
+}
+
 func (m mockError) SendRequest(req *http.Request) (int, []byte, error) {
 	return 0, nil, errors.New("afsaf")
 }
@@ -54,16 +68,27 @@ func (m mockError) SendRequestWithStatusCode(req *http.Request, expectedCode int
 	panic("implement me")
 }
 
-func (m mockError) CreateRequest(method string, url string, headers *map[string]string, logId string) *http.Request {
+func (m mockError) CreateRequest(method string, url string, headers *map[string]string, body *scheduler_config_storage.HTTPBody, logId string) *http.Request {
 	req, _ := http.NewRequest(method, url, nil)
 	return req
 }
 
+func (m mockError) ForCheck(auth *scheduler_config_storage.HTTPAuth, tlsConfig *scheduler_config_storage.HTTPTLS) (httptools.HTTPTool, error) {
+	return m, nil
+}
+
 func TestExecHttpValue(t *testing.T) {
 	t.Run("Should: return error on http request", func(t *testing.T) {
 		s := ExecHTTPValue("", 0, &scheduler_config_storage.HTTPValueConfig{Method: http.MethodGet, Headers: map[string]string{}}, &mockError{})
 		assert.Equal(t, apiPb.SchedulerCode_ERROR, s.GetLogData().Snapshot.Code)
 	})
+	t.Run("Should: return error because invalid client cert", func(t *testing.T) {
+		s := ExecHTTPValue("", 0, &scheduler_config_storage.HTTPValueConfig{
+			Method: http.MethodGet,
+			TLS:    &scheduler_config_storage.HTTPTLS{ClientCert: "cert"},
+		}, httptools.New("test"))
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, s.GetLogData().Snapshot.Code)
+	})
 	t.Run("Should: return error because value not exist", func(t *testing.T) {
 		s := ExecHTTPValue("", 0, &scheduler_config_storage.HTTPValueConfig{Method: http.MethodGet, Headers: map[string]string{}, Selectors: []*scheduler_config_storage.Selectors{
 			{
@@ -165,4 +190,132 @@ func TestExecHttpValue(t *testing.T) {
 			},
 		}, s.GetLogData().Snapshot.Meta.Value.GetListValue())
 	})
+	t.Run("Should: return warning because number is out of range", func(t *testing.T) {
+		min := float64(40)
+		s := ExecHTTPValue("", 0, &scheduler_config_storage.HTTPValueConfig{Method: http.MethodGet, Headers: map[string]string{}, Selectors: []*scheduler_config_storage.Selectors{
+			{
+				Type:       apiPb.HttpJsonValueConfig_NUMBER,
+				Path:       "age",
+				WarningMin: &min,
+			},
+		}}, &mockSuccess{})
+		assert.Equal(t, apiPb.SchedulerCode_WARNING, s.GetLogData().Snapshot.Code)
+		assert.Equal(t, "VALUE_OUT_OF_RANGE: value by path=`age` is 31, min is 40", s.GetLogData().Snapshot.Error.Message)
+		assert.Equal(t, float64(31), s.GetLogData().Snapshot.Meta.Value.GetNumberValue())
+	})
+	t.Run("Should: return ok because number is in range", func(t *testing.T) {
+		min := float64(30)
+		max := float64(31)
+		s := ExecHTTPValue("", 0, &scheduler_config_storage.HTTPValueConfig{Method: http.MethodGet, Headers: map[string]string{}, Selectors: []*scheduler_config_storage.Selectors{
+			{
+				Type:       apiPb.HttpJsonValueConfig_NUMBER,
+				Path:       "age",
+				WarningMin: &min,
+				WarningMax: &max,
+			},
+		}}, &mockSuccess{})
+		assert.Equal(t, apiPb.SchedulerCode_OK, s.GetLogData().Snapshot.Code)
+		assert.Nil(t, s.GetLogData().Snapshot.Error)
+	})
+	t.Run("Should: return error because assertion failed", func(t *testing.T) {
+		city := "London"
+		min := float64(40)
+		s := ExecHTTPValue("", 0, &scheduler_config_storage.HTTPValueConfig{Method: http.MethodGet, Headers: map[string]string{}, Selectors: []*scheduler_config_storage.Selectors{
+			{
+				Type:       apiPb.HttpJsonValueConfig_STRING,
+				Path:       "city",
+				Assertion:  &scheduler_config_storage.SelectorAssertion{Equals: &city},
+				WarningMin: &min,
+			},
+			{
+				Type:       apiPb.HttpJsonValueConfig_NUMBER,
+				Path:       "age",
+				WarningMin: &min,
+			},
+		}}, &mockSuccess{})
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, s.GetLogData().Snapshot.Code)
+		assert.Equal(t, "ASSERTION_FAILED: value by path=`city` is \"New York\", expected \"London\"", s.GetLogData().Snapshot.Error.Message)
+		assert.Len(t, s.GetLogData().Snapshot.Meta.Value.GetListValue().Values, 2)
+	})
+	t.Run("Should: return ok because assertion passed", func(t *testing.T) {
+		name := "Jane"
+		min := float64(30)
+		max := float64(40)
+		success := true
+		s := ExecHTTPValue("", 0, &scheduler_config_storage.HTTPValueConfig{Method: http.MethodGet, Headers: map[string]string{}, Selectors: []*scheduler_config_storage.Selectors{
+			{
+				Type:      apiPb.HttpJsonValueConfig_STRING,
+				Path:      "name",
+				Assertion: &scheduler_config_storage.SelectorAssertion{NotEquals: &name, Regex: "^J"},
+			},
+			{
+				Type:      apiPb.HttpJsonValueConfig_NUMBER,
+				Path:      "age",
+				Assertion: &scheduler_config_storage.SelectorAssertion{GreaterThan: &min, LessThan: &max},
+			},
+			{
+				Type:      apiPb.HttpJsonValueConfig_BOOL,
+				Path:      "success",
+				Assertion: &scheduler_config_storage.SelectorAssertion{BoolEquals: &success},
+			},
+		}}, &mockSuccess{})
+		assert.Equal(t, apiPb.SchedulerCode_OK, s.GetLogData().Snapshot.Code)
+		assert.Nil(t, s.GetLogData().Snapshot.Error)
+	})
+}
+
+func TestCheckSelectorAssertion(t *testing.T) {
+	res := gjson.Parse(`{"status": "red", "count": 5, "ok": false}`)
+	value := func(v string) *string {
+		return &v
+	}
+	number := func(v float64) *float64 {
+		return &v
+	}
+	t.Run("Should: pass without assertion", func(t *testing.T) {
+		assert.Len(t, checkSelectorAssertion(&scheduler_config_storage.Selectors{Path: "status"}, res.Get("status")), 0)
+	})
+	t.Run("Should: return every failed assertion", func(t *testing.T) {
+		errs := checkSelectorAssertion(&scheduler_config_storage.Selectors{
+			Path: "status",
+			Assertion: &scheduler_config_storage.SelectorAssertion{
+				Equals:    value("green"),
+				NotEquals: value("red"),
+				Regex:     "^g",
+			},
+		}, res.Get("status"))
+		assert.Len(t, errs, 3)
+		assert.Equal(t, "ASSERTION_FAILED: value by path=`status` is \"red\", expected not \"red\"", errs[1].Error())
+		assert.Equal(t, "ASSERTION_FAILED: value by path=`status` is \"red\", does not match \"^g\"", errs[2].Error())
+	})
+	t.Run("Should: compare numbers", func(t *testing.T) {
+		errs := checkSelectorAssertion(&scheduler_config_storage.Selectors{
+			Path:      "count",
+			Assertion: &scheduler_config_storage.SelectorAssertion{GreaterThan: number(5)},
+		}, res.Get("count"))
+		assert.Equal(t, "ASSERTION_FAILED: value by path=`count` is 5, expected greater than 5", errs[0].Error())
+		errs = checkSelectorAssertion(&scheduler_config_storage.Selectors{
+			Path:      "count",
+			Assertion: &scheduler_config_storage.SelectorAssertion{GreaterThan: number(1), LessThan: number(3)},
+		}, res.Get("count"))
+		assert.Equal(t, "ASSERTION_FAILED: value by path=`count` is 5, expected less than 3", errs[0].Error())
+		errs = checkSelectorAssertion(&scheduler_config_storage.Selectors{
+			Path:      "status",
+			Assertion: &scheduler_config_storage.SelectorAssertion{LessThan: number(3)},
+		}, res.Get("status"))
+		assert.Equal(t, "ASSERTION_FAILED: value by path=`status` is \"red\", expected number", errs[0].Error())
+	})
+	t.Run("Should: compare bool", func(t *testing.T) {
+		expected := true
+		errs := checkSelectorAssertion(&scheduler_config_storage.Selectors{
+			Path:      "ok",
+			Assertion: &scheduler_config_storage.SelectorAssertion{BoolEquals: &expected},
+		}, res.Get("ok"))
+		assert.Equal(t, "ASSERTION_FAILED: value by path=`ok` is \"false\", expected true", errs[0].Error())
+		errs = checkSelectorAssertion(&scheduler_config_storage.Selectors{
+			Path:      "status",
+			Assertion: &scheduler_config_storage.SelectorAssertion{BoolEquals: &expected},
+		}, res.Get("status"))
+		assert.Len(t, errs, 1)
+	})
 }
@@ -44,7 +44,7 @@ func newMongoError(schedulerID string, startTime, endTime *timestamp.Timestamp,
 
 func (s *mongoError) GetLogData() *apiPb.SchedulerResponse {
 	var err *apiPb.SchedulerSnapshot_Error
-	if s.code == apiPb.SchedulerCode_ERROR {
+	if s.code != apiPb.SchedulerCode_OK {
 		err = &apiPb.SchedulerSnapshot_Error{
 			Message: s.description,
 		}
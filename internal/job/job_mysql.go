@@ -39,7 +39,7 @@ func newSqlError(schedulerID string, startTime, endTime *timestamp.Timestamp, co
 
 func (s *mysqlError) GetLogData() *apiPb.SchedulerResponse {
 	var err *apiPb.SchedulerSnapshot_Error
-	if s.code == apiPb.SchedulerCode_ERROR {
+	if s.code != apiPb.SchedulerCode_OK {
 		err = &apiPb.SchedulerSnapshot_Error{
 			Message: s.description,
 		}
@@ -38,7 +38,7 @@ func newPostgresError(schedulerID string, startTime, endTime *timestamp.Timestam
 
 func (s *postgresError) GetLogData() *apiPb.SchedulerResponse {
 	var err *apiPb.SchedulerSnapshot_Error
-	if s.code == apiPb.SchedulerCode_ERROR {
+	if s.code != apiPb.SchedulerCode_OK {
 		err = &apiPb.SchedulerSnapshot_Error{
 			Message: s.description,
 		}
@@ -0,0 +1,221 @@
+package job
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"github.com/squzy/squzy/internal/helpers"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"net"
+	"strconv"
+	"strings"
+)
+
+const (
+	redisPort = 6379
+)
+
+var (
+	// INFO values saved as value of check
+	redisMetrics = []string{"redis_version", "role", "uptime_in_seconds", "connected_clients", "used_memory", "master_link_status"}
+)
+
+type redisError struct {
+	schedulerID string
+	startTime   *timestamp.Timestamp
+	endTime     *timestamp.Timestamp
+	code        apiPb.SchedulerCode
+	description string
+	value       *structpb.Value
+}
+
+func (s *redisError) GetLogData() *apiPb.SchedulerResponse {
+	var err *apiPb.SchedulerSnapshot_Error
+	if s.code != apiPb.SchedulerCode_OK {
+		err = &apiPb.SchedulerSnapshot_Error{
+			Message: s.description,
+		}
+	}
+	return &apiPb.SchedulerResponse{
+		SchedulerId: s.schedulerID,
+		Snapshot: &apiPb.SchedulerSnapshot{
+			Code:  s.code,
+			Error: err,
+			Type:  apiPb.SchedulerType_REDIS,
+			Meta: &apiPb.SchedulerSnapshot_MetaData{
+				StartTime: s.startTime,
+				EndTime:   s.endTime,
+				Value:     s.value,
+			},
+		},
+	}
+}
+
+func newRedisError(schedulerID string, startTime *timestamp.Timestamp, endTime *timestamp.Timestamp, code apiPb.SchedulerCode, description string, value *structpb.Value) CheckError {
+	return &redisError{
+		schedulerID: schedulerID,
+		startTime:   startTime,
+		endTime:     endTime,
+		code:        code,
+		description: description,
+		value:       value,
+	}
+}
+
+// ExecRedis pings redis and checks thresholds of INFO, metrics from INFO are value of check
+func ExecRedis(schedulerID string, timeout int32, config *scheduler_config_storage.RedisConfig) CheckError {
+	startTime := timestamp.Now()
+	ctx, cancel := helpers.TimeoutContext(context.Background(), helpers.DurationNotNegative(timeout))
+	defer cancel()
+	client := redis.NewClient(redisOptions(config, timeout))
+	defer func() {
+		_ = client.Close()
+	}()
+	if err := client.Ping(ctx).Err(); err != nil {
+		return newRedisError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, redisPingFailure(err).Error(), nil)
+	}
+	raw, err := client.Info(ctx).Result()
+	if err != nil {
+		return newRedisError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, redisInfoError.Error(), nil)
+	}
+	info := parseRedisInfo(raw)
+	value, err := structpb.NewValue(redisValue(info))
+	if err != nil {
+		return newRedisError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, redisInfoError.Error(), nil)
+	}
+	if err := checkRedisInfo(config, info); err != nil {
+		return newRedisError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, err.Error(), value)
+	}
+	return newRedisError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_OK, "", value)
+}
+
+func redisOptions(config *scheduler_config_storage.RedisConfig, timeout int32) *redis.Options {
+	port := config.Port
+	if port == 0 {
+		port = redisPort
+	}
+	duration := helpers.DurationNotNegative(timeout)
+	options := &redis.Options{
+		Addr:         net.JoinHostPort(config.Host, fmt.Sprintf("%d", port)),
+		Username:     config.User,
+		Password:     config.Password,
+		DB:           int(config.Db),
+		DialTimeout:  duration,
+		ReadTimeout:  duration,
+		WriteTimeout: duration,
+		// Failed command should fail check, not be retried
+		MaxRetries: -1,
+		PoolSize:   1,
+	}
+	if config.TLS {
+		options.TLSConfig = &tls.Config{
+			ServerName:         config.Host,
+			InsecureSkipVerify: config.InsecureSkipVerify,
+		}
+	}
+	return options
+}
+
+// Error replied by redis means connection is fine, so only auth errors are separated
+func redisPingFailure(err error) error {
+	var replyErr redis.Error
+	if !errors.As(err, &replyErr) {
+		return redisConnectionError
+	}
+	msg := replyErr.Error()
+	if strings.HasPrefix(msg, "NOAUTH") || strings.HasPrefix(msg, "WRONGPASS") || strings.Contains(msg, "invalid password") {
+		return redisAuthError
+	}
+	return redisPingError
+}
+
+// INFO is list of "field:value" lines split by "# Section" headers
+func parseRedisInfo(raw string) map[string]string {
+	info := map[string]string{}
+	for _, line := range strings.Split(raw, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		info[parts[0]] = parts[1]
+	}
+	return info
+}
+
+// Keyspace has line like "db0:keys=1,expires=0,avg_ttl=0" for every not empty database
+func redisKeys(info map[string]string) int64 {
+	var keys int64
+	for field, value := range info {
+		if !strings.HasPrefix(field, "db") {
+			continue
+		}
+		if _, err := strconv.Atoi(strings.TrimPrefix(field, "db")); err != nil {
+			continue
+		}
+		for _, pair := range strings.Split(value, ",") {
+			if count := strings.TrimPrefix(pair, "keys="); count != pair {
+				n, _ := strconv.ParseInt(count, 10, 64)
+				keys += n
+			}
+		}
+	}
+	return keys
+}
+
+func redisValue(info map[string]string) map[string]interface{} {
+	value := map[string]interface{}{
+		"keys": redisKeys(info),
+	}
+	for _, field := range redisMetrics {
+		raw, ok := info[field]
+		if !ok {
+			continue
+		}
+		if n, err := strconv.ParseInt(raw, 10, 64); err == nil {
+			value[field] = n
+			continue
+		}
+		value[field] = raw
+	}
+	return value
+}
+
+func checkRedisInfo(config *scheduler_config_storage.RedisConfig, info map[string]string) error {
+	if err := redisThreshold(info, "used_memory", config.MaxUsedMemory); err != nil {
+		return err
+	}
+	if err := redisThreshold(info, "connected_clients", config.MaxConnectedClients); err != nil {
+		return err
+	}
+	if keys := redisKeys(info); config.MaxKeys > 0 && keys > config.MaxKeys {
+		return fmt.Errorf("%w: keys %d is bigger than %d", redisThresholdError, keys, config.MaxKeys)
+	}
+	// Master does not have link, so it is checked only for replica
+	if config.MasterLinkUp && info["role"] == "slave" && info["master_link_status"] != "up" {
+		return fmt.Errorf("%w: %s", redisMasterLinkDownError, info["master_link_status"])
+	}
+	return nil
+}
+
+func redisThreshold(info map[string]string, field string, max int64) error {
+	if max <= 0 {
+		return nil
+	}
+	value, err := strconv.ParseInt(info[field], 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: %s not found", redisInfoError, field)
+	}
+	if value > max {
+		return fmt.Errorf("%w: %s %d is bigger than %d", redisThresholdError, field, value, max)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package job
+
+import (
+	"github.com/alicebob/miniredis/v2"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+const (
+	replicaInfo = "# Server\r\nredis_version:7.0.5\r\nuptime_in_seconds:120\r\n\r\n" +
+		"# Clients\r\nconnected_clients:12\r\n\r\n" +
+		"# Memory\r\nused_memory:1048576\r\nused_memory_human:1.00M\r\n\r\n" +
+		"# Replication\r\nrole:slave\r\nmaster_link_status:down\r\n\r\n" +
+		"# Keyspace\r\ndb0:keys=10,expires=1,avg_ttl=0\r\ndb3:keys=5,expires=0,avg_ttl=0\r\n"
+)
+
+func redisConfig(t *testing.T, s *miniredis.Miniredis) *scheduler_config_storage.RedisConfig {
+	port, err := strconv.Atoi(s.Port())
+	assert.Nil(t, err)
+	return &scheduler_config_storage.RedisConfig{
+		Host: s.Host(),
+		Port: int32(port),
+	}
+}
+
+func TestExecRedis(t *testing.T) {
+	t.Run("Should: return metrics as value", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		res := ExecRedis("", 1, redisConfig(t, s)).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_OK, res.Snapshot.Code)
+		assert.Equal(t, apiPb.SchedulerType_REDIS, res.Snapshot.Type)
+		fields := res.Snapshot.Meta.Value.GetStructValue().AsMap()
+		assert.Equal(t, float64(1), fields["connected_clients"])
+		assert.Equal(t, float64(0), fields["keys"])
+	})
+	t.Run("Should: return error because threshold is exceeded", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		config := redisConfig(t, s)
+		config.MaxConnectedClients = 1
+		assert.Equal(t, apiPb.SchedulerCode_OK, ExecRedis("", 1, config).GetLogData().Snapshot.Code)
+		config.MaxUsedMemory = 100
+		res := ExecRedis("", 1, config).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res.Snapshot.Code)
+		assert.Equal(t, "NO_INFO_REDIS: used_memory not found", res.Snapshot.Error.Message)
+		assert.NotNil(t, res.Snapshot.Meta.Value)
+	})
+	t.Run("Should: authenticate by password", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		s.RequireAuth("secret")
+		config := redisConfig(t, s)
+		res := ExecRedis("", 1, config).GetLogData()
+		assert.Equal(t, redisAuthError.Error(), res.Snapshot.Error.Message)
+		config.Password = "secret"
+		assert.Equal(t, apiPb.SchedulerCode_OK, ExecRedis("", 1, config).GetLogData().Snapshot.Code)
+	})
+	t.Run("Should: authenticate by acl user", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		s.RequireUserAuth("squzy", "secret")
+		config := redisConfig(t, s)
+		config.User = "squzy"
+		config.Password = "wrong"
+		res := ExecRedis("", 1, config).GetLogData()
+		assert.Equal(t, redisAuthError.Error(), res.Snapshot.Error.Message)
+		config.Password = "secret"
+		assert.Equal(t, apiPb.SchedulerCode_OK, ExecRedis("", 1, config).GetLogData().Snapshot.Code)
+	})
+	t.Run("Should: connect by tls", func(t *testing.T) {
+		serverTLSConf, _, err := certsetup(false, "127.0.0.1")
+		assert.Nil(t, err)
+		s, err := miniredis.RunTLS(serverTLSConf)
+		assert.Nil(t, err)
+		defer s.Close()
+		config := redisConfig(t, s)
+		config.TLS = true
+		res := ExecRedis("", 1, config).GetLogData()
+		assert.Equal(t, redisConnectionError.Error(), res.Snapshot.Error.Message)
+		config.InsecureSkipVerify = true
+		assert.Equal(t, apiPb.SchedulerCode_OK, ExecRedis("", 1, config).GetLogData().Snapshot.Code)
+	})
+	t.Run("Should: return error because redis is not available", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		config := redisConfig(t, s)
+		s.Close()
+		res := ExecRedis("", 1, config).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res.Snapshot.Code)
+		assert.Equal(t, redisConnectionError.Error(), res.Snapshot.Error.Message)
+	})
+}
+
+func TestCheckRedisInfo(t *testing.T) {
+	info := parseRedisInfo(replicaInfo)
+	t.Run("Should: parse info", func(t *testing.T) {
+		assert.Equal(t, "1048576", info["used_memory"])
+		assert.Equal(t, "slave", info["role"])
+		assert.Equal(t, int64(15), redisKeys(info))
+		assert.Equal(t, map[string]interface{}{
+			"redis_version":      "7.0.5",
+			"role":               "slave",
+			"uptime_in_seconds":  int64(120),
+			"connected_clients":  int64(12),
+			"used_memory":        int64(1048576),
+			"master_link_status": "down",
+			"keys":               int64(15),
+		}, redisValue(info))
+	})
+	t.Run("Should: return nil because thresholds are not set", func(t *testing.T) {
+		assert.Nil(t, checkRedisInfo(&scheduler_config_storage.RedisConfig{}, info))
+	})
+	t.Run("Should: return error because thresholds are exceeded", func(t *testing.T) {
+		tt := map[*scheduler_config_storage.RedisConfig]string{
+			{MaxUsedMemory: 1024}:     "REDIS_THRESHOLD_EXCEEDED: used_memory 1048576 is bigger than 1024",
+			{MaxConnectedClients: 10}: "REDIS_THRESHOLD_EXCEEDED: connected_clients 12 is bigger than 10",
+			{MaxKeys: 14}:             "REDIS_THRESHOLD_EXCEEDED: keys 15 is bigger than 14",
+			{MasterLinkUp: true}:      "REDIS_MASTER_LINK_DOWN: down",
+		}
+		for config, expected := range tt {
+			assert.EqualError(t, checkRedisInfo(config, info), expected)
+		}
+	})
+	t.Run("Should: not check master link of master", func(t *testing.T) {
+		assert.Nil(t, checkRedisInfo(&scheduler_config_storage.RedisConfig{MasterLinkUp: true}, map[string]string{"role": "master"}))
+	})
+}
@@ -25,7 +25,7 @@ type siteMapError struct {
 
 func (s *siteMapError) GetLogData() *apiPb.SchedulerResponse {
 	var err *apiPb.SchedulerSnapshot_Error
-	if s.code == apiPb.SchedulerCode_ERROR {
+	if s.code != apiPb.SchedulerCode_OK {
 		err = &apiPb.SchedulerSnapshot_Error{
 			Message: fmt.Sprintf("Error: %s, URL: %s", s.description, s.location),
 		}
@@ -92,7 +92,7 @@ func ExecSiteMap(schedulerID string, timeout int32, config *scheduler_config_sto
 
 			defer sem.Release()
 
-			rq := httpTools.CreateRequest(http.MethodGet, location, nil, schedulerID)
+			rq := httpTools.CreateRequest(http.MethodGet, location, nil, nil, schedulerID)
 			_, _, errSem = httpTools.SendRequestTimeoutStatusCode(rq, helpers.DurationFromSecond(timeout), http.StatusOK)
 
 			if errSem != nil {
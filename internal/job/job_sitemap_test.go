@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"github.com/squzy/squzy/internal/parsers"
+	"github.com/squzy/squzy/internal/httptools"
 	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
 	"github.com/squzy/squzy/internal/semaphore"
 	"testing"
@@ -20,15 +21,23 @@ func (m mockHttpTools) SendRequestTimeoutStatusCode(req *http.Request, timeout t
 	return 200, nil, nil
 }
 
+func (m mockHttpTools) SendRequestTimeoutResponse(req *http.Request, timeout time.Duration, expectedCode int) (*http.Response, []byte, error) {
+	panic("implement me")
+}
+
 func (m mockHttpTools) SendRequestTimeout(req *http.Request, timeout time.Duration) (int, []byte, error) {
 	return 200, nil, nil
 }
 
-func (m mockHttpTools) CreateRequest(method string, url string, headers *map[string]string, log string) *http.Request {
+func (m mockHttpTools) CreateRequest(method string, url string, headers *map[string]string, body *scheduler_config_storage.HTTPBody, log string) *http.Request {
 	rq, _ := http.NewRequest(method, url, nil)
 	return rq
 }
 
+func (m mockHttpTools) ForCheck(auth *scheduler_config_storage.HTTPAuth, tlsConfig *scheduler_config_storage.HTTPTLS) (httptools.HTTPTool, error) {
+	panic("implement me")
+}
+
 func (m mockHttpTools) SendRequest(req *http.Request) (int, []byte, error) {
 	return 200, nil, nil
 }
@@ -96,6 +105,10 @@ func (m mockHttpToolsWithError) SendRequestTimeoutStatusCode(req *http.Request,
 	return 500, nil, errors.New("Wrong code")
 }
 
+func (m mockHttpToolsWithError) SendRequestTimeoutResponse(req *http.Request, timeout time.Duration, expectedCode int) (*http.Response, []byte, error) {
+	panic("implement me")
+}
+
 func (m mockHttpToolsWithError) SendRequestTimeout(req *http.Request, timeout time.Duration) (int, []byte, error) {
 	panic("implement me")
 }
@@ -108,11 +121,15 @@ func (m mockHttpToolsWithError) GetWithRedirects(url string) (int, []byte, error
 	return 500, nil, errors.New("Wrong code")
 }
 
-func (m mockHttpToolsWithError) CreateRequest(method string, url string, headers *map[string]string, log string) *http.Request {
+func (m mockHttpToolsWithError) CreateRequest(method string, url string, headers *map[string]string, body *scheduler_config_storage.HTTPBody, log string) *http.Request {
 	rq, _ := http.NewRequest(method, url, nil)
 	return rq
 }
 
+func (m mockHttpToolsWithError) ForCheck(auth *scheduler_config_storage.HTTPAuth, tlsConfig *scheduler_config_storage.HTTPTLS) (httptools.HTTPTool, error) {
+	panic("implement me")
+}
+
 func (m mockHttpToolsWithError) SendRequest(req *http.Request) (int, []byte, error) {
 	return 500, nil, errors.New("Wrong code")
 }
@@ -9,6 +9,11 @@ import (
 	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamp "google.golang.org/protobuf/types/known/timestamppb"
 	"net"
+	"time"
+)
+
+const (
+	day = time.Hour * 24
 )
 
 type sslError struct {
@@ -22,7 +27,7 @@ type sslError struct {
 
 func (s *sslError) GetLogData() *apiPb.SchedulerResponse {
 	var err *apiPb.SchedulerSnapshot_Error
-	if s.code == apiPb.SchedulerCode_ERROR {
+	if s.code != apiPb.SchedulerCode_OK {
 		err = &apiPb.SchedulerSnapshot_Error{
 			Message: s.description,
 		}
@@ -68,9 +73,23 @@ func ExecSSL(schedulerID string, timeout int32, config *scheduler_config_storage
 
 	crt := conn.ConnectionState().PeerCertificates[0]
 
-	return newSSLError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_OK, "", &structpb.Value{
+	code, description := passedCode(expiresSoon(crt.NotAfter, time.Now(), config.WarningDays))
+
+	return newSSLError(schedulerID, startTime, timestamp.Now(), code, description, &structpb.Value{
 		Kind: &structpb.Value_NumberValue{
 			NumberValue: float64(crt.NotAfter.UnixNano()),
 		},
 	})
 }
+
+// Passed check is WARNING when certificate expires in less than warningDays, 0 means not checked
+func expiresSoon(notAfter time.Time, now time.Time, warningDays int32) error {
+	if warningDays <= 0 {
+		return nil
+	}
+	left := notAfter.Sub(now)
+	if left >= time.Duration(warningDays)*day {
+		return nil
+	}
+	return fmt.Errorf("%w: expires in %d days", errSslExpiresSoon, int(left/day))
+}
@@ -8,6 +8,7 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"github.com/stretchr/testify/assert"
@@ -159,3 +160,18 @@ func certsetup(isCa bool, hostName string) (serverTLSConf *tls.Config, clientTLS
 
 	return
 }
+
+func TestExpiresSoon(t *testing.T) {
+	now := time.Now()
+	t.Run("Should: return nil because warning days is not set", func(t *testing.T) {
+		assert.Nil(t, expiresSoon(now, now, 0))
+	})
+	t.Run("Should: return nil because certificate expires later", func(t *testing.T) {
+		assert.Nil(t, expiresSoon(now.Add(day*31), now, 30))
+	})
+	t.Run("Should: return error because certificate expires soon", func(t *testing.T) {
+		err := expiresSoon(now.Add(day*5+time.Hour), now, 30)
+		assert.True(t, errors.Is(err, errSslExpiresSoon))
+		assert.Equal(t, "SSL_EXPIRES_SOON: expires in 5 days", err.Error())
+	})
+}
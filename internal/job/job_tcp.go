@@ -1,12 +1,29 @@
 package job
 
 import (
+	"errors"
 	"fmt"
 	"github.com/squzy/squzy/internal/helpers"
 	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"io"
 	"net"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	// Reply is not read further, so big banners are cut
+	maxReplySize = 64 * 1024
+)
+
+var (
+	errNoReply          = errors.New("NO_REPLY")
+	errUnexpectedReply  = errors.New("UNEXPECTED_REPLY")
+	errWrongExpectRegex = errors.New("WRONG_EXPECT_REGEX")
 )
 
 type tcpError struct {
@@ -15,11 +32,12 @@ type tcpError struct {
 	endTime     *timestamp.Timestamp
 	code        apiPb.SchedulerCode
 	description string
+	value       *structpb.Value
 }
 
 func (s *tcpError) GetLogData() *apiPb.SchedulerResponse {
 	var err *apiPb.SchedulerSnapshot_Error
-	if s.code == apiPb.SchedulerCode_ERROR {
+	if s.code != apiPb.SchedulerCode_OK {
 		err = &apiPb.SchedulerSnapshot_Error{
 			Message: s.description,
 		}
@@ -33,31 +51,138 @@ func (s *tcpError) GetLogData() *apiPb.SchedulerResponse {
 			Meta: &apiPb.SchedulerSnapshot_MetaData{
 				StartTime: s.startTime,
 				EndTime:   s.endTime,
+				Value:     s.value,
 			},
 		},
 	}
 }
 
-func newTCPError(schedulerID string, startTime *timestamp.Timestamp, endTime *timestamp.Timestamp, code apiPb.SchedulerCode, description string) CheckError {
+func newTCPError(schedulerID string, startTime *timestamp.Timestamp, endTime *timestamp.Timestamp, code apiPb.SchedulerCode, description string, value *structpb.Value) CheckError {
 	return &tcpError{
 		schedulerID: schedulerID,
 		startTime:   startTime,
 		endTime:     endTime,
 		code:        code,
 		description: description,
+		value:       value,
 	}
 }
 
+// ExecTCP only connects when nothing should be sent or expected, payload without expectation is only sent,
+// otherwise received reply is value of check
 func ExecTCP(schedulerID string, timeout int32, config *scheduler_config_storage.TCPConfig) CheckError {
 	startTime := timestamp.Now()
+	matcher, err := newReplyMatcher(config)
+	if err != nil {
+		return newTCPError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, err.Error(), nil)
+	}
 	conn, err := net.DialTimeout("tcp", net.JoinHostPort(config.Host, fmt.Sprintf("%d", config.Port)), helpers.DurationFromSecond(timeout))
 	if err != nil {
-		return newTCPError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, errWrongConnectConfigError.Error())
+		return newTCPError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, errWrongConnectConfigError.Error(), nil)
 	}
 	if conn != nil {
 		defer func() {
 			_ = conn.Close()
 		}()
 	}
-	return newTCPError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_OK, "")
+	var value *structpb.Value
+	if config.Send != "" || matcher.required() {
+		reply, err := exchangeTCPReply(conn, config.Send, matcher, readDeadline(timeout, config.ReadTimeout))
+		if reply != "" {
+			value = structpb.NewStringValue(reply)
+		}
+		if err != nil {
+			return newTCPError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, err.Error(), value)
+		}
+	}
+	endTime := timestamp.Now()
+	code, description := passedCode(slowResponse(startTime, endTime, config.WarningTime))
+	return newTCPError(schedulerID, startTime, endTime, code, description, value)
+}
+
+// Timeout of check is used when read timeout is not set
+func readDeadline(timeout int32, readTimeout int32) time.Time {
+	if readTimeout > 0 {
+		return time.Now().Add(time.Duration(readTimeout) * time.Millisecond)
+	}
+	return time.Now().Add(helpers.DurationNotNegative(timeout))
+}
+
+// Reply is read only when it is expected and until it matches expectation
+func exchangeTCPReply(conn net.Conn, send string, matcher *replyMatcher, deadline time.Time) (string, error) {
+	_ = conn.SetDeadline(deadline)
+	if send != "" {
+		if _, err := conn.Write([]byte(send)); err != nil {
+			return "", errWrongConnectConfigError
+		}
+	}
+	if !matcher.required() {
+		return "", nil
+	}
+	reply := []byte{}
+	buf := make([]byte, 4096)
+	for len(reply) < maxReplySize {
+		n, err := conn.Read(buf)
+		reply = append(reply, buf[:n]...)
+		if n > 0 && matcher.match(string(reply)) == nil {
+			break
+		}
+		if err != nil {
+			if len(reply) == 0 {
+				return "", readError(err)
+			}
+			break
+		}
+	}
+	return trimReply(string(reply)), matcher.match(string(reply))
+}
+
+// Expected reply of TCP and UDP checks, regex is compiled once per check
+type replyMatcher struct {
+	expect string
+	re     *regexp.Regexp
+}
+
+func newReplyMatcher(config *scheduler_config_storage.TCPConfig) (*replyMatcher, error) {
+	matcher := &replyMatcher{
+		expect: config.Expect,
+	}
+	if config.ExpectRegex != "" {
+		re, err := regexp.Compile(config.ExpectRegex)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errWrongExpectRegex, err.Error())
+		}
+		matcher.re = re
+	}
+	return matcher, nil
+}
+
+func (m *replyMatcher) required() bool {
+	return m.expect != "" || m.re != nil
+}
+
+// Exact reply is compared without trailing line break, regex is matched with whole reply
+func (m *replyMatcher) match(reply string) error {
+	if m.re != nil {
+		if !m.re.MatchString(reply) {
+			return fmt.Errorf("%w: %q", errUnexpectedReply, trimReply(reply))
+		}
+		return nil
+	}
+	if m.expect != "" && trimReply(reply) != trimReply(m.expect) {
+		return fmt.Errorf("%w: %q", errUnexpectedReply, trimReply(reply))
+	}
+	return nil
+}
+
+func trimReply(reply string) string {
+	return strings.TrimRight(reply, "\r\n")
+}
+
+func readError(err error) error {
+	var netErr net.Error
+	if errors.Is(err, io.EOF) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return errNoReply
+	}
+	return errWrongConnectConfigError
 }
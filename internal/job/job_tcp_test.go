@@ -48,3 +48,143 @@ func TestExecTcp(t *testing.T) {
 		})
 	})
 }
+
+// Server answers with banner, then echoes every received line
+func newBannerServer(t *testing.T, banner string) (string, int32) {
+	server, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		_ = server.Close()
+	})
+	go func() {
+		for {
+			conn, err := server.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				if banner != "" {
+					_, _ = conn.Write([]byte(banner))
+				}
+				buf := make([]byte, 1024)
+				for {
+					n, err := conn.Read(buf)
+					if err != nil {
+						return
+					}
+					_, _ = conn.Write(buf[:n])
+				}
+			}()
+		}
+	}()
+	addr := server.Addr().(*net.TCPAddr)
+	return addr.IP.String(), int32(addr.Port)
+}
+
+func TestExecTcpExchange(t *testing.T) {
+	t.Run("Should: return banner as value", func(t *testing.T) {
+		host, port := newBannerServer(t, "220 squzy ESMTP\r\n")
+		res := ExecTCP("", 1, &scheduler_config_storage.TCPConfig{
+			Host:        host,
+			Port:        port,
+			ExpectRegex: "^220 ",
+		}).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_OK, res.Snapshot.Code)
+		assert.Equal(t, "220 squzy ESMTP", res.Snapshot.Meta.Value.GetStringValue())
+	})
+	t.Run("Should: send payload and match exact reply", func(t *testing.T) {
+		host, port := newBannerServer(t, "")
+		res := ExecTCP("", 1, &scheduler_config_storage.TCPConfig{
+			Host:   host,
+			Port:   port,
+			Send:   "+PONG\r\n",
+			Expect: "+PONG",
+		}).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_OK, res.Snapshot.Code)
+		assert.Equal(t, "+PONG", res.Snapshot.Meta.Value.GetStringValue())
+	})
+	t.Run("Should: return error because reply is unexpected", func(t *testing.T) {
+		host, port := newBannerServer(t, "")
+		res := ExecTCP("", 1, &scheduler_config_storage.TCPConfig{
+			Host:        host,
+			Port:        port,
+			Send:        "-ERR\r\n",
+			Expect:      "+PONG",
+			ReadTimeout: 100,
+		}).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res.Snapshot.Code)
+		assert.Equal(t, `UNEXPECTED_REPLY: "-ERR"`, res.Snapshot.Error.Message)
+		assert.Equal(t, "-ERR", res.Snapshot.Meta.Value.GetStringValue())
+	})
+	t.Run("Should: return error because nothing received", func(t *testing.T) {
+		host, port := newBannerServer(t, "")
+		res := ExecTCP("", 1, &scheduler_config_storage.TCPConfig{
+			Host:        host,
+			Port:        port,
+			Expect:      "+PONG",
+			ReadTimeout: 100,
+		}).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res.Snapshot.Code)
+		assert.Equal(t, errNoReply.Error(), res.Snapshot.Error.Message)
+		assert.Nil(t, res.Snapshot.Meta.Value)
+	})
+	t.Run("Should: only send payload without expectation", func(t *testing.T) {
+		server, err := net.Listen("tcp", "127.0.0.1:0")
+		assert.Nil(t, err)
+		defer server.Close()
+		received := make(chan string, 1)
+		go func() {
+			conn, err := server.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			buf := make([]byte, 1024)
+			n, _ := conn.Read(buf)
+			received <- string(buf[:n])
+		}()
+		addr := server.Addr().(*net.TCPAddr)
+		start := time.Now()
+		res := ExecTCP("", 1, &scheduler_config_storage.TCPConfig{
+			Host:        addr.IP.String(),
+			Port:        int32(addr.Port),
+			Send:        "PING\r\n",
+			ReadTimeout: 500,
+		}).GetLogData()
+		assert.True(t, time.Since(start) < 500*time.Millisecond)
+		assert.Equal(t, apiPb.SchedulerCode_OK, res.Snapshot.Code)
+		assert.Nil(t, res.Snapshot.Meta.Value)
+		assert.Equal(t, "PING\r\n", <-received)
+	})
+	t.Run("Should: return error because regex is wrong", func(t *testing.T) {
+		host, port := newBannerServer(t, "banner")
+		res := ExecTCP("", 1, &scheduler_config_storage.TCPConfig{
+			Host:        host,
+			Port:        port,
+			ExpectRegex: "(",
+			ReadTimeout: 100,
+		}).GetLogData()
+		assert.Contains(t, res.Snapshot.Error.Message, errWrongExpectRegex.Error())
+	})
+}
+
+func TestReplyMatcher(t *testing.T) {
+	t.Run("Should: return error because regex is wrong", func(t *testing.T) {
+		_, err := newReplyMatcher(&scheduler_config_storage.TCPConfig{ExpectRegex: "("})
+		assert.ErrorIs(t, err, errWrongExpectRegex)
+	})
+	t.Run("Should: match regex with whole reply", func(t *testing.T) {
+		matcher, err := newReplyMatcher(&scheduler_config_storage.TCPConfig{ExpectRegex: "^220 .*ESMTP"})
+		assert.Nil(t, err)
+		assert.True(t, matcher.required())
+		assert.ErrorIs(t, matcher.match("220 squzy"), errUnexpectedReply)
+		assert.Nil(t, matcher.match("220 squzy ESMTP\r\n"))
+	})
+	t.Run("Should: not require reply without expectation", func(t *testing.T) {
+		matcher, err := newReplyMatcher(&scheduler_config_storage.TCPConfig{Send: "PING"})
+		assert.Nil(t, err)
+		assert.False(t, matcher.required())
+		assert.Nil(t, matcher.match(""))
+	})
+}
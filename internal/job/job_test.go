@@ -1 +1,38 @@
 package job
+
+import (
+	"errors"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+func TestSlowResponse(t *testing.T) {
+	start := time.Now()
+	t.Run("Should: return nil because warning time is not set", func(t *testing.T) {
+		assert.Nil(t, slowResponse(timestamp.New(start), timestamp.New(start.Add(time.Hour)), 0))
+	})
+	t.Run("Should: return nil because response is fast", func(t *testing.T) {
+		assert.Nil(t, slowResponse(timestamp.New(start), timestamp.New(start.Add(time.Millisecond*100)), 100))
+	})
+	t.Run("Should: return error because response is slow", func(t *testing.T) {
+		err := slowResponse(timestamp.New(start), timestamp.New(start.Add(time.Millisecond*150)), 100)
+		assert.True(t, errors.Is(err, errSlowResponse))
+		assert.Equal(t, "SLOW_RESPONSE: 150ms longer than 100ms", err.Error())
+	})
+}
+
+func TestPassedCode(t *testing.T) {
+	t.Run("Should: return OK", func(t *testing.T) {
+		code, description := passedCode(nil)
+		assert.Equal(t, apiPb.SchedulerCode_OK, code)
+		assert.Equal(t, "", description)
+	})
+	t.Run("Should: return WARNING with description", func(t *testing.T) {
+		code, description := passedCode(errSlowResponse)
+		assert.Equal(t, apiPb.SchedulerCode_WARNING, code)
+		assert.Equal(t, errSlowResponse.Error(), description)
+	})
+}
@@ -0,0 +1,86 @@
+package job
+
+import (
+	"fmt"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"net"
+)
+
+type udpError struct {
+	schedulerID string
+	startTime   *timestamp.Timestamp
+	endTime     *timestamp.Timestamp
+	code        apiPb.SchedulerCode
+	description string
+	value       *structpb.Value
+}
+
+func (s *udpError) GetLogData() *apiPb.SchedulerResponse {
+	var err *apiPb.SchedulerSnapshot_Error
+	if s.code != apiPb.SchedulerCode_OK {
+		err = &apiPb.SchedulerSnapshot_Error{
+			Message: s.description,
+		}
+	}
+	return &apiPb.SchedulerResponse{
+		SchedulerId: s.schedulerID,
+		Snapshot: &apiPb.SchedulerSnapshot{
+			Code:  s.code,
+			Error: err,
+			Type:  apiPb.SchedulerType_UDP,
+			Meta: &apiPb.SchedulerSnapshot_MetaData{
+				StartTime: s.startTime,
+				EndTime:   s.endTime,
+				Value:     s.value,
+			},
+		},
+	}
+}
+
+func newUDPError(schedulerID string, startTime *timestamp.Timestamp, endTime *timestamp.Timestamp, code apiPb.SchedulerCode, description string, value *structpb.Value) CheckError {
+	return &udpError{
+		schedulerID: schedulerID,
+		startTime:   startTime,
+		endTime:     endTime,
+		code:        code,
+		description: description,
+		value:       value,
+	}
+}
+
+// ExecUDP sends payload and waits one datagram as reply, it is matched same way as TCP reply
+func ExecUDP(schedulerID string, timeout int32, config *scheduler_config_storage.TCPConfig) CheckError {
+	startTime := timestamp.Now()
+	matcher, err := newReplyMatcher(config)
+	if err != nil {
+		return newUDPError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, err.Error(), nil)
+	}
+	conn, err := net.Dial("udp", net.JoinHostPort(config.Host, fmt.Sprintf("%d", config.Port)))
+	if err != nil {
+		return newUDPError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, errWrongConnectConfigError.Error(), nil)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	_ = conn.SetDeadline(readDeadline(timeout, config.ReadTimeout))
+	if _, err := conn.Write([]byte(config.Send)); err != nil {
+		return newUDPError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, errWrongConnectConfigError.Error(), nil)
+	}
+	buf := make([]byte, maxReplySize)
+	n, err := conn.Read(buf)
+	if err != nil {
+		// Closed port is reported by icmp, so it is not timeout
+		return newUDPError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, readError(err).Error(), nil)
+	}
+	reply := string(buf[:n])
+	value := structpb.NewStringValue(trimReply(reply))
+	if err := matcher.match(reply); err != nil {
+		return newUDPError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, err.Error(), value)
+	}
+	endTime := timestamp.Now()
+	code, description := passedCode(slowResponse(startTime, endTime, config.WarningTime))
+	return newUDPError(schedulerID, startTime, endTime, code, description, value)
+}
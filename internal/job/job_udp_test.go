@@ -0,0 +1,81 @@
+package job
+
+import (
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"net"
+	"testing"
+)
+
+// Server answers with reply of payload, empty reply is not sent
+func newUDPServer(t *testing.T, reply func(payload string) string) (string, int32) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	go func() {
+		buf := make([]byte, 1024)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			if answer := reply(string(buf[:n])); answer != "" {
+				_, _ = conn.WriteTo([]byte(answer), addr)
+			}
+		}
+	}()
+	addr := conn.LocalAddr().(*net.UDPAddr)
+	return addr.IP.String(), int32(addr.Port)
+}
+
+func TestExecUDP(t *testing.T) {
+	echo := func(payload string) string {
+		return "stats " + payload
+	}
+	t.Run("Should: return reply as value", func(t *testing.T) {
+		host, port := newUDPServer(t, echo)
+		res := ExecUDP("", 1, &scheduler_config_storage.TCPConfig{
+			Host:        host,
+			Port:        port,
+			Send:        "ping\n",
+			ExpectRegex: "ping",
+		}).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_OK, res.Snapshot.Code)
+		assert.Equal(t, apiPb.SchedulerType_UDP, res.Snapshot.Type)
+		assert.Equal(t, "stats ping", res.Snapshot.Meta.Value.GetStringValue())
+	})
+	t.Run("Should: return error because reply is unexpected", func(t *testing.T) {
+		host, port := newUDPServer(t, echo)
+		res := ExecUDP("", 1, &scheduler_config_storage.TCPConfig{
+			Host:   host,
+			Port:   port,
+			Send:   "ping",
+			Expect: "pong",
+		}).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res.Snapshot.Code)
+		assert.Equal(t, `UNEXPECTED_REPLY: "stats ping"`, res.Snapshot.Error.Message)
+	})
+	t.Run("Should: return error because nothing received", func(t *testing.T) {
+		host, port := newUDPServer(t, func(string) string {
+			return ""
+		})
+		res := ExecUDP("", 1, &scheduler_config_storage.TCPConfig{
+			Host:        host,
+			Port:        port,
+			Send:        "ping",
+			ReadTimeout: 100,
+		}).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res.Snapshot.Code)
+		assert.Equal(t, errNoReply.Error(), res.Snapshot.Error.Message)
+	})
+	t.Run("Should: return error because host is wrong", func(t *testing.T) {
+		res := ExecUDP("", 1, &scheduler_config_storage.TCPConfig{
+			Host: "wrong host",
+			Port: 1,
+		}).GetLogData()
+		assert.Equal(t, errWrongConnectConfigError.Error(), res.Snapshot.Error.Message)
+	})
+}
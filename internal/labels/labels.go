@@ -0,0 +1,137 @@
+package labels
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	errInvalidKey         = errors.New("INVALID_LABEL_KEY")
+	errInvalidValue       = errors.New("INVALID_LABEL_VALUE")
+	errInvalidRequirement = errors.New("INVALID_SELECTOR_REQUIREMENT")
+)
+
+var (
+	// Dots are not allowed because key is used as part of mongo field path
+	keyRegexp   = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_/]{0,61}[A-Za-z0-9])?$`)
+	valueRegexp = regexp.MustCompile(`^([A-Za-z0-9]([-A-Za-z0-9_.]{0,61}[A-Za-z0-9])?)?$`)
+)
+
+type Operator string
+
+const (
+	Equals    Operator = "="
+	NotEquals Operator = "!="
+	Exists    Operator = "exists"
+	NotExists Operator = "!"
+)
+
+type Requirement struct {
+	Key      string
+	Operator Operator
+	// Empty for Exists and NotExists
+	Value string
+}
+
+// Selector matches labels when all requirements are satisfied, empty selector matches everything
+type Selector []*Requirement
+
+// Parse selector like "team=payments,env!=prod,critical,!deprecated"
+func Parse(selector string) (Selector, error) {
+	res := Selector{}
+	for _, part := range strings.Split(selector, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		requirement, err := parseRequirement(part)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, requirement)
+	}
+	return res, nil
+}
+
+func parseRequirement(part string) (*Requirement, error) {
+	requirement := &Requirement{}
+	switch {
+	case strings.Contains(part, "!="):
+		kv := strings.SplitN(part, "!=", 2)
+		requirement.Key, requirement.Operator, requirement.Value = kv[0], NotEquals, kv[1]
+	case strings.Contains(part, "=="):
+		kv := strings.SplitN(part, "==", 2)
+		requirement.Key, requirement.Operator, requirement.Value = kv[0], Equals, kv[1]
+	case strings.Contains(part, "="):
+		kv := strings.SplitN(part, "=", 2)
+		requirement.Key, requirement.Operator, requirement.Value = kv[0], Equals, kv[1]
+	case strings.HasPrefix(part, "!"):
+		requirement.Key, requirement.Operator = strings.TrimPrefix(part, "!"), NotExists
+	default:
+		requirement.Key, requirement.Operator = part, Exists
+	}
+	requirement.Key = strings.TrimSpace(requirement.Key)
+	requirement.Value = strings.TrimSpace(requirement.Value)
+	if !keyRegexp.MatchString(requirement.Key) {
+		return nil, fmt.Errorf("%w: %s", errInvalidRequirement, part)
+	}
+	if !valueRegexp.MatchString(requirement.Value) {
+		return nil, fmt.Errorf("%w: %s", errInvalidRequirement, part)
+	}
+	return requirement, nil
+}
+
+func (s Selector) Matches(labels map[string]string) bool {
+	for _, requirement := range s {
+		value, ok := labels[requirement.Key]
+		switch requirement.Operator {
+		case Equals:
+			if !ok || value != requirement.Value {
+				return false
+			}
+		case NotEquals:
+			if ok && value == requirement.Value {
+				return false
+			}
+		case Exists:
+			if !ok {
+				return false
+			}
+		case NotExists:
+			if ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (s Selector) String() string {
+	parts := make([]string, len(s))
+	for i, requirement := range s {
+		switch requirement.Operator {
+		case Exists:
+			parts[i] = requirement.Key
+		case NotExists:
+			parts[i] = "!" + requirement.Key
+		default:
+			parts[i] = requirement.Key + string(requirement.Operator) + requirement.Value
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// Validate labels before they are stored
+func Validate(labels map[string]string) error {
+	for key, value := range labels {
+		if !keyRegexp.MatchString(key) {
+			return fmt.Errorf("%w: %s", errInvalidKey, key)
+		}
+		if !valueRegexp.MatchString(value) {
+			return fmt.Errorf("%w: %s", errInvalidValue, value)
+		}
+	}
+	return nil
+}
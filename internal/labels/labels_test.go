@@ -0,0 +1,80 @@
+package labels
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("Should: parse all operators", func(t *testing.T) {
+		s, err := Parse("team=payments, env!=prod,tier==1,critical,!deprecated")
+		assert.Nil(t, err)
+		assert.Equal(t, Selector{
+			{Key: "team", Operator: Equals, Value: "payments"},
+			{Key: "env", Operator: NotEquals, Value: "prod"},
+			{Key: "tier", Operator: Equals, Value: "1"},
+			{Key: "critical", Operator: Exists},
+			{Key: "deprecated", Operator: NotExists},
+		}, s)
+	})
+	t.Run("Should: return empty selector", func(t *testing.T) {
+		s, err := Parse("")
+		assert.Nil(t, err)
+		assert.Len(t, s, 0)
+	})
+	t.Run("Should: return error because wrong key", func(t *testing.T) {
+		_, err := Parse("team.name=payments")
+		assert.ErrorIs(t, err, errInvalidRequirement)
+		_, err = Parse("=payments")
+		assert.ErrorIs(t, err, errInvalidRequirement)
+	})
+	t.Run("Should: return error because wrong value", func(t *testing.T) {
+		_, err := Parse("team=pay ments")
+		assert.ErrorIs(t, err, errInvalidRequirement)
+	})
+}
+
+func TestSelector_Matches(t *testing.T) {
+	labels := map[string]string{"team": "payments", "env": "prod"}
+	tt := map[string]bool{
+		"":                         true,
+		"team=payments":            true,
+		"team=payments,env=prod":   true,
+		"team=payments,env=stage":  false,
+		"team!=search":             true,
+		"team!=payments":           false,
+		"owner!=payments":          true,
+		"env":                      true,
+		"owner":                    false,
+		"!owner":                   true,
+		"!env":                     false,
+		"team=payments,!service":   true,
+		"team=payments,service=db": false,
+	}
+	for selector, expected := range tt {
+		t.Run("Should: match "+selector, func(t *testing.T) {
+			s, err := Parse(selector)
+			assert.Nil(t, err)
+			assert.Equal(t, expected, s.Matches(labels))
+		})
+	}
+}
+
+func TestSelector_String(t *testing.T) {
+	t.Run("Should: return same selector", func(t *testing.T) {
+		s, _ := Parse("team=payments,env!=prod,critical,!deprecated")
+		assert.Equal(t, "team=payments,env!=prod,critical,!deprecated", s.String())
+	})
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		assert.Nil(t, Validate(map[string]string{"team": "payments", "app/name": "api-v1.2", "empty": ""}))
+	})
+	t.Run("Should: return error because wrong key", func(t *testing.T) {
+		assert.ErrorIs(t, Validate(map[string]string{"$team": "payments"}), errInvalidKey)
+	})
+	t.Run("Should: return error because wrong value", func(t *testing.T) {
+		assert.ErrorIs(t, Validate(map[string]string{"team": "pay/ments"}), errInvalidValue)
+	})
+}
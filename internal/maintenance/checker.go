@@ -0,0 +1,67 @@
+package maintenance
+
+import (
+	"context"
+	"github.com/squzy/squzy/internal/logger"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"sync"
+	"time"
+)
+
+type Checker interface {
+	// Return mode of active window and true if scheduler is in maintenance now,
+	// SKIP has priority over MARK when several windows are active
+	Check(config *scheduler_config_storage.SchedulerConfig) (apiPb.MaintenanceMode, bool)
+}
+
+type checker struct {
+	storage         Storage
+	refreshInterval time.Duration
+	mutex           sync.Mutex
+	windows         []*Window
+	loadedAt        time.Time
+	nowFn           func() time.Time
+}
+
+// NewChecker create checker which keep windows in memory and reload them not often than refreshInterval
+func NewChecker(storage Storage, refreshInterval time.Duration) Checker {
+	return &checker{
+		storage:         storage,
+		refreshInterval: refreshInterval,
+		nowFn:           time.Now,
+	}
+}
+
+func (c *checker) Check(config *scheduler_config_storage.SchedulerConfig) (apiPb.MaintenanceMode, bool) {
+	now := c.nowFn()
+	active := false
+	mode := apiPb.MaintenanceMode_MAINTENANCE_MODE_MARK
+	for _, window := range c.getWindows(now) {
+		if !window.Matches(config) || !window.IsActive(now) {
+			continue
+		}
+		active = true
+		if window.Mode == apiPb.MaintenanceMode_MAINTENANCE_MODE_SKIP {
+			mode = apiPb.MaintenanceMode_MAINTENANCE_MODE_SKIP
+		}
+	}
+	return mode, active
+}
+
+func (c *checker) getWindows(now time.Time) []*Window {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if !c.loadedAt.IsZero() && now.Sub(c.loadedAt) < c.refreshInterval {
+		return c.windows
+	}
+	windows, err := c.storage.GetAll(context.Background())
+	if err != nil {
+		// Keep previous windows, DB could be unavailable for a moment
+		logger.Errorf("Could not load maintenance windows: %s", err.Error())
+		return c.windows
+	}
+	c.windows = windows
+	c.loadedAt = now
+	return c.windows
+}
@@ -0,0 +1,106 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"testing"
+	"time"
+)
+
+type storageMock struct {
+	windows []*Window
+	err     error
+	calls   int
+}
+
+func (s *storageMock) Add(ctx context.Context, window *Window) error {
+	panic("implement me")
+}
+
+func (s *storageMock) Remove(ctx context.Context, id primitive.ObjectID) error {
+	panic("implement me")
+}
+
+func (s *storageMock) GetAll(ctx context.Context) ([]*Window, error) {
+	s.calls++
+	return s.windows, s.err
+}
+
+func newWindow(selector string, mode apiPb.MaintenanceMode) *Window {
+	w := &Window{Selector: selector, Start: start, End: end, Mode: mode}
+	_ = w.init()
+	return w
+}
+
+func TestNewChecker(t *testing.T) {
+	t.Run("Should: implement interface", func(t *testing.T) {
+		assert.Implements(t, (*Checker)(nil), NewChecker(nil, time.Second))
+	})
+}
+
+func TestChecker_Check(t *testing.T) {
+	config := &scheduler_config_storage.SchedulerConfig{
+		ID:     primitive.NewObjectID(),
+		Labels: map[string]string{"team": "payments"},
+	}
+	t.Run("Should: return not active", func(t *testing.T) {
+		c := NewChecker(&storageMock{windows: []*Window{
+			newWindow("team=search", apiPb.MaintenanceMode_MAINTENANCE_MODE_SKIP),
+		}}, time.Second).(*checker)
+		c.nowFn = func() time.Time { return start }
+		_, active := c.Check(config)
+		assert.False(t, active)
+		c.nowFn = func() time.Time { return end }
+		_, active = c.Check(&scheduler_config_storage.SchedulerConfig{Labels: map[string]string{"team": "search"}})
+		assert.False(t, active)
+	})
+	t.Run("Should: return skip mode with priority", func(t *testing.T) {
+		c := NewChecker(&storageMock{windows: []*Window{
+			newWindow("team", apiPb.MaintenanceMode_MAINTENANCE_MODE_UNSPECIFIED),
+			newWindow("team=payments", apiPb.MaintenanceMode_MAINTENANCE_MODE_SKIP),
+		}}, time.Second).(*checker)
+		c.nowFn = func() time.Time { return start }
+		mode, active := c.Check(config)
+		assert.True(t, active)
+		assert.Equal(t, apiPb.MaintenanceMode_MAINTENANCE_MODE_SKIP, mode)
+	})
+	t.Run("Should: return mark mode", func(t *testing.T) {
+		c := NewChecker(&storageMock{windows: []*Window{
+			newWindow("team", apiPb.MaintenanceMode_MAINTENANCE_MODE_UNSPECIFIED),
+		}}, time.Second).(*checker)
+		c.nowFn = func() time.Time { return start }
+		mode, active := c.Check(config)
+		assert.True(t, active)
+		assert.Equal(t, apiPb.MaintenanceMode_MAINTENANCE_MODE_MARK, mode)
+	})
+	t.Run("Should: reload windows after refresh interval", func(t *testing.T) {
+		storage := &storageMock{}
+		c := NewChecker(storage, time.Minute).(*checker)
+		c.nowFn = func() time.Time { return start }
+		_, active := c.Check(config)
+		assert.False(t, active)
+		storage.windows = []*Window{newWindow("team", apiPb.MaintenanceMode_MAINTENANCE_MODE_MARK)}
+		_, active = c.Check(config)
+		assert.False(t, active)
+		assert.Equal(t, 1, storage.calls)
+		c.nowFn = func() time.Time { return start.Add(time.Minute) }
+		_, active = c.Check(config)
+		assert.True(t, active)
+		assert.Equal(t, 2, storage.calls)
+	})
+	t.Run("Should: keep previous windows on error", func(t *testing.T) {
+		storage := &storageMock{windows: []*Window{newWindow("team", apiPb.MaintenanceMode_MAINTENANCE_MODE_MARK)}}
+		c := NewChecker(storage, time.Minute).(*checker)
+		c.nowFn = func() time.Time { return start }
+		_, active := c.Check(config)
+		assert.True(t, active)
+		storage.err = errors.New("")
+		c.nowFn = func() time.Time { return start.Add(time.Minute) }
+		_, active = c.Check(config)
+		assert.True(t, active)
+	})
+}
@@ -0,0 +1,56 @@
+package maintenance
+
+import (
+	"context"
+	"github.com/squzy/mongo_helper"
+	"github.com/squzy/squzy/internal/logger"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Storage interface {
+	Add(ctx context.Context, window *Window) error
+	Remove(ctx context.Context, id primitive.ObjectID) error
+	// Windows with broken config are skipped
+	GetAll(ctx context.Context) ([]*Window, error)
+}
+
+type storage struct {
+	connector mongo_helper.Connector
+}
+
+func (s *storage) Add(ctx context.Context, window *Window) error {
+	_, err := s.connector.InsertOne(ctx, window)
+	return err
+}
+
+func (s *storage) Remove(ctx context.Context, id primitive.ObjectID) error {
+	_, err := s.connector.Delete(ctx, bson.M{
+		"_id": id,
+	})
+	return err
+}
+
+func (s *storage) GetAll(ctx context.Context) ([]*Window, error) {
+	windows := []*Window{}
+	err := s.connector.FindAll(ctx, bson.M{}, &windows)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*Window, 0, len(windows))
+	for _, window := range windows {
+		err := window.init()
+		if err != nil {
+			logger.Errorf("Maintenance window %s skipped: %s", window.ID.Hex(), err.Error())
+			continue
+		}
+		res = append(res, window)
+	}
+	return res, nil
+}
+
+func New(connector mongo_helper.Connector) Storage {
+	return &storage{
+		connector: connector,
+	}
+}
@@ -0,0 +1,110 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"testing"
+)
+
+var (
+	basicError = errors.New("")
+)
+
+type mockOk struct {
+	windows []*Window
+}
+
+func (m mockOk) Delete(ctx context.Context, filter interface{}, opts ...*options.DeleteOptions) (*mongo.DeleteResult, error) {
+	return nil, nil
+}
+
+func (m mockOk) InsertOne(ctx context.Context, document interface{}, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
+	return nil, nil
+}
+
+func (m mockOk) FindOne(ctx context.Context, filter interface{}, structToDeserialize interface{}, opts ...*options.FindOneOptions) error {
+	panic("implement me")
+}
+
+func (m mockOk) FindAll(ctx context.Context, predicate bson.M, structToDeserialize interface{}, opts ...*options.FindOptions) error {
+	*structToDeserialize.(*[]*Window) = m.windows
+	return nil
+}
+
+func (m mockOk) UpdateOne(ctx context.Context, filter interface{}, update interface{}, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error) {
+	panic("implement me")
+}
+
+type mockError struct {
+}
+
+func (m mockError) Delete(ctx context.Context, filter interface{}, opts ...*options.DeleteOptions) (*mongo.DeleteResult, error) {
+	return nil, basicError
+}
+
+func (m mockError) InsertOne(ctx context.Context, document interface{}, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
+	return nil, basicError
+}
+
+func (m mockError) FindOne(ctx context.Context, filter interface{}, structToDeserialize interface{}, opts ...*options.FindOneOptions) error {
+	return basicError
+}
+
+func (m mockError) FindAll(ctx context.Context, predicate bson.M, structToDeserialize interface{}, opts ...*options.FindOptions) error {
+	return basicError
+}
+
+func (m mockError) UpdateOne(ctx context.Context, filter interface{}, update interface{}, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error) {
+	return nil, basicError
+}
+
+func TestNew(t *testing.T) {
+	t.Run("Should: implement interface", func(t *testing.T) {
+		s := New(nil)
+		assert.Implements(t, (*Storage)(nil), s)
+	})
+}
+
+func TestStorage_Add(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(&mockOk{})
+		assert.Nil(t, s.Add(context.Background(), &Window{}))
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(&mockError{})
+		assert.NotNil(t, s.Add(context.Background(), &Window{}))
+	})
+}
+
+func TestStorage_Remove(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(&mockOk{})
+		assert.Nil(t, s.Remove(context.Background(), primitive.NewObjectID()))
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(&mockError{})
+		assert.NotNil(t, s.Remove(context.Background(), primitive.NewObjectID()))
+	})
+}
+
+func TestStorage_GetAll(t *testing.T) {
+	t.Run("Should: return only valid windows", func(t *testing.T) {
+		valid := &Window{ID: primitive.NewObjectID(), Selector: "team", Cron: "0 3 * * *", Duration: 10}
+		broken := &Window{ID: primitive.NewObjectID(), Selector: "team"}
+		s := New(&mockOk{windows: []*Window{valid, broken}})
+		res, err := s.GetAll(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []*Window{valid}, res)
+		assert.NotNil(t, res[0].schedule)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(&mockError{})
+		_, err := s.GetAll(context.Background())
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,158 @@
+package maintenance
+
+import (
+	"errors"
+	"fmt"
+	"github.com/robfig/cron/v3"
+	"github.com/squzy/squzy/internal/labels"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+var (
+	errEmptyWindow         = errors.New("EMPTY_MAINTENANCE_WINDOW")
+	errEmptyTarget         = errors.New("MAINTENANCE_WINDOW_WITHOUT_SCHEDULERS")
+	errInvalidRange        = errors.New("INVALID_MAINTENANCE_WINDOW_RANGE")
+	errOneOffAndRecurring  = errors.New("MAINTENANCE_WINDOW_ONE_OFF_AND_RECURRING")
+	errInvalidCron         = errors.New("INVALID_CRON_EXPRESSION")
+	errInvalidSchedulerID  = errors.New("INVALID_SCHEDULER_ID")
+	errInvalidWindowLength = errors.New("INVALID_MAINTENANCE_WINDOW_DURATION")
+)
+
+var (
+	// Same format as scheduler cron expression
+	cronParser = cron.NewParser(
+		cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
+	)
+)
+
+// Window is one-off (Start - End) or recurring (Cron + Duration) time range
+// attached to list of schedulers and/or schedulers matched by label selector
+type Window struct {
+	ID           primitive.ObjectID    `bson:"_id"`
+	Name         string                `bson:"name,omitempty"`
+	SchedulerIDs []primitive.ObjectID  `bson:"schedulerIds,omitempty"`
+	Selector     string                `bson:"selector,omitempty"`
+	Start        time.Time             `bson:"start"`
+	End          time.Time             `bson:"end"`
+	Cron         string                `bson:"cron,omitempty"`
+	Duration     int32                 `bson:"duration,omitempty"`
+	Mode         apiPb.MaintenanceMode `bson:"mode"`
+	schedule     cron.Schedule
+	selector     labels.Selector
+}
+
+// FromProto validate window and create new one with fresh id
+func FromProto(rq *apiPb.MaintenanceWindow) (*Window, error) {
+	if rq == nil {
+		return nil, errEmptyWindow
+	}
+	window := &Window{
+		ID:       primitive.NewObjectID(),
+		Name:     rq.GetName(),
+		Selector: rq.GetSelector(),
+		Cron:     rq.GetCron(),
+		Duration: rq.GetDuration(),
+		Mode:     rq.GetMode(),
+	}
+	for _, id := range rq.GetSchedulerIds() {
+		schedulerID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errInvalidSchedulerID, id)
+		}
+		window.SchedulerIDs = append(window.SchedulerIDs, schedulerID)
+	}
+	if rq.GetStart() != nil {
+		window.Start = rq.GetStart().AsTime()
+	}
+	if rq.GetEnd() != nil {
+		window.End = rq.GetEnd().AsTime()
+	}
+	err := window.init()
+	if err != nil {
+		return nil, err
+	}
+	return window, nil
+}
+
+// Parse selector and cron, should be called after window loaded from DB
+func (w *Window) init() error {
+	if len(w.SchedulerIDs) == 0 && w.Selector == "" {
+		return errEmptyTarget
+	}
+	selector, err := labels.Parse(w.Selector)
+	if err != nil {
+		return err
+	}
+	w.selector = selector
+	oneOff := !w.Start.IsZero() || !w.End.IsZero()
+	if oneOff && w.Cron != "" {
+		return errOneOffAndRecurring
+	}
+	if oneOff {
+		if !w.End.After(w.Start) {
+			return errInvalidRange
+		}
+		return nil
+	}
+	if w.Cron == "" {
+		return errInvalidRange
+	}
+	if w.Duration <= 0 {
+		return errInvalidWindowLength
+	}
+	schedule, err := cronParser.Parse(w.Cron)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errInvalidCron, err.Error())
+	}
+	w.schedule = schedule
+	return nil
+}
+
+// IsActive return true if now is inside window, cron without CRON_TZ is evaluated in UTC
+func (w *Window) IsActive(now time.Time) bool {
+	if w.schedule == nil {
+		return !now.Before(w.Start) && now.Before(w.End)
+	}
+	length := time.Duration(w.Duration) * time.Second
+	// First start after (now - length) should be already passed
+	start := w.schedule.Next(now.In(time.UTC).Add(-length))
+	return !start.After(now)
+}
+
+// Matches return true if scheduler is in list or matched by selector
+func (w *Window) Matches(config *scheduler_config_storage.SchedulerConfig) bool {
+	for _, id := range w.SchedulerIDs {
+		if id == config.ID {
+			return true
+		}
+	}
+	if w.Selector == "" {
+		return false
+	}
+	return w.selector.Matches(config.Labels)
+}
+
+func (w *Window) ToProto() *apiPb.MaintenanceWindow {
+	res := &apiPb.MaintenanceWindow{
+		Id:       w.ID.Hex(),
+		Name:     w.Name,
+		Selector: w.Selector,
+		Cron:     w.Cron,
+		Duration: w.Duration,
+		Mode:     w.Mode,
+	}
+	for _, id := range w.SchedulerIDs {
+		res.SchedulerIds = append(res.SchedulerIds, id.Hex())
+	}
+	if !w.Start.IsZero() {
+		res.Start = timestamppb.New(w.Start)
+	}
+	if !w.End.IsZero() {
+		res.End = timestamppb.New(w.End)
+	}
+	return res
+}
@@ -0,0 +1,149 @@
+package maintenance
+
+import (
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+var (
+	start = time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC)
+	end   = start.Add(time.Hour)
+)
+
+func TestFromProto(t *testing.T) {
+	id := primitive.NewObjectID()
+	t.Run("Should: create one-off window", func(t *testing.T) {
+		w, err := FromProto(&apiPb.MaintenanceWindow{
+			Name:         "deploy",
+			SchedulerIds: []string{id.Hex()},
+			Start:        timestamppb.New(start),
+			End:          timestamppb.New(end),
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []primitive.ObjectID{id}, w.SchedulerIDs)
+		assert.Equal(t, start, w.Start)
+		assert.Equal(t, end, w.End)
+	})
+	t.Run("Should: create recurring window", func(t *testing.T) {
+		w, err := FromProto(&apiPb.MaintenanceWindow{
+			Selector: "team=payments",
+			Cron:     "0 3 * * *",
+			Duration: 1800,
+			Mode:     apiPb.MaintenanceMode_MAINTENANCE_MODE_SKIP,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, "0 3 * * *", w.Cron)
+		assert.Equal(t, apiPb.MaintenanceMode_MAINTENANCE_MODE_SKIP, w.Mode)
+	})
+	t.Run("Should: return to proto same window", func(t *testing.T) {
+		rq := &apiPb.MaintenanceWindow{
+			Name:         "deploy",
+			SchedulerIds: []string{id.Hex()},
+			Selector:     "team=payments",
+			Start:        timestamppb.New(start),
+			End:          timestamppb.New(end),
+		}
+		w, err := FromProto(rq)
+		assert.Nil(t, err)
+		rq.Id = w.ID.Hex()
+		assert.Equal(t, rq.String(), w.ToProto().String())
+	})
+	tt := map[string]struct {
+		window *apiPb.MaintenanceWindow
+		err    error
+	}{
+		"empty": {nil, errEmptyWindow},
+		"without schedulers": {
+			&apiPb.MaintenanceWindow{Start: timestamppb.New(start), End: timestamppb.New(end)},
+			errEmptyTarget,
+		},
+		"wrong scheduler id": {
+			&apiPb.MaintenanceWindow{SchedulerIds: []string{"wrong"}, Start: timestamppb.New(start), End: timestamppb.New(end)},
+			errInvalidSchedulerID,
+		},
+		"end before start": {
+			&apiPb.MaintenanceWindow{Selector: "team", Start: timestamppb.New(end), End: timestamppb.New(start)},
+			errInvalidRange,
+		},
+		"without range": {
+			&apiPb.MaintenanceWindow{Selector: "team"},
+			errInvalidRange,
+		},
+		"one-off and recurring": {
+			&apiPb.MaintenanceWindow{Selector: "team", Start: timestamppb.New(start), End: timestamppb.New(end), Cron: "0 3 * * *"},
+			errOneOffAndRecurring,
+		},
+		"without duration": {
+			&apiPb.MaintenanceWindow{Selector: "team", Cron: "0 3 * * *"},
+			errInvalidWindowLength,
+		},
+		"wrong cron": {
+			&apiPb.MaintenanceWindow{Selector: "team", Cron: "wrong", Duration: 10},
+			errInvalidCron,
+		},
+	}
+	for name, test := range tt {
+		t.Run("Should: return error because "+name, func(t *testing.T) {
+			_, err := FromProto(test.window)
+			assert.ErrorIs(t, err, test.err)
+		})
+	}
+	t.Run("Should: return error because wrong selector", func(t *testing.T) {
+		_, err := FromProto(&apiPb.MaintenanceWindow{Selector: "team=pay ments", Cron: "0 3 * * *", Duration: 10})
+		assert.NotNil(t, err)
+	})
+}
+
+func TestWindow_IsActive(t *testing.T) {
+	t.Run("Should: check one-off window", func(t *testing.T) {
+		w, _ := FromProto(&apiPb.MaintenanceWindow{Selector: "team", Start: timestamppb.New(start), End: timestamppb.New(end)})
+		assert.False(t, w.IsActive(start.Add(-time.Second)))
+		assert.True(t, w.IsActive(start))
+		assert.True(t, w.IsActive(end.Add(-time.Second)))
+		assert.False(t, w.IsActive(end))
+	})
+	t.Run("Should: check recurring window", func(t *testing.T) {
+		w, _ := FromProto(&apiPb.MaintenanceWindow{Selector: "team", Cron: "CRON_TZ=UTC 0 10 * * *", Duration: 3600})
+		assert.False(t, w.IsActive(start.Add(-time.Second)))
+		assert.True(t, w.IsActive(start))
+		assert.True(t, w.IsActive(start.Add(time.Hour*24+time.Minute*30)))
+		assert.False(t, w.IsActive(end))
+		assert.False(t, w.IsActive(start.Add(time.Hour*12)))
+	})
+	t.Run("Should: evaluate cron in UTC whatever local time zone is", func(t *testing.T) {
+		local := time.Local
+		time.Local = time.FixedZone("UTC+3", 3*60*60)
+		defer func() {
+			time.Local = local
+		}()
+		w, _ := FromProto(&apiPb.MaintenanceWindow{Selector: "team", Cron: "0 10 * * *", Duration: 3600})
+		assert.True(t, w.IsActive(start.In(time.Local)))
+		assert.False(t, w.IsActive(start.Add(-time.Hour*3).In(time.Local)))
+		tokyo, _ := FromProto(&apiPb.MaintenanceWindow{Selector: "team", Cron: "CRON_TZ=Asia/Tokyo 0 19 * * *", Duration: 3600})
+		assert.True(t, tokyo.IsActive(start.In(time.Local)))
+	})
+}
+
+func TestWindow_Matches(t *testing.T) {
+	id := primitive.NewObjectID()
+	config := &scheduler_config_storage.SchedulerConfig{
+		ID:     id,
+		Labels: map[string]string{"team": "payments"},
+	}
+	t.Run("Should: match by id", func(t *testing.T) {
+		w, _ := FromProto(&apiPb.MaintenanceWindow{SchedulerIds: []string{id.Hex()}, Start: timestamppb.New(start), End: timestamppb.New(end)})
+		assert.True(t, w.Matches(config))
+		assert.False(t, w.Matches(&scheduler_config_storage.SchedulerConfig{ID: primitive.NewObjectID()}))
+	})
+	t.Run("Should: match by selector", func(t *testing.T) {
+		w, _ := FromProto(&apiPb.MaintenanceWindow{Selector: "team=payments", Start: timestamppb.New(start), End: timestamppb.New(end)})
+		assert.True(t, w.Matches(config))
+		w, _ = FromProto(&apiPb.MaintenanceWindow{Selector: "team=search", Start: timestamppb.New(start), End: timestamppb.New(end)})
+		assert.False(t, w.Matches(config))
+	})
+}
@@ -0,0 +1,240 @@
+package probe
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/squzy/squzy/internal/helpers"
+	"github.com/squzy/squzy/internal/job"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"go.mongodb.org/mongo-driver/bson"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"sync"
+	"time"
+)
+
+const (
+	// Probe needs time to receive task and send result back
+	resultGrace = time.Second * 5
+	// Tasks waiting for slow probe, new tasks are not sent while it is full
+	taskBuffer = 100
+)
+
+var (
+	errUnknownTask     = errors.New("UNKNOWN_PROBE_TASK")
+	errUnknownLocation = errors.New("UNKNOWN_PROBE_LOCATION")
+
+	errNoProbe     = errors.New("NO_PROBE_IN_LOCATION")
+	errProbeIsBusy = errors.New("PROBE_IS_BUSY")
+	errNoResult    = errors.New("NO_RESULT_FROM_PROBE")
+)
+
+// Hub sends checks to probes connected to squzy monitoring and waits for their results
+type Hub interface {
+	// Send check to one probe of every location and aggregate results,
+	// location without result until timeout is counted as failed
+	Execute(config *scheduler_config_storage.SchedulerConfig) job.CheckError
+	// Return tasks for probe of location, cancel should be called when probe disconnects
+	Subscribe(location string) (<-chan *apiPb.ProbeTask, func())
+	Report(rq *apiPb.ProbeResultRequest) error
+	// Check token of probe call, see WithToken
+	Authorize(ctx context.Context) error
+}
+
+type subscriber struct {
+	tasks chan *apiPb.ProbeTask
+}
+
+type round struct {
+	locations map[string]bool
+	results   chan *apiPb.ProbeResultRequest
+}
+
+type hub struct {
+	mutex       sync.Mutex
+	subscribers map[string][]*subscriber
+	// Next subscriber of location, so probes of same location share checks
+	next   map[string]int
+	rounds map[string]*round
+	nowFn  func() *timestamp.Timestamp
+	// Extra time to wait after check timeout
+	grace time.Duration
+	// Shared with probes
+	token string
+}
+
+func New(token string) Hub {
+	return &hub{
+		token:       token,
+		subscribers: map[string][]*subscriber{},
+		next:        map[string]int{},
+		rounds:      map[string]*round{},
+		nowFn:       timestamp.Now,
+		grace:       resultGrace,
+	}
+}
+
+func (h *hub) Subscribe(location string) (<-chan *apiPb.ProbeTask, func()) {
+	sub := &subscriber{
+		tasks: make(chan *apiPb.ProbeTask, taskBuffer),
+	}
+	h.mutex.Lock()
+	h.subscribers[location] = append(h.subscribers[location], sub)
+	h.mutex.Unlock()
+	return sub.tasks, func() {
+		h.mutex.Lock()
+		defer h.mutex.Unlock()
+		subs := h.subscribers[location]
+		for i, s := range subs {
+			if s == sub {
+				h.subscribers[location] = append(subs[:i:i], subs[i+1:]...)
+				break
+			}
+		}
+		if len(h.subscribers[location]) == 0 {
+			delete(h.subscribers, location)
+			delete(h.next, location)
+		}
+	}
+}
+
+func (h *hub) Report(rq *apiPb.ProbeResultRequest) error {
+	h.mutex.Lock()
+	r, ok := h.rounds[rq.GetTaskId()]
+	h.mutex.Unlock()
+	if !ok {
+		// Result came after timeout
+		return errUnknownTask
+	}
+	if !r.locations[rq.GetLocation()] {
+		return errUnknownLocation
+	}
+	select {
+	case r.results <- rq:
+	default:
+		// Round already has result of every location
+	}
+	return nil
+}
+
+func (h *hub) Execute(config *scheduler_config_storage.SchedulerConfig) job.CheckError {
+	startTime := h.nowFn()
+	encoded, err := bson.Marshal(config)
+	if err != nil {
+		return newProbeError(config, startTime, h.nowFn(), nil, err)
+	}
+	id := uuid.New().String()
+	r := &round{
+		locations: map[string]bool{},
+		results:   make(chan *apiPb.ProbeResultRequest, len(config.Locations)),
+	}
+	for _, location := range config.Locations {
+		r.locations[location] = true
+	}
+	h.mutex.Lock()
+	h.rounds[id] = r
+	h.mutex.Unlock()
+	defer func() {
+		h.mutex.Lock()
+		delete(h.rounds, id)
+		h.mutex.Unlock()
+	}()
+
+	results := map[string]*apiPb.LocationResult{}
+	task := &apiPb.ProbeTask{
+		Id:          id,
+		SchedulerId: config.ID.Hex(),
+		Config:      encoded,
+	}
+	for location := range r.locations {
+		err := h.send(location, task)
+		if err != nil {
+			results[location] = failedLocation(location, err)
+		}
+	}
+
+	var value *apiPb.SchedulerSnapshot
+	timer := time.NewTimer(h.waitFor(config))
+	defer timer.Stop()
+	for len(results) < len(r.locations) {
+		select {
+		case rq := <-r.results:
+			if _, ok := results[rq.GetLocation()]; ok {
+				continue
+			}
+			results[rq.GetLocation()] = toLocationResult(rq)
+			if value == nil && isPassed(rq.GetSnapshot().GetCode()) {
+				value = rq.GetSnapshot()
+			}
+		case <-timer.C:
+			for location := range r.locations {
+				if _, ok := results[location]; !ok {
+					results[location] = failedLocation(location, errNoResult)
+				}
+			}
+		}
+	}
+	return newProbeError(config, startTime, h.nowFn(), value, nil, sortedResults(config.Locations, results)...)
+}
+
+// Task goes to next probe of location, so load is spread between them
+func (h *hub) send(location string, task *apiPb.ProbeTask) error {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	subs := h.subscribers[location]
+	if len(subs) == 0 {
+		return errNoProbe
+	}
+	index := h.next[location] % len(subs)
+	h.next[location] = index + 1
+	select {
+	case subs[index].tasks <- task:
+		return nil
+	default:
+		return errProbeIsBusy
+	}
+}
+
+// Probe executes check with retry policy, so every attempt could take whole timeout after its backoff
+func (h *hub) waitFor(config *scheduler_config_storage.SchedulerConfig) time.Duration {
+	return config.RetryPolicy.MaxDuration(helpers.DurationNotNegative(config.Timeout)) + h.grace
+}
+
+func isPassed(code apiPb.SchedulerCode) bool {
+	return code == apiPb.SchedulerCode_OK || code == apiPb.SchedulerCode_WARNING
+}
+
+func failedLocation(location string, err error) *apiPb.LocationResult {
+	return &apiPb.LocationResult{
+		Location: location,
+		Code:     apiPb.SchedulerCode_ERROR,
+		Error:    err.Error(),
+	}
+}
+
+func toLocationResult(rq *apiPb.ProbeResultRequest) *apiPb.LocationResult {
+	code := rq.GetSnapshot().GetCode()
+	if code == apiPb.SchedulerCode_SCHEDULER_CODE_UNSPECIFIED {
+		code = apiPb.SchedulerCode_ERROR
+	}
+	return &apiPb.LocationResult{
+		Location: rq.GetLocation(),
+		Code:     code,
+		Error:    rq.GetSnapshot().GetError().GetMessage(),
+	}
+}
+
+// Keep order of locations from config
+func sortedResults(locations []string, results map[string]*apiPb.LocationResult) []*apiPb.LocationResult {
+	res := []*apiPb.LocationResult{}
+	seen := map[string]bool{}
+	for _, location := range locations {
+		if seen[location] {
+			continue
+		}
+		seen[location] = true
+		res = append(res, results[location])
+	}
+	return res
+}
@@ -0,0 +1,185 @@
+package probe
+
+import (
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"testing"
+	"time"
+)
+
+func newConfig(locations ...string) *scheduler_config_storage.SchedulerConfig {
+	return &scheduler_config_storage.SchedulerConfig{
+		ID:        primitive.NewObjectID(),
+		Type:      apiPb.SchedulerType_TCP,
+		Timeout:   1,
+		Locations: locations,
+		TCPConfig: &scheduler_config_storage.TCPConfig{
+			Host: "localhost",
+			Port: 80,
+		},
+	}
+}
+
+// Probe answers every task with code
+func runProbe(h Hub, location string, code apiPb.SchedulerCode) func() {
+	tasks, cancel := h.Subscribe(location)
+	go func() {
+		for task := range tasks {
+			_ = h.Report(&apiPb.ProbeResultRequest{
+				TaskId:   task.Id,
+				Location: location,
+				Snapshot: &apiPb.SchedulerSnapshot{
+					Code:  code,
+					Error: &apiPb.SchedulerSnapshot_Error{Message: "CONNECTION_TIMEOUT"},
+				},
+			})
+		}
+	}()
+	return cancel
+}
+
+func TestNew(t *testing.T) {
+	t.Run("Should: implement interface", func(t *testing.T) {
+		assert.Implements(t, (*Hub)(nil), New("token"))
+	})
+}
+
+func TestHub_Execute(t *testing.T) {
+	t.Run("Should: return ok when every location succeeded", func(t *testing.T) {
+		h := New("token")
+		defer runProbe(h, "eu", apiPb.SchedulerCode_OK)()
+		defer runProbe(h, "us", apiPb.SchedulerCode_OK)()
+		res := h.Execute(newConfig("eu", "us")).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_OK, res.Snapshot.Code)
+		assert.Equal(t, []*apiPb.LocationResult{
+			{Location: "eu", Code: apiPb.SchedulerCode_OK, Error: "CONNECTION_TIMEOUT"},
+			{Location: "us", Code: apiPb.SchedulerCode_OK, Error: "CONNECTION_TIMEOUT"},
+		}, res.Snapshot.Meta.Locations)
+	})
+	t.Run("Should: return error when one location failed", func(t *testing.T) {
+		h := New("token")
+		defer runProbe(h, "eu", apiPb.SchedulerCode_OK)()
+		defer runProbe(h, "us", apiPb.SchedulerCode_ERROR)()
+		res := h.Execute(newConfig("eu", "us")).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res.Snapshot.Code)
+		assert.Equal(t, "us: CONNECTION_TIMEOUT", res.Snapshot.Error.Message)
+	})
+	t.Run("Should: return ok when less than min locations failed", func(t *testing.T) {
+		h := New("token")
+		defer runProbe(h, "eu", apiPb.SchedulerCode_OK)()
+		defer runProbe(h, "us", apiPb.SchedulerCode_ERROR)()
+		config := newConfig("eu", "us")
+		config.MinFailedLocations = 2
+		res := h.Execute(config).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_OK, res.Snapshot.Code)
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res.Snapshot.Meta.Locations[1].Code)
+	})
+	t.Run("Should: count location without probe as failed", func(t *testing.T) {
+		h := New("token")
+		defer runProbe(h, "eu", apiPb.SchedulerCode_OK)()
+		res := h.Execute(newConfig("eu", "us")).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res.Snapshot.Code)
+		assert.Equal(t, "us: "+errNoProbe.Error(), res.Snapshot.Error.Message)
+	})
+	t.Run("Should: count location without result as failed", func(t *testing.T) {
+		h := New("token")
+		h.(*hub).grace = 0
+		_, cancel := h.Subscribe("eu")
+		defer cancel()
+		start := time.Now()
+		res := h.Execute(newConfig("eu")).GetLogData()
+		assert.True(t, time.Since(start) >= time.Second)
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res.Snapshot.Code)
+		assert.Equal(t, "eu: "+errNoResult.Error(), res.Snapshot.Error.Message)
+	})
+	t.Run("Should: send decodable config", func(t *testing.T) {
+		h := New("token")
+		tasks, cancel := h.Subscribe("eu")
+		defer cancel()
+		config := newConfig("eu")
+		go func() {
+			task := <-tasks
+			decoded := &scheduler_config_storage.SchedulerConfig{}
+			assert.Nil(t, bson.Unmarshal(task.Config, decoded))
+			assert.Equal(t, config, decoded)
+			assert.Equal(t, config.ID.Hex(), task.SchedulerId)
+			_ = h.Report(&apiPb.ProbeResultRequest{
+				TaskId:   task.Id,
+				Location: "eu",
+				Snapshot: &apiPb.SchedulerSnapshot{Code: apiPb.SchedulerCode_OK},
+			})
+		}()
+		res := h.Execute(config).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_OK, res.Snapshot.Code)
+	})
+}
+
+func TestHub_Subscribe(t *testing.T) {
+	t.Run("Should: spread tasks between probes of location", func(t *testing.T) {
+		h := New("token")
+		first, cancelFirst := h.Subscribe("eu")
+		defer cancelFirst()
+		second, cancelSecond := h.Subscribe("eu")
+		defer cancelSecond()
+		task := &apiPb.ProbeTask{Id: "id"}
+		assert.Nil(t, h.(*hub).send("eu", task))
+		assert.Nil(t, h.(*hub).send("eu", task))
+		assert.Len(t, first, 1)
+		assert.Len(t, second, 1)
+	})
+	t.Run("Should: not send tasks after cancel", func(t *testing.T) {
+		h := New("token")
+		_, cancel := h.Subscribe("eu")
+		cancel()
+		assert.Equal(t, errNoProbe, h.(*hub).send("eu", &apiPb.ProbeTask{}))
+	})
+	t.Run("Should: return error because probe is busy", func(t *testing.T) {
+		h := New("token")
+		_, cancel := h.Subscribe("eu")
+		defer cancel()
+		for i := 0; i < taskBuffer; i++ {
+			assert.Nil(t, h.(*hub).send("eu", &apiPb.ProbeTask{}))
+		}
+		assert.Equal(t, errProbeIsBusy, h.(*hub).send("eu", &apiPb.ProbeTask{}))
+	})
+}
+
+func TestHub_Report(t *testing.T) {
+	t.Run("Should: return error because task is unknown", func(t *testing.T) {
+		assert.Equal(t, errUnknownTask, New("token").Report(&apiPb.ProbeResultRequest{TaskId: "id"}))
+	})
+	t.Run("Should: return error because location is unknown", func(t *testing.T) {
+		h := New("token")
+		tasks, cancel := h.Subscribe("eu")
+		defer cancel()
+		go func() {
+			task := <-tasks
+			assert.Equal(t, errUnknownLocation, h.Report(&apiPb.ProbeResultRequest{TaskId: task.Id, Location: "us"}))
+			_ = h.Report(&apiPb.ProbeResultRequest{
+				TaskId:   task.Id,
+				Location: "eu",
+				Snapshot: &apiPb.SchedulerSnapshot{Code: apiPb.SchedulerCode_OK},
+			})
+		}()
+		assert.Equal(t, apiPb.SchedulerCode_OK, h.Execute(newConfig("eu")).GetLogData().Snapshot.Code)
+	})
+}
+
+func TestHub_waitFor(t *testing.T) {
+	t.Run("Should: wait for timeout with grace", func(t *testing.T) {
+		assert.Equal(t, time.Second+resultGrace, New("token").(*hub).waitFor(newConfig("eu")))
+	})
+	t.Run("Should: wait for every attempt with backoff", func(t *testing.T) {
+		config := newConfig("eu")
+		config.RetryPolicy = &scheduler_config_storage.RetryPolicy{
+			Attempts:          3,
+			Backoff:           1000,
+			BackoffMultiplier: 2,
+		}
+		// 3 timeouts, 1 and 2 seconds of backoff
+		assert.Equal(t, 6*time.Second+resultGrace, New("token").(*hub).waitFor(config))
+	})
+}
@@ -0,0 +1,103 @@
+package probe
+
+import (
+	"fmt"
+	"github.com/squzy/squzy/internal/job"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+)
+
+type probeError struct {
+	schedulerID   string
+	schedulerType apiPb.SchedulerType
+	startTime     *timestamp.Timestamp
+	endTime       *timestamp.Timestamp
+	code          apiPb.SchedulerCode
+	description   string
+	value         *apiPb.SchedulerSnapshot
+	locations     []*apiPb.LocationResult
+}
+
+func (p *probeError) GetLogData() *apiPb.SchedulerResponse {
+	var err *apiPb.SchedulerSnapshot_Error
+	if p.code != apiPb.SchedulerCode_OK {
+		err = &apiPb.SchedulerSnapshot_Error{
+			Message: p.description,
+		}
+	}
+	meta := &apiPb.SchedulerSnapshot_MetaData{
+		StartTime: p.startTime,
+		EndTime:   p.endTime,
+		Locations: p.locations,
+	}
+	if p.value != nil {
+		meta.Value = p.value.GetMeta().GetValue()
+	}
+	return &apiPb.SchedulerResponse{
+		SchedulerId: p.schedulerID,
+		Snapshot: &apiPb.SchedulerSnapshot{
+			Code:  p.code,
+			Error: err,
+			Type:  p.schedulerType,
+			Meta:  meta,
+		},
+	}
+}
+
+// Check fails only when it failed in MinFailedLocations locations, value is taken from first succeeded location.
+// Passed check is WARNING when any location has WARNING
+func newProbeError(
+	config *scheduler_config_storage.SchedulerConfig,
+	startTime *timestamp.Timestamp,
+	endTime *timestamp.Timestamp,
+	value *apiPb.SchedulerSnapshot,
+	err error,
+	locations ...*apiPb.LocationResult,
+) job.CheckError {
+	res := &probeError{
+		schedulerID:   config.ID.Hex(),
+		schedulerType: config.Type,
+		startTime:     startTime,
+		endTime:       endTime,
+		code:          apiPb.SchedulerCode_OK,
+		value:         value,
+		locations:     locations,
+	}
+	if err != nil {
+		res.code = apiPb.SchedulerCode_ERROR
+		res.description = err.Error()
+		return res
+	}
+	failed := []string{}
+	warnings := []string{}
+	for _, location := range locations {
+		switch location.GetCode() {
+		case apiPb.SchedulerCode_ERROR:
+			failed = append(failed, fmt.Sprintf("%s: %s", location.GetLocation(), location.GetError()))
+		case apiPb.SchedulerCode_WARNING:
+			warnings = append(warnings, fmt.Sprintf("%s: %s", location.GetLocation(), location.GetError()))
+		}
+	}
+	if len(failed) > 0 && int32(len(failed)) >= minFailed(config.MinFailedLocations, len(locations)) {
+		res.code = apiPb.SchedulerCode_ERROR
+		res.description = strings.Join(failed, "; ")
+		return res
+	}
+	if len(warnings) > 0 {
+		res.code = apiPb.SchedulerCode_WARNING
+		res.description = strings.Join(warnings, "; ")
+	}
+	return res
+}
+
+func minFailed(value int32, locations int) int32 {
+	if value <= 0 {
+		return 1
+	}
+	if value > int32(locations) {
+		return int32(locations)
+	}
+	return value
+}
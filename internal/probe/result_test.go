@@ -0,0 +1,63 @@
+package probe
+
+import (
+	"errors"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/structpb"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+)
+
+func TestNewProbeError(t *testing.T) {
+	config := &scheduler_config_storage.SchedulerConfig{
+		Type: apiPb.SchedulerType_HTTP_JSON_VALUE,
+	}
+	now := timestamp.Now()
+	t.Run("Should: return error without locations", func(t *testing.T) {
+		res := newProbeError(config, now, now, nil, errors.New("BROKEN")).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res.Snapshot.Code)
+		assert.Equal(t, "BROKEN", res.Snapshot.Error.Message)
+		assert.Equal(t, apiPb.SchedulerType_HTTP_JSON_VALUE, res.Snapshot.Type)
+	})
+	t.Run("Should: keep value of succeeded location", func(t *testing.T) {
+		value := &apiPb.SchedulerSnapshot{
+			Meta: &apiPb.SchedulerSnapshot_MetaData{Value: structpb.NewStringValue("v")},
+		}
+		res := newProbeError(config, now, now, value, nil,
+			&apiPb.LocationResult{Location: "eu", Code: apiPb.SchedulerCode_OK},
+		).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_OK, res.Snapshot.Code)
+		assert.Nil(t, res.Snapshot.Error)
+		assert.Equal(t, "v", res.Snapshot.Meta.Value.GetStringValue())
+	})
+	t.Run("Should: return warning of location", func(t *testing.T) {
+		res := newProbeError(config, now, now, nil, nil,
+			&apiPb.LocationResult{Location: "eu", Code: apiPb.SchedulerCode_OK},
+			&apiPb.LocationResult{Location: "us", Code: apiPb.SchedulerCode_WARNING, Error: "SLOW_RESPONSE"},
+		).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_WARNING, res.Snapshot.Code)
+		assert.Equal(t, "us: SLOW_RESPONSE", res.Snapshot.Error.Message)
+	})
+	t.Run("Should: return error instead of warning", func(t *testing.T) {
+		res := newProbeError(config, now, now, nil, nil,
+			&apiPb.LocationResult{Location: "eu", Code: apiPb.SchedulerCode_ERROR, Error: "CONNECTION_TIMEOUT"},
+			&apiPb.LocationResult{Location: "us", Code: apiPb.SchedulerCode_WARNING, Error: "SLOW_RESPONSE"},
+		).GetLogData()
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, res.Snapshot.Code)
+		assert.Equal(t, "eu: CONNECTION_TIMEOUT", res.Snapshot.Error.Message)
+	})
+}
+
+func TestMinFailed(t *testing.T) {
+	t.Run("Should: use one by default", func(t *testing.T) {
+		assert.Equal(t, int32(1), minFailed(0, 3))
+	})
+	t.Run("Should: not be more than locations", func(t *testing.T) {
+		assert.Equal(t, int32(3), minFailed(5, 3))
+	})
+	t.Run("Should: keep value", func(t *testing.T) {
+		assert.Equal(t, int32(2), minFailed(2, 3))
+	})
+}
@@ -0,0 +1,28 @@
+package probe
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"google.golang.org/grpc/metadata"
+)
+
+// TokenMetadataKey is metadata key of shared token which probe sends with every call to squzy monitoring
+const TokenMetadataKey = "x-squzy-probe-token"
+
+var errInvalidToken = errors.New("INVALID_PROBE_TOKEN")
+
+// WithToken adds token of probe to outgoing call
+func WithToken(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, TokenMetadataKey, token)
+}
+
+// Probes are rejected while token is not set, so checks are not sent to anybody who connects
+func (h *hub) Authorize(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(TokenMetadataKey)
+	if h.token == "" || len(values) != 1 || subtle.ConstantTimeCompare([]byte(values[0]), []byte(h.token)) != 1 {
+		return errInvalidToken
+	}
+	return nil
+}
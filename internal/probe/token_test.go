@@ -0,0 +1,32 @@
+package probe
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+	"testing"
+)
+
+// Token sent by probe as it is received by squzy monitoring
+func incomingContext(ctx context.Context) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestHub_Authorize(t *testing.T) {
+	t.Run("Should: accept probe with same token", func(t *testing.T) {
+		ctx := incomingContext(WithToken(context.Background(), "token"))
+		assert.Nil(t, New("token").Authorize(ctx))
+	})
+	t.Run("Should: return error because token is wrong", func(t *testing.T) {
+		ctx := incomingContext(WithToken(context.Background(), "other"))
+		assert.Equal(t, errInvalidToken, New("token").Authorize(ctx))
+	})
+	t.Run("Should: return error because token is missing", func(t *testing.T) {
+		assert.Equal(t, errInvalidToken, New("token").Authorize(context.Background()))
+	})
+	t.Run("Should: return error because token is not set", func(t *testing.T) {
+		ctx := incomingContext(WithToken(context.Background(), ""))
+		assert.Equal(t, errInvalidToken, New("").Authorize(ctx))
+	})
+}
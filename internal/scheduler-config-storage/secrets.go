@@ -0,0 +1,113 @@
+package scheduler_config_storage
+
+import "errors"
+
+// Shown instead of secrets, stored secret is kept when it comes back in update
+const RedactedSecret = "<redacted>"
+
+var errRedactedSecretNotFound = errors.New("REDACTED_SECRET_NOT_FOUND")
+
+// Redacted returns copy of config where not empty secrets are replaced by RedactedSecret
+func (c *SchedulerConfig) Redacted() *SchedulerConfig {
+	res := *c
+	if c.HTTPConfig != nil {
+		httpConfig := *c.HTTPConfig
+		httpConfig.Auth, httpConfig.TLS = copyAuth(httpConfig.Auth), copyTLS(httpConfig.TLS)
+		res.HTTPConfig = &httpConfig
+	}
+	if c.HTTPValueConfig != nil {
+		valueConfig := *c.HTTPValueConfig
+		valueConfig.Auth, valueConfig.TLS = copyAuth(valueConfig.Auth), copyTLS(valueConfig.TLS)
+		res.HTTPValueConfig = &valueConfig
+	}
+	if c.HTTPScenarioConfig != nil {
+		scenarioConfig := *c.HTTPScenarioConfig
+		scenarioConfig.Auth, scenarioConfig.TLS = copyAuth(scenarioConfig.Auth), copyTLS(scenarioConfig.TLS)
+		res.HTTPScenarioConfig = &scenarioConfig
+	}
+	if c.RedisConfig != nil {
+		redisConfig := *c.RedisConfig
+		res.RedisConfig = &redisConfig
+	}
+	if c.Db != nil {
+		db := *c.Db
+		res.Db = &db
+	}
+	for _, secret := range res.secrets() {
+		if *secret != "" {
+			*secret = RedactedSecret
+		}
+	}
+	return &res
+}
+
+// KeepSecrets replaces RedactedSecret by same secret of stored config,
+// so redacted config could be changed and sent back
+func (c *SchedulerConfig) KeepSecrets(stored *SchedulerConfig) error {
+	storedSecrets := stored.secrets()
+	for key, secret := range c.secrets() {
+		if *secret != RedactedSecret {
+			continue
+		}
+		storedSecret, ok := storedSecrets[key]
+		if !ok || *storedSecret == "" {
+			return errRedactedSecretNotFound
+		}
+		*secret = *storedSecret
+	}
+	return nil
+}
+
+// Pointers to secrets by path, paths are same for configs of same type
+func (c *SchedulerConfig) secrets() map[string]*string {
+	res := map[string]*string{}
+	if c.HTTPConfig != nil {
+		httpSecrets("http.", c.HTTPConfig.Auth, c.HTTPConfig.TLS, res)
+	}
+	if c.HTTPValueConfig != nil {
+		httpSecrets("httpValue.", c.HTTPValueConfig.Auth, c.HTTPValueConfig.TLS, res)
+	}
+	if c.HTTPScenarioConfig != nil {
+		httpSecrets("httpScenario.", c.HTTPScenarioConfig.Auth, c.HTTPScenarioConfig.TLS, res)
+	}
+	if c.RedisConfig != nil {
+		res["redis.password"] = &c.RedisConfig.Password
+	}
+	if c.Db != nil {
+		res["db.password"] = &c.Db.Password
+	}
+	return res
+}
+
+func httpSecrets(prefix string, auth *HTTPAuth, tls *HTTPTLS, res map[string]*string) {
+	if auth != nil {
+		res[prefix+"basicPassword"] = &auth.BasicPassword
+		res[prefix+"bearerToken"] = &auth.BearerToken
+		if auth.OAuth2 != nil {
+			res[prefix+"clientSecret"] = &auth.OAuth2.ClientSecret
+		}
+	}
+	if tls != nil {
+		res[prefix+"clientKey"] = &tls.ClientKey
+	}
+}
+
+func copyAuth(auth *HTTPAuth) *HTTPAuth {
+	if auth == nil {
+		return nil
+	}
+	res := *auth
+	if auth.OAuth2 != nil {
+		oauth2 := *auth.OAuth2
+		res.OAuth2 = &oauth2
+	}
+	return &res
+}
+
+func copyTLS(tls *HTTPTLS) *HTTPTLS {
+	if tls == nil {
+		return nil
+	}
+	res := *tls
+	return &res
+}
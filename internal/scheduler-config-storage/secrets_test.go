@@ -0,0 +1,66 @@
+package scheduler_config_storage
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func configWithSecrets() *SchedulerConfig {
+	return &SchedulerConfig{
+		HTTPConfig: &HTTPConfig{
+			URL: "http://localhost",
+			Auth: &HTTPAuth{
+				OAuth2: &OAuth2Config{
+					ClientID:     "id",
+					ClientSecret: "secret",
+				},
+			},
+			TLS: &HTTPTLS{
+				ClientCert: "cert",
+				ClientKey:  "key",
+			},
+		},
+		RedisConfig: &RedisConfig{
+			Password: "password",
+		},
+		Db: &DbConfig{},
+	}
+}
+
+func TestSchedulerConfig_Redacted(t *testing.T) {
+	t.Run("Should: replace secrets without changing config", func(t *testing.T) {
+		config := configWithSecrets()
+		redacted := config.Redacted()
+		assert.Equal(t, RedactedSecret, redacted.HTTPConfig.Auth.OAuth2.ClientSecret)
+		assert.Equal(t, "id", redacted.HTTPConfig.Auth.OAuth2.ClientID)
+		assert.Equal(t, RedactedSecret, redacted.HTTPConfig.TLS.ClientKey)
+		assert.Equal(t, "cert", redacted.HTTPConfig.TLS.ClientCert)
+		assert.Equal(t, RedactedSecret, redacted.RedisConfig.Password)
+		assert.Equal(t, "", redacted.HTTPConfig.Auth.BearerToken)
+		assert.Equal(t, "", redacted.Db.Password)
+		assert.Equal(t, configWithSecrets(), config)
+	})
+}
+
+func TestSchedulerConfig_KeepSecrets(t *testing.T) {
+	t.Run("Should: keep stored secrets instead of redacted", func(t *testing.T) {
+		config := configWithSecrets().Redacted()
+		config.HTTPConfig.URL = "http://changed"
+		config.RedisConfig.Password = "new"
+		err := config.KeepSecrets(configWithSecrets())
+		assert.Nil(t, err)
+		assert.Equal(t, "secret", config.HTTPConfig.Auth.OAuth2.ClientSecret)
+		assert.Equal(t, "key", config.HTTPConfig.TLS.ClientKey)
+		assert.Equal(t, "new", config.RedisConfig.Password)
+		assert.Equal(t, "http://changed", config.HTTPConfig.URL)
+	})
+	t.Run("Should: return error because secret is not stored", func(t *testing.T) {
+		config := &SchedulerConfig{
+			HTTPValueConfig: &HTTPValueConfig{
+				Auth: &HTTPAuth{BearerToken: RedactedSecret},
+			},
+		}
+		err := config.KeepSecrets(configWithSecrets())
+		assert.Equal(t, errRedactedSecretNotFound, err)
+	})
+}
@@ -2,10 +2,18 @@ package scheduler_config_storage
 
 import (
 	"context"
+	"errors"
 	"github.com/squzy/mongo_helper"
+	"github.com/squzy/squzy/internal/labels"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+const (
+	// Delay between attempts could not grow more
+	maxRetryBackoff = time.Minute
 )
 
 type DbConfig struct {
@@ -17,39 +25,209 @@ type DbConfig struct {
 	Cluster  string `bson:"cluster"`
 }
 
+type RedisConfig struct {
+	Host               string `bson:"host"`
+	Port               int32  `bson:"port"`
+	User               string `bson:"user,omitempty"`
+	Password           string `bson:"password,omitempty"`
+	Db                 int32  `bson:"db,omitempty"`
+	TLS                bool   `bson:"tls,omitempty"`
+	InsecureSkipVerify bool   `bson:"insecureSkipVerify,omitempty"`
+	// Thresholds of INFO values, 0 means not checked
+	MaxUsedMemory       int64 `bson:"maxUsedMemory,omitempty"`
+	MaxConnectedClients int64 `bson:"maxConnectedClients,omitempty"`
+	MaxKeys             int64 `bson:"maxKeys,omitempty"`
+	MasterLinkUp        bool  `bson:"masterLinkUp,omitempty"`
+}
+
 type GrpcConfig struct {
 	Service string `bson:"service"`
 	Host    string `bson:"host"`
 	Port    int32  `bson:"port"`
+	// Milliseconds after which passed check is WARNING
+	WarningTime int32 `bson:"warningTime,omitempty"`
 }
 
 type SslExpirationConfig struct {
 	Host string `bson:"host"`
 	Port int32  `bson:"port"`
+	// Passed check is WARNING when certificate expires in less days
+	WarningDays int32 `bson:"warningDays,omitempty"`
 }
 
 type HTTPConfig struct {
-	Method     string            `bson:"string"`
+	Method      string            `bson:"string"`
+	URL         string            `bson:"url"`
+	Headers     map[string]string `bson:"headers"`
+	StatusCode  int32             `bson:"statusCode"`
+	WarningTime int32             `bson:"warningTime,omitempty"`
+	Assertions  *HTTPAssertions   `bson:"assertions,omitempty"`
+	Body        *HTTPBody         `bson:"body,omitempty"`
+	Auth        *HTTPAuth         `bson:"auth,omitempty"`
+	TLS         *HTTPTLS          `bson:"tls,omitempty"`
+}
+
+type HTTPScenarioConfig struct {
+	Steps       []*HTTPScenarioStep `bson:"steps"`
+	WarningTime int32               `bson:"warningTime,omitempty"`
+	Auth        *HTTPAuth           `bson:"auth,omitempty"`
+	TLS         *HTTPTLS            `bson:"tls,omitempty"`
+}
+
+type HTTPScenarioStep struct {
+	Name       string            `bson:"name,omitempty"`
+	Method     string            `bson:"method"`
 	URL        string            `bson:"url"`
-	Headers    map[string]string `bson:"headers"`
-	StatusCode int32             `bson:"statusCode"`
+	Headers    map[string]string `bson:"headers,omitempty"`
+	Body       *HTTPBody         `bson:"body,omitempty"`
+	StatusCode int32             `bson:"statusCode,omitempty"`
+	Assertions *HTTPAssertions   `bson:"assertions,omitempty"`
+	Extract    []*HTTPExtract    `bson:"extract,omitempty"`
+}
+
+type HTTPExtract struct {
+	Name       string                                `bson:"name"`
+	Source     apiPb.HttpScenarioStep_Extract_Source `bson:"source"`
+	Expression string                                `bson:"expression"`
+}
+
+type HTTPAuth struct {
+	BasicUser     string        `bson:"basicUser,omitempty"`
+	BasicPassword string        `bson:"basicPassword,omitempty"`
+	BearerToken   string        `bson:"bearerToken,omitempty"`
+	OAuth2        *OAuth2Config `bson:"oauth2,omitempty"`
+}
+
+type OAuth2Config struct {
+	TokenURL     string   `bson:"tokenUrl"`
+	ClientID     string   `bson:"clientId"`
+	ClientSecret string   `bson:"clientSecret"`
+	Scopes       []string `bson:"scopes,omitempty"`
+}
+
+// PEM encoded certificates and key
+type HTTPTLS struct {
+	ClientCert         string `bson:"clientCert,omitempty"`
+	ClientKey          string `bson:"clientKey,omitempty"`
+	CABundle           string `bson:"caBundle,omitempty"`
+	InsecureSkipVerify bool   `bson:"insecureSkipVerify,omitempty"`
+}
+
+// Content is rendered before every request, see httptools.CreateRequest
+type HTTPBody struct {
+	Content     string `bson:"content"`
+	ContentType string `bson:"contentType,omitempty"`
+}
+
+type HTTPAssertions struct {
+	BodyContains    []string           `bson:"bodyContains,omitempty"`
+	BodyNotContains []string           `bson:"bodyNotContains,omitempty"`
+	BodyRegex       string             `bson:"bodyRegex,omitempty"`
+	Headers         []*HeaderAssertion `bson:"headers,omitempty"`
+	// In milliseconds
+	MaxResponseTime int32 `bson:"maxResponseTime,omitempty"`
+}
+
+type HeaderAssertion struct {
+	Name   string `bson:"name"`
+	Equals string `bson:"equals,omitempty"`
+	Regex  string `bson:"regex,omitempty"`
 }
 
 type HTTPValueConfig struct {
-	Method    string            `bson:"method"`
-	URL       string            `bson:"url"`
-	Headers   map[string]string `bson:"headers"`
-	Selectors []*Selectors      `bson:"selectors"`
+	Method      string            `bson:"method"`
+	URL         string            `bson:"url"`
+	Headers     map[string]string `bson:"headers"`
+	Selectors   []*Selectors      `bson:"selectors"`
+	WarningTime int32             `bson:"warningTime,omitempty"`
+	Body        *HTTPBody         `bson:"body,omitempty"`
+	Auth        *HTTPAuth         `bson:"auth,omitempty"`
+	TLS         *HTTPTLS          `bson:"tls,omitempty"`
 }
 
 type Selectors struct {
 	Type apiPb.HttpJsonValueConfig_JsonValueParseType `bson:"type"`
 	Path string                                       `bson:"path"`
+	// Passed check is WARNING when NUMBER value is out of range, nil bound is not checked
+	WarningMin *float64 `bson:"warningMin,omitempty"`
+	WarningMax *float64 `bson:"warningMax,omitempty"`
+	// Check is ERROR when value does not pass assertion
+	Assertion *SelectorAssertion `bson:"assertion,omitempty"`
+}
+
+// Nil field is not checked
+type SelectorAssertion struct {
+	Equals      *string  `bson:"equals,omitempty"`
+	NotEquals   *string  `bson:"notEquals,omitempty"`
+	GreaterThan *float64 `bson:"greaterThan,omitempty"`
+	LessThan    *float64 `bson:"lessThan,omitempty"`
+	Regex       string   `bson:"regex,omitempty"`
+	BoolEquals  *bool    `bson:"boolEquals,omitempty"`
+}
+
+type RetryPolicy struct {
+	// Total attempts including first one
+	Attempts int32 `bson:"attempts"`
+	// Milliseconds before second attempt
+	Backoff           int32    `bson:"backoff"`
+	BackoffMultiplier float64  `bson:"backoffMultiplier"`
+	RetryableErrors   []string `bson:"retryableErrors,omitempty"`
+}
+
+// Backoffs return delays before every attempt after first one
+func (p *RetryPolicy) Backoffs() []time.Duration {
+	if p == nil || p.Attempts <= 1 {
+		return nil
+	}
+	backoffs := make([]time.Duration, p.Attempts-1)
+	backoff := time.Duration(p.Backoff) * time.Millisecond
+	for i := range backoffs {
+		backoffs[i] = backoff
+		if p.BackoffMultiplier > 0 {
+			backoff = time.Duration(float64(backoff) * p.BackoffMultiplier)
+		}
+		if backoff > maxRetryBackoff {
+			backoff = maxRetryBackoff
+		}
+	}
+	return backoffs
+}
+
+// MaxDuration is how long check could take when every attempt takes whole timeout
+func (p *RetryPolicy) MaxDuration(timeout time.Duration) time.Duration {
+	total := timeout
+	for _, backoff := range p.Backoffs() {
+		total += backoff + timeout
+	}
+	return total
+}
+
+type FlapDetection struct {
+	// How many recent results are compared
+	Window    int32   `bson:"window"`
+	Threshold float64 `bson:"threshold"`
 }
 
+// Used by TCP and UDP checks
 type TCPConfig struct {
-	Host string `bson:"host"`
-	Port int32  `bson:"port"`
+	Host        string `bson:"host"`
+	Port        int32  `bson:"port"`
+	WarningTime int32  `bson:"warningTime,omitempty"`
+	Send        string `bson:"send,omitempty"`
+	Expect      string `bson:"expect,omitempty"`
+	ExpectRegex string `bson:"expectRegex,omitempty"`
+	// In milliseconds
+	ReadTimeout int32 `bson:"readTimeout,omitempty"`
+}
+
+type DNSConfig struct {
+	// Address of resolver, port 53 is used by default
+	Resolver   string                     `bson:"resolver"`
+	Name       string                     `bson:"name"`
+	RecordType apiPb.DnsConfig_RecordType `bson:"recordType"`
+	Expected   []string                   `bson:"expected,omitempty"`
+	// Seconds, 0 means TTL is not checked
+	MaxTTL int32 `bson:"maxTtl,omitempty"`
 }
 
 type SiteMapConfig struct {
@@ -58,19 +236,42 @@ type SiteMapConfig struct {
 }
 
 type SchedulerConfig struct {
-	ID                  primitive.ObjectID    `bson:"_id"`
-	Name                string                `bson:"name,omitempty"`
-	Type                apiPb.SchedulerType   `bson:"type"`
-	Status              apiPb.SchedulerStatus `bson:"status"`
-	Interval            int32                 `bson:"interval"`
-	Timeout             int32                 `bson:"timeout"`
-	TCPConfig           *TCPConfig            `bson:"tcpConfig,omitempty"`
-	SiteMapConfig       *SiteMapConfig        `bson:"siteMapConfig,omitempty"`
-	GrpcConfig          *GrpcConfig           `bson:"grpcConfig,omitempty"`
-	HTTPConfig          *HTTPConfig           `bson:"httpConfig,omitempty"`
-	HTTPValueConfig     *HTTPValueConfig      `bson:"httpValueConfig,omitempty"`
-	SslExpirationConfig *SslExpirationConfig  `bson:"sslExpirationConfig,omitempty"`
-	Db                  *DbConfig             `bson:"db"`
+	ID          primitive.ObjectID    `bson:"_id"`
+	Name        string                `bson:"name,omitempty"`
+	Type        apiPb.SchedulerType   `bson:"type"`
+	Status      apiPb.SchedulerStatus `bson:"status"`
+	Interval    int32                 `bson:"interval"`
+	Cron        string                `bson:"cron,omitempty"`
+	Labels      map[string]string     `bson:"labels,omitempty"`
+	Timeout     int32                 `bson:"timeout"`
+	RetryPolicy *RetryPolicy          `bson:"retryPolicy,omitempty"`
+	ParentIDs   []primitive.ObjectID  `bson:"parentIds,omitempty"`
+	// Check is executed by probes of these locations, empty means squzy monitoring itself
+	Locations []string `bson:"locations,omitempty"`
+	// Check fails when it failed in so many locations
+	MinFailedLocations int32 `bson:"minFailedLocations,omitempty"`
+	// Interval in seconds used while check is failing, 0 means usual schedule
+	FailureInterval int32 `bson:"failureInterval,omitempty"`
+	// Usual schedule is back after so many OK results in a row, 0 means 1
+	RecoverAfter int32 `bson:"recoverAfter,omitempty"`
+	// OK results left before usual schedule is back, positive only while check is failing
+	RecoverLeft   int32          `bson:"recoverLeft,omitempty"`
+	FlapDetection *FlapDetection `bson:"flapDetection,omitempty"`
+	// Codes of recent checks from oldest to newest, saved only with flap detection
+	RecentCodes []apiPb.SchedulerCode `bson:"recentCodes,omitempty"`
+	// Code of last executed check, dependent schedulers are skipped while it is failing
+	LastCode            apiPb.SchedulerCode  `bson:"lastCode,omitempty"`
+	TCPConfig           *TCPConfig           `bson:"tcpConfig,omitempty"`
+	SiteMapConfig       *SiteMapConfig       `bson:"siteMapConfig,omitempty"`
+	GrpcConfig          *GrpcConfig          `bson:"grpcConfig,omitempty"`
+	HTTPConfig          *HTTPConfig          `bson:"httpConfig,omitempty"`
+	HTTPValueConfig     *HTTPValueConfig     `bson:"httpValueConfig,omitempty"`
+	SslExpirationConfig *SslExpirationConfig `bson:"sslExpirationConfig,omitempty"`
+	DNSConfig           *DNSConfig           `bson:"dnsConfig,omitempty"`
+	UDPConfig           *TCPConfig           `bson:"udpConfig,omitempty"`
+	RedisConfig         *RedisConfig         `bson:"redisConfig,omitempty"`
+	HTTPScenarioConfig  *HTTPScenarioConfig  `bson:"httpScenarioConfig,omitempty"`
+	Db                  *DbConfig            `bson:"db"`
 }
 
 type Storage interface {
@@ -79,14 +280,26 @@ type Storage interface {
 	Remove(ctx context.Context, schedulerID primitive.ObjectID) error
 	Run(ctx context.Context, schedulerID primitive.ObjectID) error
 	Stop(ctx context.Context, schedulerID primitive.ObjectID) error
+	// Replace everything except status, removed schedulers could not be updated
+	Update(ctx context.Context, config *SchedulerConfig) error
 	GetAll(ctx context.Context) ([]*SchedulerConfig, error)
+	// Empty selector returns all configs like GetAll
+	GetBySelector(ctx context.Context, selector labels.Selector) ([]*SchedulerConfig, error)
 	GetAllForSync(ctx context.Context) ([]*SchedulerConfig, error)
+	SetLastCode(ctx context.Context, schedulerID primitive.ObjectID, code apiPb.SchedulerCode) error
+	SetRecoverLeft(ctx context.Context, schedulerID primitive.ObjectID, left int32) error
+	// Only last window codes are kept
+	PushRecentCode(ctx context.Context, schedulerID primitive.ObjectID, code apiPb.SchedulerCode, window int32) error
 }
 
 type storage struct {
 	connector mongo_helper.Connector
 }
 
+var (
+	errNotFoundError = errors.New("SCHEDULER_CONFIG_NOT_FOUND")
+)
+
 var (
 	statusForAction = []apiPb.SchedulerStatus{
 		apiPb.SchedulerStatus_STOPPED,
@@ -116,6 +329,38 @@ func (s *storage) GetAll(ctx context.Context) ([]*SchedulerConfig, error) {
 	return configs, nil
 }
 
+func (s *storage) GetBySelector(ctx context.Context, selector labels.Selector) ([]*SchedulerConfig, error) {
+	configs := []*SchedulerConfig{}
+	err := s.connector.FindAll(ctx, selectorToFilter(selector), &configs)
+	if err != nil {
+		return nil, err
+	}
+	return configs, nil
+}
+
+func selectorToFilter(selector labels.Selector) bson.M {
+	if len(selector) == 0 {
+		return bson.M{}
+	}
+	conditions := make([]bson.M, len(selector))
+	for i, requirement := range selector {
+		field := "labels." + requirement.Key
+		switch requirement.Operator {
+		case labels.Equals:
+			conditions[i] = bson.M{field: requirement.Value}
+		case labels.NotEquals:
+			conditions[i] = bson.M{field: bson.M{"$ne": requirement.Value}}
+		case labels.Exists:
+			conditions[i] = bson.M{field: bson.M{"$exists": true}}
+		default:
+			conditions[i] = bson.M{field: bson.M{"$exists": false}}
+		}
+	}
+	return bson.M{
+		"$and": conditions,
+	}
+}
+
 func (s *storage) Add(ctx context.Context, config *SchedulerConfig) error {
 	_, err := s.connector.InsertOne(ctx, config)
 	return err
@@ -160,6 +405,85 @@ func (s *storage) Stop(ctx context.Context, schedulerID primitive.ObjectID) erro
 	return err
 }
 
+func (s *storage) Update(ctx context.Context, config *SchedulerConfig) error {
+	res, err := s.connector.UpdateOne(ctx, bson.M{
+		"_id": config.ID,
+		"status": bson.M{
+			"$in": statusForAction,
+		},
+	}, bson.M{
+		"$set": bson.M{
+			"name":                config.Name,
+			"type":                config.Type,
+			"interval":            config.Interval,
+			"cron":                config.Cron,
+			"labels":              config.Labels,
+			"timeout":             config.Timeout,
+			"retryPolicy":         config.RetryPolicy,
+			"parentIds":           config.ParentIDs,
+			"locations":           config.Locations,
+			"minFailedLocations":  config.MinFailedLocations,
+			"failureInterval":     config.FailureInterval,
+			"recoverAfter":        config.RecoverAfter,
+			"flapDetection":       config.FlapDetection,
+			"tcpConfig":           config.TCPConfig,
+			"siteMapConfig":       config.SiteMapConfig,
+			"grpcConfig":          config.GrpcConfig,
+			"httpConfig":          config.HTTPConfig,
+			"httpValueConfig":     config.HTTPValueConfig,
+			"sslExpirationConfig": config.SslExpirationConfig,
+			"dnsConfig":           config.DNSConfig,
+			"udpConfig":           config.UDPConfig,
+			"redisConfig":         config.RedisConfig,
+			"httpScenarioConfig":  config.HTTPScenarioConfig,
+			"db":                  config.Db,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if res != nil && res.MatchedCount == 0 {
+		return errNotFoundError
+	}
+	return nil
+}
+
+func (s *storage) SetLastCode(ctx context.Context, schedulerID primitive.ObjectID, code apiPb.SchedulerCode) error {
+	_, err := s.connector.UpdateOne(ctx, bson.M{
+		"_id": schedulerID,
+	}, bson.M{
+		"$set": bson.M{
+			"lastCode": code,
+		},
+	})
+	return err
+}
+
+func (s *storage) SetRecoverLeft(ctx context.Context, schedulerID primitive.ObjectID, left int32) error {
+	_, err := s.connector.UpdateOne(ctx, bson.M{
+		"_id": schedulerID,
+	}, bson.M{
+		"$set": bson.M{
+			"recoverLeft": left,
+		},
+	})
+	return err
+}
+
+func (s *storage) PushRecentCode(ctx context.Context, schedulerID primitive.ObjectID, code apiPb.SchedulerCode, window int32) error {
+	_, err := s.connector.UpdateOne(ctx, bson.M{
+		"_id": schedulerID,
+	}, bson.M{
+		"$push": bson.M{
+			"recentCodes": bson.M{
+				"$each":  []apiPb.SchedulerCode{code},
+				"$slice": -window,
+			},
+		},
+	})
+	return err
+}
+
 func (s *storage) Get(ctx context.Context, schedulerID primitive.ObjectID) (*SchedulerConfig, error) {
 	config := &SchedulerConfig{}
 	err := s.connector.FindOne(ctx, bson.M{
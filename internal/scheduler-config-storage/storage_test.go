@@ -3,12 +3,17 @@ package scheduler_config_storage
 import (
 	"context"
 	"errors"
+	"github.com/squzy/squzy/internal/labels"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 var (
@@ -105,6 +110,49 @@ func TestStorage_GetAll(t *testing.T) {
 	})
 }
 
+type mockFilter struct {
+	mockOk
+	filter *bson.M
+}
+
+func (m mockFilter) FindAll(ctx context.Context, predicate bson.M, structToDeserialize interface{}, opts ...*options.FindOptions) error {
+	*m.filter = predicate
+	return nil
+}
+
+func TestStorage_GetBySelector(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(&mockOk{})
+		_, err := s.GetBySelector(context.Background(), nil)
+		assert.Equal(t, nil, err)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(&mockError{})
+		_, err := s.GetBySelector(context.Background(), nil)
+		assert.NotEqual(t, nil, err)
+	})
+	t.Run("Should: find all by empty selector", func(t *testing.T) {
+		filter := bson.M{}
+		s := New(&mockFilter{filter: &filter})
+		_, _ = s.GetBySelector(context.Background(), labels.Selector{})
+		assert.Equal(t, bson.M{}, filter)
+	})
+	t.Run("Should: convert selector to filter", func(t *testing.T) {
+		filter := bson.M{}
+		s := New(&mockFilter{filter: &filter})
+		selector, _ := labels.Parse("team=payments,env!=prod,critical,!deprecated")
+		_, _ = s.GetBySelector(context.Background(), selector)
+		assert.Equal(t, bson.M{
+			"$and": []bson.M{
+				{"labels.team": "payments"},
+				{"labels.env": bson.M{"$ne": "prod"}},
+				{"labels.critical": bson.M{"$exists": true}},
+				{"labels.deprecated": bson.M{"$exists": false}},
+			},
+		}, filter)
+	})
+}
+
 func TestStorage_GetAllForSync(t *testing.T) {
 	t.Run("Should: not return error", func(t *testing.T) {
 		s := New(&mockOk{})
@@ -156,3 +204,117 @@ func TestStorage_Stop(t *testing.T) {
 		assert.NotEqual(t, nil, err)
 	})
 }
+
+func TestStorage_SetLastCode(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(&mockOk{})
+		err := s.SetLastCode(context.Background(), primitive.NewObjectID(), apiPb.SchedulerCode_ERROR)
+		assert.Equal(t, nil, err)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(&mockError{})
+		err := s.SetLastCode(context.Background(), primitive.NewObjectID(), apiPb.SchedulerCode_ERROR)
+		assert.NotEqual(t, nil, err)
+	})
+}
+
+func TestStorage_SetRecoverLeft(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(&mockOk{})
+		err := s.SetRecoverLeft(context.Background(), primitive.NewObjectID(), 2)
+		assert.Equal(t, nil, err)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(&mockError{})
+		err := s.SetRecoverLeft(context.Background(), primitive.NewObjectID(), 2)
+		assert.NotEqual(t, nil, err)
+	})
+}
+
+func TestStorage_PushRecentCode(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(&mockOk{})
+		err := s.PushRecentCode(context.Background(), primitive.NewObjectID(), apiPb.SchedulerCode_OK, 21)
+		assert.Equal(t, nil, err)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(&mockError{})
+		err := s.PushRecentCode(context.Background(), primitive.NewObjectID(), apiPb.SchedulerCode_OK, 21)
+		assert.NotEqual(t, nil, err)
+	})
+}
+
+type mockNotMatched struct {
+	mockOk
+}
+
+func (m mockNotMatched) UpdateOne(ctx context.Context, filter interface{}, update interface{}, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error) {
+	return &mongo.UpdateResult{MatchedCount: 0}, nil
+}
+
+type mockUpdate struct {
+	mockOk
+	update *bson.M
+}
+
+func (m mockUpdate) UpdateOne(ctx context.Context, filter interface{}, update interface{}, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error) {
+	*m.update = update.(bson.M)
+	return &mongo.UpdateResult{MatchedCount: 1}, nil
+}
+
+func TestStorage_Update(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(&mockOk{})
+		err := s.Update(context.Background(), &SchedulerConfig{ID: primitive.NewObjectID()})
+		assert.Equal(t, nil, err)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(&mockError{})
+		err := s.Update(context.Background(), &SchedulerConfig{ID: primitive.NewObjectID()})
+		assert.NotEqual(t, nil, err)
+	})
+	t.Run("Should: return error because config not found", func(t *testing.T) {
+		s := New(&mockNotMatched{})
+		err := s.Update(context.Background(), &SchedulerConfig{ID: primitive.NewObjectID()})
+		assert.Equal(t, errNotFoundError, err)
+	})
+	t.Run("Should: replace every field of config except state", func(t *testing.T) {
+		// State is changed by own methods, update should not reset it
+		state := map[string]bool{"_id": true, "status": true, "recoverLeft": true, "recentCodes": true, "lastCode": true}
+		update := bson.M{}
+		s := New(&mockUpdate{update: &update})
+		err := s.Update(context.Background(), &SchedulerConfig{ID: primitive.NewObjectID()})
+		assert.Equal(t, nil, err)
+		set := update["$set"].(bson.M)
+		configType := reflect.TypeOf(SchedulerConfig{})
+		for i := 0; i < configType.NumField(); i++ {
+			name := strings.Split(configType.Field(i).Tag.Get("bson"), ",")[0]
+			if state[name] {
+				assert.NotContains(t, set, name)
+				continue
+			}
+			assert.Contains(t, set, name)
+		}
+	})
+}
+
+func TestRetryPolicy_Backoffs(t *testing.T) {
+	t.Run("Should: return nothing without retries", func(t *testing.T) {
+		var policy *RetryPolicy
+		assert.Len(t, policy.Backoffs(), 0)
+		assert.Len(t, (&RetryPolicy{Attempts: 1, Backoff: 100}).Backoffs(), 0)
+	})
+	t.Run("Should: grow backoff until max", func(t *testing.T) {
+		policy := &RetryPolicy{Attempts: 4, Backoff: 25000, BackoffMultiplier: 2}
+		assert.Equal(t, []time.Duration{time.Second * 25, time.Second * 50, time.Minute}, policy.Backoffs())
+	})
+}
+
+func TestRetryPolicy_MaxDuration(t *testing.T) {
+	t.Run("Should: sum timeouts of attempts and backoffs", func(t *testing.T) {
+		policy := &RetryPolicy{Attempts: 3, Backoff: 1000}
+		assert.Equal(t, time.Second*32, policy.MaxDuration(time.Second*10))
+		var empty *RetryPolicy
+		assert.Equal(t, time.Second*10, empty.MaxDuration(time.Second*10))
+	})
+}
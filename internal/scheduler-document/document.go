@@ -0,0 +1,989 @@
+package scheduler_document
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/squzy/squzy/internal/helpers"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"gopkg.in/yaml.v3"
+	"io"
+	"sort"
+	"strings"
+)
+
+var (
+	errEmptyName      = errors.New("EMPTY_NAME")
+	errDuplicatedName = errors.New("DUPLICATED_NAME")
+	errUnknownType    = errors.New("UNKNOWN_TYPE")
+	errMissingConfig  = errors.New("MISSING_CONFIG")
+	// Changed secrets are not shown in plan
+	secretSuffixes = []string{"password", "Password", ".bearerToken", ".clientSecret", ".clientKey"}
+)
+
+// Document describes all checks, checks are matched with existing schedulers by name
+type Document struct {
+	Checks []*Check `json:"checks" yaml:"checks"`
+}
+
+type Check struct {
+	Name string `json:"name" yaml:"name"`
+	// Name of scheduler type like TCP or HTTP_JSON_VALUE
+	Type          string            `json:"type" yaml:"type"`
+	Interval      int32             `json:"interval,omitempty" yaml:"interval,omitempty"`
+	Cron          string            `json:"cron,omitempty" yaml:"cron,omitempty"`
+	Timeout       int32             `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	Labels        map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	TCP           *Address          `json:"tcp,omitempty" yaml:"tcp,omitempty"`
+	SslExpiration *Address          `json:"sslExpiration,omitempty" yaml:"sslExpiration,omitempty"`
+	Grpc          *Grpc             `json:"grpc,omitempty" yaml:"grpc,omitempty"`
+	HTTP          *HTTP             `json:"http,omitempty" yaml:"http,omitempty"`
+	HTTPValue     *HTTPValue        `json:"httpValue,omitempty" yaml:"httpValue,omitempty"`
+	SiteMap       *SiteMap          `json:"siteMap,omitempty" yaml:"siteMap,omitempty"`
+	DNS           *DNS              `json:"dns,omitempty" yaml:"dns,omitempty"`
+	UDP           *Address          `json:"udp,omitempty" yaml:"udp,omitempty"`
+	Redis         *Redis            `json:"redis,omitempty" yaml:"redis,omitempty"`
+	HTTPScenario  *Scenario         `json:"httpScenario,omitempty" yaml:"httpScenario,omitempty"`
+	// Used by MONGO, POSTGRES, MYSQL and CASSANDRA
+	Db          *Db          `json:"db,omitempty" yaml:"db,omitempty"`
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty" yaml:"retryPolicy,omitempty"`
+	// Ids of schedulers this check depends on
+	ParentIDs []string `json:"parentIds,omitempty" yaml:"parentIds,omitempty"`
+	// Probe locations, check fails when it failed in minFailedLocations of them
+	Locations          []string `json:"locations,omitempty" yaml:"locations,omitempty"`
+	MinFailedLocations int32    `json:"minFailedLocations,omitempty" yaml:"minFailedLocations,omitempty"`
+	// Interval in seconds used while check is failing, interval is back after recoverAfter OK results
+	FailureInterval int32          `json:"failureInterval,omitempty" yaml:"failureInterval,omitempty"`
+	RecoverAfter    int32          `json:"recoverAfter,omitempty" yaml:"recoverAfter,omitempty"`
+	FlapDetection   *FlapDetection `json:"flapDetection,omitempty" yaml:"flapDetection,omitempty"`
+}
+
+type Address struct {
+	Host string `json:"host,omitempty" yaml:"host,omitempty"`
+	Port int32  `json:"port,omitempty" yaml:"port,omitempty"`
+	// Used by TCP, milliseconds after which passed check is WARNING
+	WarningTime int32 `json:"warningTime,omitempty" yaml:"warningTime,omitempty"`
+	// Used by SSL_EXPIRATION, days before expiration when passed check is WARNING
+	WarningDays int32 `json:"warningDays,omitempty" yaml:"warningDays,omitempty"`
+	// Used by TCP and UDP, payload and expected reply
+	Send        string `json:"send,omitempty" yaml:"send,omitempty"`
+	Expect      string `json:"expect,omitempty" yaml:"expect,omitempty"`
+	ExpectRegex string `json:"expectRegex,omitempty" yaml:"expectRegex,omitempty"`
+	ReadTimeout int32  `json:"readTimeout,omitempty" yaml:"readTimeout,omitempty"`
+}
+
+type Grpc struct {
+	Service     string `json:"service,omitempty" yaml:"service,omitempty"`
+	Host        string `json:"host,omitempty" yaml:"host,omitempty"`
+	Port        int32  `json:"port,omitempty" yaml:"port,omitempty"`
+	WarningTime int32  `json:"warningTime,omitempty" yaml:"warningTime,omitempty"`
+}
+
+type HTTP struct {
+	Method      string            `json:"method,omitempty" yaml:"method,omitempty"`
+	URL         string            `json:"url,omitempty" yaml:"url,omitempty"`
+	Headers     map[string]string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	StatusCode  int32             `json:"statusCode,omitempty" yaml:"statusCode,omitempty"`
+	WarningTime int32             `json:"warningTime,omitempty" yaml:"warningTime,omitempty"`
+	Assertions  *Assertions       `json:"assertions,omitempty" yaml:"assertions,omitempty"`
+	Body        *Body             `json:"body,omitempty" yaml:"body,omitempty"`
+	Auth        *Auth             `json:"auth,omitempty" yaml:"auth,omitempty"`
+	TLS         *TLS              `json:"tls,omitempty" yaml:"tls,omitempty"`
+}
+
+// Only one of basic, bearer or oauth2 could be set
+type Auth struct {
+	BasicUser     string  `json:"basicUser,omitempty" yaml:"basicUser,omitempty"`
+	BasicPassword string  `json:"basicPassword,omitempty" yaml:"basicPassword,omitempty"`
+	BearerToken   string  `json:"bearerToken,omitempty" yaml:"bearerToken,omitempty"`
+	OAuth2        *OAuth2 `json:"oauth2,omitempty" yaml:"oauth2,omitempty"`
+}
+
+type OAuth2 struct {
+	TokenURL     string   `json:"tokenUrl" yaml:"tokenUrl"`
+	ClientID     string   `json:"clientId" yaml:"clientId"`
+	ClientSecret string   `json:"clientSecret,omitempty" yaml:"clientSecret,omitempty"`
+	Scopes       []string `json:"scopes,omitempty" yaml:"scopes,omitempty"`
+}
+
+// PEM encoded certificates and key
+type TLS struct {
+	ClientCert         string `json:"clientCert,omitempty" yaml:"clientCert,omitempty"`
+	ClientKey          string `json:"clientKey,omitempty" yaml:"clientKey,omitempty"`
+	CABundle           string `json:"caBundle,omitempty" yaml:"caBundle,omitempty"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty" yaml:"insecureSkipVerify,omitempty"`
+}
+
+type Body struct {
+	// Could have {{timestamp}}, {{timestamp_ms}} and {{uuid}} placeholders
+	Content     string `json:"content" yaml:"content"`
+	ContentType string `json:"contentType,omitempty" yaml:"contentType,omitempty"`
+}
+
+type Assertions struct {
+	BodyContains    []string           `json:"bodyContains,omitempty" yaml:"bodyContains,omitempty"`
+	BodyNotContains []string           `json:"bodyNotContains,omitempty" yaml:"bodyNotContains,omitempty"`
+	BodyRegex       string             `json:"bodyRegex,omitempty" yaml:"bodyRegex,omitempty"`
+	Headers         []*HeaderAssertion `json:"headers,omitempty" yaml:"headers,omitempty"`
+	// Milliseconds after which check fails
+	MaxResponseTime int32 `json:"maxResponseTime,omitempty" yaml:"maxResponseTime,omitempty"`
+}
+
+type HeaderAssertion struct {
+	Name   string `json:"name" yaml:"name"`
+	Equals string `json:"equals,omitempty" yaml:"equals,omitempty"`
+	Regex  string `json:"regex,omitempty" yaml:"regex,omitempty"`
+}
+
+type HTTPValue struct {
+	Method      string            `json:"method,omitempty" yaml:"method,omitempty"`
+	URL         string            `json:"url,omitempty" yaml:"url,omitempty"`
+	Headers     map[string]string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Selectors   []*Selector       `json:"selectors,omitempty" yaml:"selectors,omitempty"`
+	WarningTime int32             `json:"warningTime,omitempty" yaml:"warningTime,omitempty"`
+	Body        *Body             `json:"body,omitempty" yaml:"body,omitempty"`
+	Auth        *Auth             `json:"auth,omitempty" yaml:"auth,omitempty"`
+	TLS         *TLS              `json:"tls,omitempty" yaml:"tls,omitempty"`
+}
+
+type Selector struct {
+	// Name of parse type like STRING or NUMBER
+	Type string `json:"type" yaml:"type"`
+	Path string `json:"path" yaml:"path"`
+	// Range of NUMBER value, passed check is WARNING outside of it
+	WarningMin *float64 `json:"warningMin,omitempty" yaml:"warningMin,omitempty"`
+	WarningMax *float64 `json:"warningMax,omitempty" yaml:"warningMax,omitempty"`
+	// Check is ERROR when value does not pass assertion
+	Assertion *SelectorAssertion `json:"assertion,omitempty" yaml:"assertion,omitempty"`
+}
+
+// Every set field is checked, greaterThan and lessThan together make range
+type SelectorAssertion struct {
+	Equals      *string  `json:"equals,omitempty" yaml:"equals,omitempty"`
+	NotEquals   *string  `json:"notEquals,omitempty" yaml:"notEquals,omitempty"`
+	GreaterThan *float64 `json:"greaterThan,omitempty" yaml:"greaterThan,omitempty"`
+	LessThan    *float64 `json:"lessThan,omitempty" yaml:"lessThan,omitempty"`
+	Regex       string   `json:"regex,omitempty" yaml:"regex,omitempty"`
+	BoolEquals  *bool    `json:"boolEquals,omitempty" yaml:"boolEquals,omitempty"`
+}
+
+type Scenario struct {
+	Steps []*ScenarioStep `json:"steps" yaml:"steps"`
+	// Milliseconds of whole scenario after which passed check is WARNING
+	WarningTime int32 `json:"warningTime,omitempty" yaml:"warningTime,omitempty"`
+	Auth        *Auth `json:"auth,omitempty" yaml:"auth,omitempty"`
+	TLS         *TLS  `json:"tls,omitempty" yaml:"tls,omitempty"`
+}
+
+type ScenarioStep struct {
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+	// Url, headers and body could use {{name}} of variables extracted by previous steps
+	Method     string            `json:"method,omitempty" yaml:"method,omitempty"`
+	URL        string            `json:"url" yaml:"url"`
+	Headers    map[string]string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Body       *Body             `json:"body,omitempty" yaml:"body,omitempty"`
+	StatusCode int32             `json:"statusCode,omitempty" yaml:"statusCode,omitempty"`
+	Assertions *Assertions       `json:"assertions,omitempty" yaml:"assertions,omitempty"`
+	Extract    []*Extract        `json:"extract,omitempty" yaml:"extract,omitempty"`
+}
+
+type Extract struct {
+	Name string `json:"name" yaml:"name"`
+	// Name of source like JSON, HEADER or REGEX
+	Source     string `json:"source" yaml:"source"`
+	Expression string `json:"expression" yaml:"expression"`
+}
+
+type SiteMap struct {
+	URL         string `json:"url,omitempty" yaml:"url,omitempty"`
+	Concurrency int32  `json:"concurrency,omitempty" yaml:"concurrency,omitempty"`
+}
+
+type DNS struct {
+	Resolver string `json:"resolver,omitempty" yaml:"resolver,omitempty"`
+	Name     string `json:"name,omitempty" yaml:"name,omitempty"`
+	// Name of record type like A or MX
+	RecordType string   `json:"recordType,omitempty" yaml:"recordType,omitempty"`
+	Expected   []string `json:"expected,omitempty" yaml:"expected,omitempty"`
+	MaxTTL     int32    `json:"maxTtl,omitempty" yaml:"maxTtl,omitempty"`
+}
+
+type Redis struct {
+	Host               string `json:"host,omitempty" yaml:"host,omitempty"`
+	Port               int32  `json:"port,omitempty" yaml:"port,omitempty"`
+	User               string `json:"user,omitempty" yaml:"user,omitempty"`
+	Password           string `json:"password,omitempty" yaml:"password,omitempty"`
+	Db                 int32  `json:"db,omitempty" yaml:"db,omitempty"`
+	TLS                bool   `json:"tls,omitempty" yaml:"tls,omitempty"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty" yaml:"insecureSkipVerify,omitempty"`
+	// Thresholds of INFO values
+	MaxUsedMemory       int64 `json:"maxUsedMemory,omitempty" yaml:"maxUsedMemory,omitempty"`
+	MaxConnectedClients int64 `json:"maxConnectedClients,omitempty" yaml:"maxConnectedClients,omitempty"`
+	MaxKeys             int64 `json:"maxKeys,omitempty" yaml:"maxKeys,omitempty"`
+	MasterLinkUp        bool  `json:"masterLinkUp,omitempty" yaml:"masterLinkUp,omitempty"`
+}
+
+type RetryPolicy struct {
+	Attempts int32 `json:"attempts,omitempty" yaml:"attempts,omitempty"`
+	// Milliseconds before second attempt
+	Backoff           int32    `json:"backoff,omitempty" yaml:"backoff,omitempty"`
+	BackoffMultiplier float64  `json:"backoffMultiplier,omitempty" yaml:"backoffMultiplier,omitempty"`
+	RetryableErrors   []string `json:"retryableErrors,omitempty" yaml:"retryableErrors,omitempty"`
+}
+
+type FlapDetection struct {
+	Window    int32   `json:"window,omitempty" yaml:"window,omitempty"`
+	Threshold float64 `json:"threshold,omitempty" yaml:"threshold,omitempty"`
+}
+
+type Db struct {
+	Host     string `json:"host,omitempty" yaml:"host,omitempty"`
+	Port     int32  `json:"port,omitempty" yaml:"port,omitempty"`
+	User     string `json:"user,omitempty" yaml:"user,omitempty"`
+	Password string `json:"password,omitempty" yaml:"password,omitempty"`
+	DbName   string `json:"dbName,omitempty" yaml:"dbName,omitempty"`
+	Cluster  string `json:"cluster,omitempty" yaml:"cluster,omitempty"`
+}
+
+// Unknown fields are treated as error, so typo in document would not be silently ignored
+func Unmarshal(data []byte, format apiPb.DocumentFormat) (*Document, error) {
+	doc := &Document{}
+	if format == apiPb.DocumentFormat_DOCUMENT_FORMAT_JSON {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(doc); err != nil {
+			return nil, err
+		}
+	} else {
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		// Empty document means no checks at all
+		if err := decoder.Decode(doc); err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+	}
+	if err := doc.Validate(); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func Marshal(doc *Document, format apiPb.DocumentFormat) ([]byte, error) {
+	if format == apiPb.DocumentFormat_DOCUMENT_FORMAT_JSON {
+		return json.MarshalIndent(doc, "", "  ")
+	}
+	return yaml.Marshal(doc)
+}
+
+func (d *Document) Validate() error {
+	names := map[string]bool{}
+	for i, check := range d.Checks {
+		if check.Name == "" {
+			return fmt.Errorf("%w: check #%d", errEmptyName, i)
+		}
+		if names[check.Name] {
+			return fmt.Errorf("%w: %s", errDuplicatedName, check.Name)
+		}
+		names[check.Name] = true
+	}
+	return nil
+}
+
+func (c *Check) ToAddRequest() (*apiPb.AddRequest, error) {
+	schedulerType, ok := apiPb.SchedulerType_value[c.Type]
+	if !ok || schedulerType == int32(apiPb.SchedulerType_SCHEDULER_TYPE_UNSPECIFIED) {
+		return nil, fmt.Errorf("%w: %s in %s", errUnknownType, c.Type, c.Name)
+	}
+	rq := &apiPb.AddRequest{
+		Name:               c.Name,
+		Interval:           c.Interval,
+		Cron:               c.Cron,
+		Timeout:            c.Timeout,
+		Labels:             c.Labels,
+		ParentIds:          c.ParentIDs,
+		Locations:          c.Locations,
+		MinFailedLocations: c.MinFailedLocations,
+		FailureInterval:    c.FailureInterval,
+		RecoverAfter:       c.RecoverAfter,
+	}
+	if c.RetryPolicy != nil {
+		rq.RetryPolicy = &apiPb.RetryPolicy{
+			Attempts:          c.RetryPolicy.Attempts,
+			Backoff:           c.RetryPolicy.Backoff,
+			BackoffMultiplier: c.RetryPolicy.BackoffMultiplier,
+			RetryableErrors:   c.RetryPolicy.RetryableErrors,
+		}
+	}
+	if c.FlapDetection != nil {
+		rq.FlapDetection = &apiPb.FlapDetection{
+			Window:    c.FlapDetection.Window,
+			Threshold: c.FlapDetection.Threshold,
+		}
+	}
+	missing := fmt.Errorf("%w: %s", errMissingConfig, c.Name)
+	switch apiPb.SchedulerType(schedulerType) {
+	case apiPb.SchedulerType_TCP:
+		if c.TCP == nil {
+			return nil, missing
+		}
+		rq.Config = &apiPb.AddRequest_Tcp{Tcp: c.TCP.toTCPConfig()}
+	case apiPb.SchedulerType_UDP:
+		if c.UDP == nil {
+			return nil, missing
+		}
+		rq.Config = &apiPb.AddRequest_Udp{Udp: c.UDP.toTCPConfig()}
+	case apiPb.SchedulerType_REDIS:
+		if c.Redis == nil {
+			return nil, missing
+		}
+		rq.Config = &apiPb.AddRequest_Redis{
+			Redis: &apiPb.RedisConfig{
+				Host:                c.Redis.Host,
+				Port:                c.Redis.Port,
+				User:                c.Redis.User,
+				Password:            c.Redis.Password,
+				Db:                  c.Redis.Db,
+				Tls:                 c.Redis.TLS,
+				InsecureSkipVerify:  c.Redis.InsecureSkipVerify,
+				MaxUsedMemory:       c.Redis.MaxUsedMemory,
+				MaxConnectedClients: c.Redis.MaxConnectedClients,
+				MaxKeys:             c.Redis.MaxKeys,
+				MasterLinkUp:        c.Redis.MasterLinkUp,
+			},
+		}
+	case apiPb.SchedulerType_HTTP_SCENARIO:
+		if c.HTTPScenario == nil {
+			return nil, missing
+		}
+		scenario, err := c.HTTPScenario.toProto()
+		if err != nil {
+			return nil, fmt.Errorf("%w in %s", err, c.Name)
+		}
+		rq.Config = &apiPb.AddRequest_HttpScenario{HttpScenario: scenario}
+	case apiPb.SchedulerType_SSL_EXPIRATION:
+		if c.SslExpiration == nil {
+			return nil, missing
+		}
+		rq.Config = &apiPb.AddRequest_SslExpiration{
+			SslExpiration: &apiPb.SslExpirationConfig{
+				Host:        c.SslExpiration.Host,
+				Port:        c.SslExpiration.Port,
+				WarningDays: c.SslExpiration.WarningDays,
+			},
+		}
+	case apiPb.SchedulerType_GRPC:
+		if c.Grpc == nil {
+			return nil, missing
+		}
+		rq.Config = &apiPb.AddRequest_Grpc{
+			Grpc: &apiPb.GrpcConfig{
+				Service:     c.Grpc.Service,
+				Host:        c.Grpc.Host,
+				Port:        c.Grpc.Port,
+				WarningTime: c.Grpc.WarningTime,
+			},
+		}
+	case apiPb.SchedulerType_HTTP:
+		if c.HTTP == nil {
+			return nil, missing
+		}
+		rq.Config = &apiPb.AddRequest_Http{
+			Http: &apiPb.HttpConfig{
+				Method:      c.HTTP.Method,
+				Url:         c.HTTP.URL,
+				Headers:     c.HTTP.Headers,
+				StatusCode:  c.HTTP.StatusCode,
+				WarningTime: c.HTTP.WarningTime,
+				Assertions:  c.HTTP.Assertions.toProto(),
+				Body:        c.HTTP.Body.toProto(),
+				Auth:        c.HTTP.Auth.toProto(),
+				Tls:         c.HTTP.TLS.toProto(),
+			},
+		}
+	case apiPb.SchedulerType_HTTP_JSON_VALUE:
+		if c.HTTPValue == nil {
+			return nil, missing
+		}
+		selectors := []*apiPb.HttpJsonValueConfig_Selectors{}
+		for _, selector := range c.HTTPValue.Selectors {
+			parseType, ok := apiPb.HttpJsonValueConfig_JsonValueParseType_value[selector.Type]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s in %s", errUnknownType, selector.Type, c.Name)
+			}
+			selectors = append(selectors, &apiPb.HttpJsonValueConfig_Selectors{
+				Type:       apiPb.HttpJsonValueConfig_JsonValueParseType(parseType),
+				Path:       selector.Path,
+				WarningMin: helpers.DoubleValueToProto(selector.WarningMin),
+				WarningMax: helpers.DoubleValueToProto(selector.WarningMax),
+				Assertion:  selector.Assertion.toProto(),
+			})
+		}
+		rq.Config = &apiPb.AddRequest_HttpValue{
+			HttpValue: &apiPb.HttpJsonValueConfig{
+				Method:      c.HTTPValue.Method,
+				Url:         c.HTTPValue.URL,
+				Headers:     c.HTTPValue.Headers,
+				Selectors:   selectors,
+				WarningTime: c.HTTPValue.WarningTime,
+				Body:        c.HTTPValue.Body.toProto(),
+				Auth:        c.HTTPValue.Auth.toProto(),
+				Tls:         c.HTTPValue.TLS.toProto(),
+			},
+		}
+	case apiPb.SchedulerType_SITE_MAP:
+		if c.SiteMap == nil {
+			return nil, missing
+		}
+		rq.Config = &apiPb.AddRequest_Sitemap{
+			Sitemap: &apiPb.SiteMapConfig{Url: c.SiteMap.URL, Concurrency: c.SiteMap.Concurrency},
+		}
+	case apiPb.SchedulerType_DNS:
+		if c.DNS == nil {
+			return nil, missing
+		}
+		recordType, ok := apiPb.DnsConfig_RecordType_value[c.DNS.RecordType]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s in %s", errUnknownType, c.DNS.RecordType, c.Name)
+		}
+		rq.Config = &apiPb.AddRequest_Dns{
+			Dns: &apiPb.DnsConfig{
+				Resolver:   c.DNS.Resolver,
+				Name:       c.DNS.Name,
+				RecordType: apiPb.DnsConfig_RecordType(recordType),
+				Expected:   c.DNS.Expected,
+				MaxTtl:     c.DNS.MaxTTL,
+			},
+		}
+	case apiPb.SchedulerType_MONGO, apiPb.SchedulerType_POSTGRES, apiPb.SchedulerType_MYSQL, apiPb.SchedulerType_CASSANDRA:
+		if c.Db == nil {
+			return nil, missing
+		}
+		db := &apiPb.DbConfig{
+			Host:     c.Db.Host,
+			Port:     c.Db.Port,
+			User:     c.Db.User,
+			Password: c.Db.Password,
+			DbName:   c.Db.DbName,
+			Cluster:  c.Db.Cluster,
+		}
+		switch apiPb.SchedulerType(schedulerType) {
+		case apiPb.SchedulerType_MONGO:
+			rq.Config = &apiPb.AddRequest_Mongo{Mongo: db}
+		case apiPb.SchedulerType_POSTGRES:
+			rq.Config = &apiPb.AddRequest_Postgres{Postgres: db}
+		case apiPb.SchedulerType_MYSQL:
+			rq.Config = &apiPb.AddRequest_Mysql{Mysql: db}
+		default:
+			rq.Config = &apiPb.AddRequest_Cassandra{Cassandra: db}
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s in %s", errUnknownType, c.Type, c.Name)
+	}
+	return rq, nil
+}
+
+func FromConfig(config *scheduler_config_storage.SchedulerConfig) *Check {
+	check := &Check{
+		Name:     config.Name,
+		Type:     config.Type.String(),
+		Interval: config.Interval,
+		Cron:     config.Cron,
+		Timeout:  config.Timeout,
+		Labels:   config.Labels,
+	}
+	check.Locations = config.Locations
+	check.MinFailedLocations = config.MinFailedLocations
+	check.FailureInterval = config.FailureInterval
+	check.RecoverAfter = config.RecoverAfter
+	for _, id := range config.ParentIDs {
+		check.ParentIDs = append(check.ParentIDs, id.Hex())
+	}
+	if config.RetryPolicy != nil {
+		check.RetryPolicy = &RetryPolicy{
+			Attempts:          config.RetryPolicy.Attempts,
+			Backoff:           config.RetryPolicy.Backoff,
+			BackoffMultiplier: config.RetryPolicy.BackoffMultiplier,
+			RetryableErrors:   config.RetryPolicy.RetryableErrors,
+		}
+	}
+	if config.FlapDetection != nil {
+		check.FlapDetection = &FlapDetection{
+			Window:    config.FlapDetection.Window,
+			Threshold: config.FlapDetection.Threshold,
+		}
+	}
+	switch config.Type {
+	case apiPb.SchedulerType_TCP:
+		if config.TCPConfig != nil {
+			check.TCP = addressFromTCPConfig(config.TCPConfig)
+		}
+	case apiPb.SchedulerType_UDP:
+		if config.UDPConfig != nil {
+			check.UDP = addressFromTCPConfig(config.UDPConfig)
+		}
+	case apiPb.SchedulerType_REDIS:
+		if config.RedisConfig != nil {
+			check.Redis = &Redis{
+				Host:                config.RedisConfig.Host,
+				Port:                config.RedisConfig.Port,
+				User:                config.RedisConfig.User,
+				Password:            config.RedisConfig.Password,
+				Db:                  config.RedisConfig.Db,
+				TLS:                 config.RedisConfig.TLS,
+				InsecureSkipVerify:  config.RedisConfig.InsecureSkipVerify,
+				MaxUsedMemory:       config.RedisConfig.MaxUsedMemory,
+				MaxConnectedClients: config.RedisConfig.MaxConnectedClients,
+				MaxKeys:             config.RedisConfig.MaxKeys,
+				MasterLinkUp:        config.RedisConfig.MasterLinkUp,
+			}
+		}
+	case apiPb.SchedulerType_HTTP_SCENARIO:
+		if config.HTTPScenarioConfig != nil {
+			check.HTTPScenario = scenarioFromConfig(config.HTTPScenarioConfig)
+		}
+	case apiPb.SchedulerType_SSL_EXPIRATION:
+		if config.SslExpirationConfig != nil {
+			check.SslExpiration = &Address{
+				Host:        config.SslExpirationConfig.Host,
+				Port:        config.SslExpirationConfig.Port,
+				WarningDays: config.SslExpirationConfig.WarningDays,
+			}
+		}
+	case apiPb.SchedulerType_GRPC:
+		if config.GrpcConfig != nil {
+			check.Grpc = &Grpc{
+				Service:     config.GrpcConfig.Service,
+				Host:        config.GrpcConfig.Host,
+				Port:        config.GrpcConfig.Port,
+				WarningTime: config.GrpcConfig.WarningTime,
+			}
+		}
+	case apiPb.SchedulerType_HTTP:
+		if config.HTTPConfig != nil {
+			check.HTTP = &HTTP{
+				Method:      config.HTTPConfig.Method,
+				URL:         config.HTTPConfig.URL,
+				Headers:     config.HTTPConfig.Headers,
+				StatusCode:  config.HTTPConfig.StatusCode,
+				WarningTime: config.HTTPConfig.WarningTime,
+				Assertions:  assertionsFromConfig(config.HTTPConfig.Assertions),
+				Body:        bodyFromConfig(config.HTTPConfig.Body),
+				Auth:        authFromConfig(config.HTTPConfig.Auth),
+				TLS:         tlsFromConfig(config.HTTPConfig.TLS),
+			}
+		}
+	case apiPb.SchedulerType_HTTP_JSON_VALUE:
+		if config.HTTPValueConfig != nil {
+			check.HTTPValue = &HTTPValue{
+				Method:      config.HTTPValueConfig.Method,
+				URL:         config.HTTPValueConfig.URL,
+				Headers:     config.HTTPValueConfig.Headers,
+				WarningTime: config.HTTPValueConfig.WarningTime,
+				Body:        bodyFromConfig(config.HTTPValueConfig.Body),
+				Auth:        authFromConfig(config.HTTPValueConfig.Auth),
+				TLS:         tlsFromConfig(config.HTTPValueConfig.TLS),
+			}
+			for _, selector := range config.HTTPValueConfig.Selectors {
+				check.HTTPValue.Selectors = append(check.HTTPValue.Selectors, &Selector{
+					Type:       selector.Type.String(),
+					Path:       selector.Path,
+					WarningMin: selector.WarningMin,
+					WarningMax: selector.WarningMax,
+					Assertion:  selectorAssertionFromConfig(selector.Assertion),
+				})
+			}
+		}
+	case apiPb.SchedulerType_SITE_MAP:
+		if config.SiteMapConfig != nil {
+			check.SiteMap = &SiteMap{URL: config.SiteMapConfig.URL, Concurrency: config.SiteMapConfig.Concurrency}
+		}
+	case apiPb.SchedulerType_DNS:
+		if config.DNSConfig != nil {
+			check.DNS = &DNS{
+				Resolver:   config.DNSConfig.Resolver,
+				Name:       config.DNSConfig.Name,
+				RecordType: config.DNSConfig.RecordType.String(),
+				Expected:   config.DNSConfig.Expected,
+				MaxTTL:     config.DNSConfig.MaxTTL,
+			}
+		}
+	case apiPb.SchedulerType_MONGO, apiPb.SchedulerType_POSTGRES, apiPb.SchedulerType_MYSQL, apiPb.SchedulerType_CASSANDRA:
+		if config.Db != nil {
+			check.Db = &Db{
+				Host:     config.Db.Host,
+				Port:     config.Db.Port,
+				User:     config.Db.User,
+				Password: config.Db.Password,
+				DbName:   config.Db.DbName,
+				Cluster:  config.Db.Cluster,
+			}
+		}
+	}
+	return check
+}
+
+func (a *Assertions) toProto() *apiPb.HttpAssertions {
+	if a == nil {
+		return nil
+	}
+	assertions := &apiPb.HttpAssertions{
+		BodyContains:    a.BodyContains,
+		BodyNotContains: a.BodyNotContains,
+		BodyRegex:       a.BodyRegex,
+		MaxResponseTime: a.MaxResponseTime,
+	}
+	for _, header := range a.Headers {
+		assertions.Headers = append(assertions.Headers, &apiPb.HttpAssertions_Header{
+			Name:   header.Name,
+			Equals: header.Equals,
+			Regex:  header.Regex,
+		})
+	}
+	return assertions
+}
+
+func assertionsFromConfig(config *scheduler_config_storage.HTTPAssertions) *Assertions {
+	if config == nil {
+		return nil
+	}
+	assertions := &Assertions{
+		BodyContains:    config.BodyContains,
+		BodyNotContains: config.BodyNotContains,
+		BodyRegex:       config.BodyRegex,
+		MaxResponseTime: config.MaxResponseTime,
+	}
+	for _, header := range config.Headers {
+		assertions.Headers = append(assertions.Headers, &HeaderAssertion{
+			Name:   header.Name,
+			Equals: header.Equals,
+			Regex:  header.Regex,
+		})
+	}
+	return assertions
+}
+
+func (a *SelectorAssertion) toProto() *apiPb.HttpJsonValueConfig_Assertion {
+	if a == nil {
+		return nil
+	}
+	return &apiPb.HttpJsonValueConfig_Assertion{
+		Equals:      helpers.StringValueToProto(a.Equals),
+		NotEquals:   helpers.StringValueToProto(a.NotEquals),
+		GreaterThan: helpers.DoubleValueToProto(a.GreaterThan),
+		LessThan:    helpers.DoubleValueToProto(a.LessThan),
+		Regex:       a.Regex,
+		BoolEquals:  helpers.BoolValueToProto(a.BoolEquals),
+	}
+}
+
+func selectorAssertionFromConfig(config *scheduler_config_storage.SelectorAssertion) *SelectorAssertion {
+	if config == nil {
+		return nil
+	}
+	return &SelectorAssertion{
+		Equals:      config.Equals,
+		NotEquals:   config.NotEquals,
+		GreaterThan: config.GreaterThan,
+		LessThan:    config.LessThan,
+		Regex:       config.Regex,
+		BoolEquals:  config.BoolEquals,
+	}
+}
+
+func (b *Body) toProto() *apiPb.HttpBody {
+	if b == nil {
+		return nil
+	}
+	return &apiPb.HttpBody{
+		Content:     b.Content,
+		ContentType: b.ContentType,
+	}
+}
+
+func bodyFromConfig(config *scheduler_config_storage.HTTPBody) *Body {
+	if config == nil {
+		return nil
+	}
+	return &Body{
+		Content:     config.Content,
+		ContentType: config.ContentType,
+	}
+}
+
+func (a *Auth) toProto() *apiPb.HttpAuth {
+	if a == nil {
+		return nil
+	}
+	auth := &apiPb.HttpAuth{
+		BasicUser:     a.BasicUser,
+		BasicPassword: a.BasicPassword,
+		BearerToken:   a.BearerToken,
+	}
+	if a.OAuth2 != nil {
+		auth.Oauth2 = &apiPb.HttpAuth_OAuth2{
+			TokenUrl:     a.OAuth2.TokenURL,
+			ClientId:     a.OAuth2.ClientID,
+			ClientSecret: a.OAuth2.ClientSecret,
+			Scopes:       a.OAuth2.Scopes,
+		}
+	}
+	return auth
+}
+
+func authFromConfig(config *scheduler_config_storage.HTTPAuth) *Auth {
+	if config == nil {
+		return nil
+	}
+	auth := &Auth{
+		BasicUser:     config.BasicUser,
+		BasicPassword: config.BasicPassword,
+		BearerToken:   config.BearerToken,
+	}
+	if config.OAuth2 != nil {
+		auth.OAuth2 = &OAuth2{
+			TokenURL:     config.OAuth2.TokenURL,
+			ClientID:     config.OAuth2.ClientID,
+			ClientSecret: config.OAuth2.ClientSecret,
+			Scopes:       config.OAuth2.Scopes,
+		}
+	}
+	return auth
+}
+
+func (t *TLS) toProto() *apiPb.HttpTls {
+	if t == nil {
+		return nil
+	}
+	return &apiPb.HttpTls{
+		ClientCert:         t.ClientCert,
+		ClientKey:          t.ClientKey,
+		CaBundle:           t.CABundle,
+		InsecureSkipVerify: t.InsecureSkipVerify,
+	}
+}
+
+func tlsFromConfig(config *scheduler_config_storage.HTTPTLS) *TLS {
+	if config == nil {
+		return nil
+	}
+	return &TLS{
+		ClientCert:         config.ClientCert,
+		ClientKey:          config.ClientKey,
+		CABundle:           config.CABundle,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+}
+
+func (s *Scenario) toProto() (*apiPb.HttpScenarioConfig, error) {
+	scenario := &apiPb.HttpScenarioConfig{
+		WarningTime: s.WarningTime,
+		Auth:        s.Auth.toProto(),
+		Tls:         s.TLS.toProto(),
+	}
+	for _, step := range s.Steps {
+		protoStep := &apiPb.HttpScenarioStep{
+			Name:       step.Name,
+			Method:     step.Method,
+			Url:        step.URL,
+			Headers:    step.Headers,
+			Body:       step.Body.toProto(),
+			StatusCode: step.StatusCode,
+			Assertions: step.Assertions.toProto(),
+		}
+		for _, extract := range step.Extract {
+			source, ok := apiPb.HttpScenarioStep_Extract_Source_value[extract.Source]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", errUnknownType, extract.Source)
+			}
+			protoStep.Extract = append(protoStep.Extract, &apiPb.HttpScenarioStep_Extract{
+				Name:       extract.Name,
+				Source:     apiPb.HttpScenarioStep_Extract_Source(source),
+				Expression: extract.Expression,
+			})
+		}
+		scenario.Steps = append(scenario.Steps, protoStep)
+	}
+	return scenario, nil
+}
+
+func scenarioFromConfig(config *scheduler_config_storage.HTTPScenarioConfig) *Scenario {
+	scenario := &Scenario{
+		WarningTime: config.WarningTime,
+		Auth:        authFromConfig(config.Auth),
+		TLS:         tlsFromConfig(config.TLS),
+	}
+	for _, step := range config.Steps {
+		docStep := &ScenarioStep{
+			Name:       step.Name,
+			Method:     step.Method,
+			URL:        step.URL,
+			Headers:    step.Headers,
+			Body:       bodyFromConfig(step.Body),
+			StatusCode: step.StatusCode,
+			Assertions: assertionsFromConfig(step.Assertions),
+		}
+		for _, extract := range step.Extract {
+			docStep.Extract = append(docStep.Extract, &Extract{
+				Name:       extract.Name,
+				Source:     extract.Source.String(),
+				Expression: extract.Expression,
+			})
+		}
+		scenario.Steps = append(scenario.Steps, docStep)
+	}
+	return scenario
+}
+
+func (a *Address) toTCPConfig() *apiPb.TcpConfig {
+	return &apiPb.TcpConfig{
+		Host:        a.Host,
+		Port:        a.Port,
+		WarningTime: a.WarningTime,
+		Send:        a.Send,
+		Expect:      a.Expect,
+		ExpectRegex: a.ExpectRegex,
+		ReadTimeout: a.ReadTimeout,
+	}
+}
+
+func addressFromTCPConfig(config *scheduler_config_storage.TCPConfig) *Address {
+	return &Address{
+		Host:        config.Host,
+		Port:        config.Port,
+		WarningTime: config.WarningTime,
+		Send:        config.Send,
+		Expect:      config.Expect,
+		ExpectRegex: config.ExpectRegex,
+		ReadTimeout: config.ReadTimeout,
+	}
+}
+
+// Existing scheduler with its check
+type Entry struct {
+	ID    string
+	Check *Check
+}
+
+type Change struct {
+	Action apiPb.SchedulerChange_Action
+	// Empty for create
+	ID string
+	// Desired check for create and update, current one for remove
+	Check *Check
+	Diff  []string
+}
+
+// Plan compares existing schedulers with desired checks by name,
+// existing schedulers which are not described in document would be removed.
+// If several existing schedulers have same name only first is kept
+func Plan(current []*Entry, desired []*Check) []*Change {
+	byName := map[string]*Entry{}
+	for _, entry := range current {
+		if _, ok := byName[entry.Check.Name]; !ok && entry.Check.Name != "" {
+			byName[entry.Check.Name] = entry
+		}
+	}
+	changes := []*Change{}
+	matched := map[string]bool{}
+	for _, check := range desired {
+		entry, ok := byName[check.Name]
+		if !ok {
+			changes = append(changes, &Change{
+				Action: apiPb.SchedulerChange_CREATE,
+				Check:  check,
+			})
+			continue
+		}
+		matched[entry.ID] = true
+		diff := Diff(entry.Check, check)
+		if len(diff) == 0 {
+			continue
+		}
+		changes = append(changes, &Change{
+			Action: apiPb.SchedulerChange_UPDATE,
+			ID:     entry.ID,
+			Check:  check,
+			Diff:   diff,
+		})
+	}
+	for _, entry := range current {
+		if matched[entry.ID] {
+			continue
+		}
+		changes = append(changes, &Change{
+			Action: apiPb.SchedulerChange_REMOVE,
+			ID:     entry.ID,
+			Check:  entry.Check,
+		})
+	}
+	return changes
+}
+
+// Diff returns sorted list of changed fields like "http.url: a -> b"
+func Diff(before, after *Check) []string {
+	old := flatten(before)
+	updated := flatten(after)
+	keys := map[string]bool{}
+	for k := range old {
+		keys[k] = true
+	}
+	for k := range updated {
+		keys[k] = true
+	}
+	diff := []string{}
+	for k := range keys {
+		if old[k] == updated[k] {
+			continue
+		}
+		if isSecret(k) {
+			// Do not show secrets in plan
+			diff = append(diff, k+": changed")
+			continue
+		}
+		diff = append(diff, fmt.Sprintf("%s: %s -> %s", k, valueOrNone(old[k]), valueOrNone(updated[k])))
+	}
+	sort.Strings(diff)
+	return diff
+}
+
+func isSecret(key string) bool {
+	for _, suffix := range secretSuffixes {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func valueOrNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+	return value
+}
+
+func flatten(check *Check) map[string]string {
+	res := map[string]string{}
+	data, _ := json.Marshal(check)
+	var value interface{}
+	_ = json.Unmarshal(data, &value)
+	flattenValue("", value, res)
+	return res
+}
+
+func flattenValue(prefix string, value interface{}, res map[string]string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, nested := range v {
+			flattenValue(strings.TrimPrefix(prefix+"."+k, "."), nested, res)
+		}
+	case []interface{}:
+		for i, nested := range v {
+			flattenValue(fmt.Sprintf("%s[%d]", prefix, i), nested, res)
+		}
+	default:
+		res[prefix] = fmt.Sprint(v)
+	}
+}
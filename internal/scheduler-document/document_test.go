@@ -0,0 +1,453 @@
+package scheduler_document
+
+import (
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"testing"
+)
+
+const yamlDocument = `
+checks:
+  - name: google
+    type: HTTP
+    interval: 10
+    timeout: 5
+    labels:
+      team: search
+    http:
+      method: GET
+      url: https://google.com
+      statusCode: 200
+  - name: rates
+    type: HTTP_JSON_VALUE
+    cron: "*/5 * * * *"
+    httpValue:
+      method: GET
+      url: https://api.exchangeratesapi.io/latest
+      selectors:
+        - type: NUMBER
+          path: rates.RUB
+          assertion:
+            lessThan: 100
+  - name: users-db
+    type: POSTGRES
+    interval: 60
+    db:
+      host: localhost
+      port: 5432
+      user: user
+      password: secret
+      dbName: users
+`
+
+func TestUnmarshal(t *testing.T) {
+	t.Run("Should: parse yaml document", func(t *testing.T) {
+		doc, err := Unmarshal([]byte(yamlDocument), apiPb.DocumentFormat_DOCUMENT_FORMAT_YAML)
+		assert.Nil(t, err)
+		assert.Len(t, doc.Checks, 3)
+		assert.Equal(t, "https://google.com", doc.Checks[0].HTTP.URL)
+		assert.Equal(t, map[string]string{"team": "search"}, doc.Checks[0].Labels)
+		assert.Equal(t, "rates.RUB", doc.Checks[1].HTTPValue.Selectors[0].Path)
+		assert.Equal(t, float64(100), *doc.Checks[1].HTTPValue.Selectors[0].Assertion.LessThan)
+		assert.Equal(t, "secret", doc.Checks[2].Db.Password)
+	})
+	t.Run("Should: parse json document", func(t *testing.T) {
+		doc, err := Unmarshal([]byte(`{"checks":[{"name":"tcp","type":"TCP","interval":10,"tcp":{"host":"localhost","port":80}}]}`), apiPb.DocumentFormat_DOCUMENT_FORMAT_JSON)
+		assert.Nil(t, err)
+		assert.Equal(t, &Address{Host: "localhost", Port: 80}, doc.Checks[0].TCP)
+	})
+	t.Run("Should: parse empty yaml document", func(t *testing.T) {
+		doc, err := Unmarshal([]byte(""), apiPb.DocumentFormat_DOCUMENT_FORMAT_UNSPECIFIED)
+		assert.Nil(t, err)
+		assert.Len(t, doc.Checks, 0)
+	})
+	t.Run("Should: return error because unknown field in yaml", func(t *testing.T) {
+		_, err := Unmarshal([]byte("checks:\n  - name: a\n    intervl: 10\n"), apiPb.DocumentFormat_DOCUMENT_FORMAT_YAML)
+		assert.NotNil(t, err)
+	})
+	t.Run("Should: return error because unknown field in json", func(t *testing.T) {
+		_, err := Unmarshal([]byte(`{"checks":[{"name":"a","intervl":10}]}`), apiPb.DocumentFormat_DOCUMENT_FORMAT_JSON)
+		assert.NotNil(t, err)
+	})
+	t.Run("Should: return error because duplicated name", func(t *testing.T) {
+		_, err := Unmarshal([]byte("checks:\n  - name: a\n  - name: a\n"), apiPb.DocumentFormat_DOCUMENT_FORMAT_YAML)
+		assert.ErrorIs(t, err, errDuplicatedName)
+	})
+	t.Run("Should: return error because empty name", func(t *testing.T) {
+		_, err := Unmarshal([]byte("checks:\n  - type: TCP\n"), apiPb.DocumentFormat_DOCUMENT_FORMAT_YAML)
+		assert.ErrorIs(t, err, errEmptyName)
+	})
+}
+
+func TestMarshal(t *testing.T) {
+	doc := &Document{
+		Checks: []*Check{
+			{Name: "tcp", Type: "TCP", Interval: 10, TCP: &Address{Host: "localhost", Port: 80}},
+		},
+	}
+	t.Run("Should: marshal to yaml and back", func(t *testing.T) {
+		data, err := Marshal(doc, apiPb.DocumentFormat_DOCUMENT_FORMAT_YAML)
+		assert.Nil(t, err)
+		actual, err := Unmarshal(data, apiPb.DocumentFormat_DOCUMENT_FORMAT_YAML)
+		assert.Nil(t, err)
+		assert.Equal(t, doc, actual)
+	})
+	t.Run("Should: marshal to json and back", func(t *testing.T) {
+		data, err := Marshal(doc, apiPb.DocumentFormat_DOCUMENT_FORMAT_JSON)
+		assert.Nil(t, err)
+		actual, err := Unmarshal(data, apiPb.DocumentFormat_DOCUMENT_FORMAT_JSON)
+		assert.Nil(t, err)
+		assert.Equal(t, doc, actual)
+	})
+}
+
+func TestCheck_ToAddRequest(t *testing.T) {
+	t.Run("Should: return request for every type", func(t *testing.T) {
+		tt := []*Check{
+			{Name: "tcp", Type: "TCP", TCP: &Address{}},
+			{Name: "ssl", Type: "SSL_EXPIRATION", SslExpiration: &Address{}},
+			{Name: "grpc", Type: "GRPC", Grpc: &Grpc{}},
+			{Name: "http", Type: "HTTP", HTTP: &HTTP{}},
+			{Name: "value", Type: "HTTP_JSON_VALUE", HTTPValue: &HTTPValue{Selectors: []*Selector{{Type: "STRING", Path: "a"}}}},
+			{Name: "sitemap", Type: "SITE_MAP", SiteMap: &SiteMap{}},
+			{Name: "dns", Type: "DNS", DNS: &DNS{RecordType: "A"}},
+			{Name: "udp", Type: "UDP", UDP: &Address{Send: "stats"}},
+			{Name: "redis", Type: "REDIS", Redis: &Redis{Host: "localhost"}},
+			{Name: "scenario", Type: "HTTP_SCENARIO", HTTPScenario: &Scenario{Steps: []*ScenarioStep{{URL: "u"}}}},
+			{Name: "mongo", Type: "MONGO", Db: &Db{}},
+			{Name: "postgres", Type: "POSTGRES", Db: &Db{}},
+			{Name: "mysql", Type: "MYSQL", Db: &Db{}},
+			{Name: "cassandra", Type: "CASSANDRA", Db: &Db{Cluster: "cluster"}},
+		}
+		for _, check := range tt {
+			check.Labels = map[string]string{"team": "search"}
+			rq, err := check.ToAddRequest()
+			assert.Nil(t, err)
+			assert.NotNil(t, rq.Config)
+			assert.Equal(t, check.Name, rq.Name)
+			assert.Equal(t, check.Labels, rq.Labels)
+		}
+	})
+	t.Run("Should: keep retry policy", func(t *testing.T) {
+		rq, err := (&Check{Name: "tcp", Type: "TCP", TCP: &Address{}, RetryPolicy: &RetryPolicy{
+			Attempts:        3,
+			Backoff:         200,
+			RetryableErrors: []string{"TIMEOUT"},
+		}}).ToAddRequest()
+		assert.Nil(t, err)
+		assert.Equal(t, &apiPb.RetryPolicy{Attempts: 3, Backoff: 200, RetryableErrors: []string{"TIMEOUT"}}, rq.RetryPolicy)
+	})
+	t.Run("Should: keep parent ids", func(t *testing.T) {
+		rq, err := (&Check{Name: "tcp", Type: "TCP", TCP: &Address{}, ParentIDs: []string{"5f0f3a3d1c9d440000a1b2c3"}}).ToAddRequest()
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"5f0f3a3d1c9d440000a1b2c3"}, rq.ParentIds)
+	})
+	t.Run("Should: keep locations", func(t *testing.T) {
+		rq, err := (&Check{Name: "tcp", Type: "TCP", TCP: &Address{}, Locations: []string{"eu", "us"}, MinFailedLocations: 2}).ToAddRequest()
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"eu", "us"}, rq.Locations)
+		assert.Equal(t, int32(2), rq.MinFailedLocations)
+	})
+	t.Run("Should: keep failure interval", func(t *testing.T) {
+		rq, err := (&Check{Name: "tcp", Type: "TCP", TCP: &Address{}, FailureInterval: 15, RecoverAfter: 3}).ToAddRequest()
+		assert.Nil(t, err)
+		assert.Equal(t, int32(15), rq.FailureInterval)
+		assert.Equal(t, int32(3), rq.RecoverAfter)
+	})
+	t.Run("Should: keep flap detection", func(t *testing.T) {
+		rq, err := (&Check{Name: "tcp", Type: "TCP", TCP: &Address{}, FlapDetection: &FlapDetection{Window: 10, Threshold: 30}}).ToAddRequest()
+		assert.Nil(t, err)
+		assert.Equal(t, int32(10), rq.FlapDetection.Window)
+		assert.Equal(t, float64(30), rq.FlapDetection.Threshold)
+	})
+	t.Run("Should: keep warning thresholds", func(t *testing.T) {
+		max := float64(100)
+		rq, err := (&Check{Name: "value", Type: "HTTP_JSON_VALUE", HTTPValue: &HTTPValue{
+			WarningTime: 500,
+			Selectors:   []*Selector{{Type: "NUMBER", Path: "p", WarningMax: &max}},
+		}}).ToAddRequest()
+		assert.Nil(t, err)
+		assert.Equal(t, int32(500), rq.GetHttpValue().WarningTime)
+		assert.Nil(t, rq.GetHttpValue().Selectors[0].WarningMin)
+		assert.Equal(t, float64(100), rq.GetHttpValue().Selectors[0].WarningMax.Value)
+		rq, err = (&Check{Name: "ssl", Type: "SSL_EXPIRATION", SslExpiration: &Address{WarningDays: 30}}).ToAddRequest()
+		assert.Nil(t, err)
+		assert.Equal(t, int32(30), rq.GetSslExpiration().WarningDays)
+	})
+	t.Run("Should: keep selector assertion", func(t *testing.T) {
+		status := "green"
+		rq, err := (&Check{Name: "value", Type: "HTTP_JSON_VALUE", HTTPValue: &HTTPValue{
+			Selectors: []*Selector{{Type: "STRING", Path: "status", Assertion: &SelectorAssertion{Equals: &status}}},
+		}}).ToAddRequest()
+		assert.Nil(t, err)
+		assert.Equal(t, "green", rq.GetHttpValue().Selectors[0].Assertion.Equals.Value)
+		assert.Nil(t, rq.GetHttpValue().Selectors[0].Assertion.BoolEquals)
+	})
+	t.Run("Should: keep http assertions", func(t *testing.T) {
+		rq, err := (&Check{Name: "http", Type: "HTTP", HTTP: &HTTP{Assertions: &Assertions{
+			BodyNotContains: []string{"Service unavailable"},
+			Headers:         []*HeaderAssertion{{Name: "Content-Type", Regex: "json"}},
+		}}}).ToAddRequest()
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"Service unavailable"}, rq.GetHttp().Assertions.BodyNotContains)
+		assert.Equal(t, "json", rq.GetHttp().Assertions.Headers[0].Regex)
+	})
+	t.Run("Should: keep http auth and tls", func(t *testing.T) {
+		rq, err := (&Check{Name: "http", Type: "HTTP", HTTP: &HTTP{
+			Auth: &Auth{OAuth2: &OAuth2{TokenURL: "u", ClientID: "id", Scopes: []string{"read"}}},
+			TLS:  &TLS{CABundle: "ca", InsecureSkipVerify: true},
+		}}).ToAddRequest()
+		assert.Nil(t, err)
+		assert.Equal(t, "u", rq.GetHttp().Auth.Oauth2.TokenUrl)
+		assert.Equal(t, []string{"read"}, rq.GetHttp().Auth.Oauth2.Scopes)
+		assert.Equal(t, "ca", rq.GetHttp().Tls.CaBundle)
+		assert.True(t, rq.GetHttp().Tls.InsecureSkipVerify)
+		rq, err = (&Check{Name: "value", Type: "HTTP_JSON_VALUE", HTTPValue: &HTTPValue{Auth: &Auth{BasicUser: "user", BasicPassword: "pass"}}}).ToAddRequest()
+		assert.Nil(t, err)
+		assert.Equal(t, "user", rq.GetHttpValue().Auth.BasicUser)
+		assert.Nil(t, rq.GetHttpValue().Tls)
+	})
+	t.Run("Should: keep request body", func(t *testing.T) {
+		rq, err := (&Check{Name: "post", Type: "HTTP", HTTP: &HTTP{Method: "POST", Body: &Body{
+			Content:     `{"id":"{{uuid}}"}`,
+			ContentType: "application/json",
+		}}}).ToAddRequest()
+		assert.Nil(t, err)
+		assert.Equal(t, `{"id":"{{uuid}}"}`, rq.GetHttp().Body.Content)
+		assert.Equal(t, "application/json", rq.GetHttp().Body.ContentType)
+		rq, err = (&Check{Name: "value", Type: "HTTP_JSON_VALUE", HTTPValue: &HTTPValue{Body: &Body{Content: "a=b"}}}).ToAddRequest()
+		assert.Nil(t, err)
+		assert.Equal(t, "a=b", rq.GetHttpValue().Body.Content)
+	})
+	t.Run("Should: keep send and expect", func(t *testing.T) {
+		rq, err := (&Check{Name: "tcp", Type: "TCP", TCP: &Address{Send: "PING", Expect: "+PONG", ReadTimeout: 100}}).ToAddRequest()
+		assert.Nil(t, err)
+		assert.Equal(t, "PING", rq.GetTcp().Send)
+		assert.Equal(t, "+PONG", rq.GetTcp().Expect)
+		assert.Equal(t, int32(100), rq.GetTcp().ReadTimeout)
+	})
+	t.Run("Should: return error because unknown type", func(t *testing.T) {
+		_, err := (&Check{Name: "a", Type: "SMTP"}).ToAddRequest()
+		assert.ErrorIs(t, err, errUnknownType)
+		_, err = (&Check{Name: "a", Type: "SCHEDULER_TYPE_UNSPECIFIED"}).ToAddRequest()
+		assert.ErrorIs(t, err, errUnknownType)
+	})
+	t.Run("Should: return error because unknown selector type", func(t *testing.T) {
+		_, err := (&Check{Name: "a", Type: "HTTP_JSON_VALUE", HTTPValue: &HTTPValue{
+			Selectors: []*Selector{{Type: "DATE"}},
+		}}).ToAddRequest()
+		assert.ErrorIs(t, err, errUnknownType)
+	})
+	t.Run("Should: keep scenario steps", func(t *testing.T) {
+		rq, err := (&Check{Name: "scenario", Type: "HTTP_SCENARIO", HTTPScenario: &Scenario{Steps: []*ScenarioStep{
+			{Name: "login", Method: "POST", URL: "u", Extract: []*Extract{{Name: "token", Source: "JSON", Expression: "data.token"}}},
+			{Name: "me", URL: "u", Headers: map[string]string{"Authorization": "Bearer {{token}}"}},
+		}}}).ToAddRequest()
+		assert.Nil(t, err)
+		assert.Len(t, rq.GetHttpScenario().Steps, 2)
+		assert.Equal(t, apiPb.HttpScenarioStep_Extract_JSON, rq.GetHttpScenario().Steps[0].Extract[0].Source)
+	})
+	t.Run("Should: return error because unknown extract source", func(t *testing.T) {
+		_, err := (&Check{Name: "a", Type: "HTTP_SCENARIO", HTTPScenario: &Scenario{Steps: []*ScenarioStep{
+			{URL: "u", Extract: []*Extract{{Name: "token", Source: "XPATH"}}},
+		}}}).ToAddRequest()
+		assert.ErrorIs(t, err, errUnknownType)
+	})
+	t.Run("Should: return error because unknown record type", func(t *testing.T) {
+		_, err := (&Check{Name: "a", Type: "DNS", DNS: &DNS{RecordType: "PTR"}}).ToAddRequest()
+		assert.ErrorIs(t, err, errUnknownType)
+	})
+	t.Run("Should: return error because config missing", func(t *testing.T) {
+		for _, schedulerType := range []string{"TCP", "SSL_EXPIRATION", "GRPC", "HTTP", "HTTP_JSON_VALUE", "SITE_MAP", "MONGO", "DNS", "UDP", "REDIS", "HTTP_SCENARIO"} {
+			_, err := (&Check{Name: "a", Type: schedulerType}).ToAddRequest()
+			assert.ErrorIs(t, err, errMissingConfig)
+		}
+	})
+}
+
+func TestFromConfig(t *testing.T) {
+	t.Run("Should: convert every type", func(t *testing.T) {
+		tt := map[*scheduler_config_storage.SchedulerConfig]*Check{
+			{Name: "tcp", Type: apiPb.SchedulerType_TCP, Interval: 10, Labels: map[string]string{"a": "b"}, TCPConfig: &scheduler_config_storage.TCPConfig{Host: "h", Port: 1}}: {
+				Name: "tcp", Type: "TCP", Interval: 10, Labels: map[string]string{"a": "b"}, TCP: &Address{Host: "h", Port: 1},
+			},
+			{Name: "ssl", Type: apiPb.SchedulerType_SSL_EXPIRATION, SslExpirationConfig: &scheduler_config_storage.SslExpirationConfig{Host: "h"}}: {
+				Name: "ssl", Type: "SSL_EXPIRATION", SslExpiration: &Address{Host: "h"},
+			},
+			{Name: "grpc", Type: apiPb.SchedulerType_GRPC, GrpcConfig: &scheduler_config_storage.GrpcConfig{Service: "s", WarningTime: 100}}: {
+				Name: "grpc", Type: "GRPC", Grpc: &Grpc{Service: "s", WarningTime: 100},
+			},
+			{Name: "http", Type: apiPb.SchedulerType_HTTP, Cron: "@hourly", HTTPConfig: &scheduler_config_storage.HTTPConfig{URL: "u"}}: {
+				Name: "http", Type: "HTTP", Cron: "@hourly", HTTP: &HTTP{URL: "u"},
+			},
+			{Name: "assertions", Type: apiPb.SchedulerType_HTTP, HTTPConfig: &scheduler_config_storage.HTTPConfig{Assertions: &scheduler_config_storage.HTTPAssertions{
+				BodyRegex: "^ok",
+				Headers:   []*scheduler_config_storage.HeaderAssertion{{Name: "Server", Equals: "nginx"}},
+			}}}: {
+				Name: "assertions", Type: "HTTP", HTTP: &HTTP{Assertions: &Assertions{
+					BodyRegex: "^ok",
+					Headers:   []*HeaderAssertion{{Name: "Server", Equals: "nginx"}},
+				}},
+			},
+			{Name: "value", Type: apiPb.SchedulerType_HTTP_JSON_VALUE, HTTPValueConfig: &scheduler_config_storage.HTTPValueConfig{
+				Selectors: []*scheduler_config_storage.Selectors{{Type: apiPb.HttpJsonValueConfig_NUMBER, Path: "p"}},
+			}}: {
+				Name: "value", Type: "HTTP_JSON_VALUE", HTTPValue: &HTTPValue{Selectors: []*Selector{{Type: "NUMBER", Path: "p"}}},
+			},
+			{Name: "asserted", Type: apiPb.SchedulerType_HTTP_JSON_VALUE, HTTPValueConfig: &scheduler_config_storage.HTTPValueConfig{
+				Selectors: []*scheduler_config_storage.Selectors{{Type: apiPb.HttpJsonValueConfig_STRING, Path: "p", Assertion: &scheduler_config_storage.SelectorAssertion{Regex: "^ok"}}},
+			}}: {
+				Name: "asserted", Type: "HTTP_JSON_VALUE", HTTPValue: &HTTPValue{Selectors: []*Selector{{Type: "STRING", Path: "p", Assertion: &SelectorAssertion{Regex: "^ok"}}}},
+			},
+			{Name: "post", Type: apiPb.SchedulerType_HTTP_JSON_VALUE, HTTPValueConfig: &scheduler_config_storage.HTTPValueConfig{
+				Body: &scheduler_config_storage.HTTPBody{Content: "{}", ContentType: "application/json"},
+			}}: {
+				Name: "post", Type: "HTTP_JSON_VALUE", HTTPValue: &HTTPValue{Body: &Body{Content: "{}", ContentType: "application/json"}},
+			},
+			{Name: "auth", Type: apiPb.SchedulerType_HTTP, HTTPConfig: &scheduler_config_storage.HTTPConfig{
+				Auth: &scheduler_config_storage.HTTPAuth{OAuth2: &scheduler_config_storage.OAuth2Config{TokenURL: "u", ClientID: "id"}},
+				TLS:  &scheduler_config_storage.HTTPTLS{ClientCert: "cert", ClientKey: "key"},
+			}}: {
+				Name: "auth", Type: "HTTP", HTTP: &HTTP{
+					Auth: &Auth{OAuth2: &OAuth2{TokenURL: "u", ClientID: "id"}},
+					TLS:  &TLS{ClientCert: "cert", ClientKey: "key"},
+				},
+			},
+			{Name: "bearer", Type: apiPb.SchedulerType_HTTP_JSON_VALUE, HTTPValueConfig: &scheduler_config_storage.HTTPValueConfig{
+				Auth: &scheduler_config_storage.HTTPAuth{BearerToken: "t"},
+			}}: {
+				Name: "bearer", Type: "HTTP_JSON_VALUE", HTTPValue: &HTTPValue{Auth: &Auth{BearerToken: "t"}},
+			},
+			{Name: "sitemap", Type: apiPb.SchedulerType_SITE_MAP, SiteMapConfig: &scheduler_config_storage.SiteMapConfig{Concurrency: 2}}: {
+				Name: "sitemap", Type: "SITE_MAP", SiteMap: &SiteMap{Concurrency: 2},
+			},
+			{Name: "udp", Type: apiPb.SchedulerType_UDP, UDPConfig: &scheduler_config_storage.TCPConfig{Host: "h", Send: "ping", ExpectRegex: "^pong"}}: {
+				Name: "udp", Type: "UDP", UDP: &Address{Host: "h", Send: "ping", ExpectRegex: "^pong"},
+			},
+			{Name: "redis", Type: apiPb.SchedulerType_REDIS, RedisConfig: &scheduler_config_storage.RedisConfig{Host: "h", TLS: true, MaxKeys: 10}}: {
+				Name: "redis", Type: "REDIS", Redis: &Redis{Host: "h", TLS: true, MaxKeys: 10},
+			},
+			{Name: "scenario", Type: apiPb.SchedulerType_HTTP_SCENARIO, HTTPScenarioConfig: &scheduler_config_storage.HTTPScenarioConfig{
+				Steps: []*scheduler_config_storage.HTTPScenarioStep{{
+					URL:     "u",
+					Extract: []*scheduler_config_storage.HTTPExtract{{Name: "id", Source: apiPb.HttpScenarioStep_Extract_HEADER, Expression: "X-Id"}},
+				}},
+			}}: {
+				Name: "scenario", Type: "HTTP_SCENARIO", HTTPScenario: &Scenario{Steps: []*ScenarioStep{{
+					URL:     "u",
+					Extract: []*Extract{{Name: "id", Source: "HEADER", Expression: "X-Id"}},
+				}}},
+			},
+			{Name: "dns", Type: apiPb.SchedulerType_DNS, DNSConfig: &scheduler_config_storage.DNSConfig{Name: "n", RecordType: apiPb.DnsConfig_MX, MaxTTL: 60}}: {
+				Name: "dns", Type: "DNS", DNS: &DNS{Name: "n", RecordType: "MX", MaxTTL: 60},
+			},
+			{Name: "mysql", Type: apiPb.SchedulerType_MYSQL, Db: &scheduler_config_storage.DbConfig{DbName: "db"}}: {
+				Name: "mysql", Type: "MYSQL", Db: &Db{DbName: "db"},
+			},
+			{Name: "probe", Type: apiPb.SchedulerType_MONGO, Locations: []string{"eu"}, MinFailedLocations: 1}: {
+				Name: "probe", Type: "MONGO", Locations: []string{"eu"}, MinFailedLocations: 1,
+			},
+			{Name: "failing", Type: apiPb.SchedulerType_MONGO, FailureInterval: 15, RecoverAfter: 3, RecoverLeft: 2}: {
+				Name: "failing", Type: "MONGO", FailureInterval: 15, RecoverAfter: 3,
+			},
+			{Name: "flapping", Type: apiPb.SchedulerType_MONGO, FlapDetection: &scheduler_config_storage.FlapDetection{Window: 10}}: {
+				Name: "flapping", Type: "MONGO", FlapDetection: &FlapDetection{Window: 10},
+			},
+			{Name: "retry", Type: apiPb.SchedulerType_MONGO, RetryPolicy: &scheduler_config_storage.RetryPolicy{Attempts: 2, BackoffMultiplier: 1.5}}: {
+				Name: "retry", Type: "MONGO", RetryPolicy: &RetryPolicy{Attempts: 2, BackoffMultiplier: 1.5},
+			},
+		}
+		for config, expected := range tt {
+			assert.Equal(t, expected, FromConfig(config))
+		}
+	})
+	t.Run("Should: convert parent ids", func(t *testing.T) {
+		parentID, _ := primitive.ObjectIDFromHex("5f0f3a3d1c9d440000a1b2c3")
+		check := FromConfig(&scheduler_config_storage.SchedulerConfig{
+			Name:      "tcp",
+			Type:      apiPb.SchedulerType_TCP,
+			ParentIDs: []primitive.ObjectID{parentID},
+		})
+		assert.Equal(t, []string{"5f0f3a3d1c9d440000a1b2c3"}, check.ParentIDs)
+	})
+}
+
+func TestPlan(t *testing.T) {
+	current := []*Entry{
+		{ID: "1", Check: &Check{Name: "same", Type: "TCP", Interval: 10, TCP: &Address{Host: "a"}}},
+		{ID: "2", Check: &Check{Name: "changed", Type: "TCP", Interval: 10, TCP: &Address{Host: "a"}}},
+		{ID: "3", Check: &Check{Name: "removed", Type: "TCP", Interval: 10, TCP: &Address{Host: "a"}}},
+		{ID: "4", Check: &Check{Name: "same", Type: "TCP", Interval: 10, TCP: &Address{Host: "a"}}},
+	}
+	desired := []*Check{
+		{Name: "same", Type: "TCP", Interval: 10, TCP: &Address{Host: "a"}},
+		{Name: "changed", Type: "TCP", Interval: 20, TCP: &Address{Host: "b"}},
+		{Name: "created", Type: "TCP", Interval: 10, TCP: &Address{Host: "a"}},
+	}
+	t.Run("Should: plan create, update and remove", func(t *testing.T) {
+		changes := Plan(current, desired)
+		assert.Equal(t, []*Change{
+			{
+				Action: apiPb.SchedulerChange_UPDATE,
+				ID:     "2",
+				Check:  desired[1],
+				Diff:   []string{"interval: 10 -> 20", "tcp.host: a -> b"},
+			},
+			{
+				Action: apiPb.SchedulerChange_CREATE,
+				Check:  desired[2],
+			},
+			{
+				Action: apiPb.SchedulerChange_REMOVE,
+				ID:     "3",
+				Check:  current[2].Check,
+			},
+			{
+				Action: apiPb.SchedulerChange_REMOVE,
+				ID:     "4",
+				Check:  current[3].Check,
+			},
+		}, changes)
+	})
+	t.Run("Should: plan nothing for same checks", func(t *testing.T) {
+		assert.Len(t, Plan(current[:1], desired[:1]), 0)
+	})
+}
+
+func TestDiff(t *testing.T) {
+	t.Run("Should: not show password", func(t *testing.T) {
+		diff := Diff(
+			&Check{Name: "db", Type: "MYSQL", Db: &Db{Password: "old"}},
+			&Check{Name: "db", Type: "MYSQL", Cron: "@daily", Db: &Db{Password: "new"}},
+		)
+		assert.Equal(t, []string{"cron: <none> -> @daily", "db.password: changed"}, diff)
+	})
+	t.Run("Should: not show http secrets", func(t *testing.T) {
+		diff := Diff(
+			&Check{Name: "http", Type: "HTTP", HTTP: &HTTP{Auth: &Auth{BearerToken: "old"}}},
+			&Check{Name: "http", Type: "HTTP", HTTP: &HTTP{
+				Auth: &Auth{OAuth2: &OAuth2{TokenURL: "u", ClientID: "id", ClientSecret: "new"}},
+				TLS:  &TLS{ClientKey: "key"},
+			}},
+		)
+		assert.Equal(t, []string{
+			"http.auth.bearerToken: changed",
+			"http.auth.oauth2.clientId: <none> -> id",
+			"http.auth.oauth2.clientSecret: changed",
+			"http.auth.oauth2.tokenUrl: <none> -> u",
+			"http.tls.clientKey: changed",
+		}, diff)
+	})
+	t.Run("Should: show changed selectors and headers", func(t *testing.T) {
+		diff := Diff(
+			&Check{Name: "v", HTTPValue: &HTTPValue{Selectors: []*Selector{{Type: "STRING", Path: "a"}}}},
+			&Check{Name: "v", HTTPValue: &HTTPValue{
+				Headers:   map[string]string{"X-Token": "t"},
+				Selectors: []*Selector{{Type: "STRING", Path: "b"}},
+			}},
+		)
+		assert.Equal(t, []string{"httpValue.headers.X-Token: <none> -> t", "httpValue.selectors[0].path: a -> b"}, diff)
+	})
+}
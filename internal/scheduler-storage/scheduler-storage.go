@@ -15,6 +15,8 @@ type SchedulerStorage interface {
 	Get(string) (scheduler.Scheduler, error)
 	Set(scheduler.Scheduler) error
 	Remove(string) error
+	// Return snapshot of all schedulers
+	GetAll() []scheduler.Scheduler
 }
 
 type storage struct {
@@ -58,6 +60,16 @@ func (s *storage) Remove(id string) error {
 	return nil
 }
 
+func (s *storage) GetAll() []scheduler.Scheduler {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	res := make([]scheduler.Scheduler, 0, len(s.kv))
+	for _, value := range s.kv {
+		res = append(res, value)
+	}
+	return res
+}
+
 func New() SchedulerStorage {
 	return &storage{
 		kv: make(map[string]scheduler.Scheduler),
@@ -1,6 +1,7 @@
 package scheduler_storage
 
 import (
+	"github.com/squzy/squzy/internal/scheduler"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"testing"
@@ -28,6 +29,10 @@ func (s schedulerMock) IsRun() bool {
 	return true
 }
 
+func (s schedulerMock) GetSchedule() string {
+	return ""
+}
+
 func TestNew(t *testing.T) {
 	t.Run("Shoudle: create storage", func(t *testing.T) {
 		s := New()
@@ -92,3 +97,13 @@ func TestStorage_Remove(t *testing.T) {
 		assert.Equal(t, nil, err)
 	})
 }
+
+func TestStorage_GetAll(t *testing.T) {
+	t.Run("Should: return all schedulers", func(t *testing.T) {
+		s := New()
+		assert.Len(t, s.GetAll(), 0)
+		err := s.Set(&schedulerMock{})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, []scheduler.Scheduler{&schedulerMock{}}, s.GetAll())
+	})
+}
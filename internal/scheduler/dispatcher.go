@@ -0,0 +1,320 @@
+package scheduler
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"github.com/squzy/squzy/apps/squzy_monitoring/config"
+	"github.com/squzy/squzy/internal/cache"
+	job_executor "github.com/squzy/squzy/internal/job-executor"
+	"github.com/squzy/squzy/internal/logger"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sync"
+	"time"
+)
+
+const (
+	// How long dispatcher sleeps when nothing is planned
+	idleWait = time.Hour
+)
+
+// Dispatcher keeps next runs of all schedulers in one min-heap, wakes up at the nearest one,
+// claims all due ticks in cache with one batch and hands claimed jobs to executor
+type Dispatcher interface {
+	// Should stop dispatching, planned schedulers stay in cache
+	Stop()
+	job_executor.RecoveryListener
+	schedule(s *schl) error
+	unschedule(id primitive.ObjectID)
+}
+
+type entry struct {
+	schl *schl
+	// Next run as it stored in cache, used for compare-and-set
+	scheduled time.Time
+	// When dispatcher should wake up for entry, differs from scheduled only for retries
+	wakeAt time.Time
+	// Last known state of check with failure interval
+	failing bool
+	index   int
+}
+
+type queue []*entry
+
+func (q queue) Len() int { return len(q) }
+
+func (q queue) Less(i, j int) bool { return q[i].wakeAt.Before(q[j].wakeAt) }
+
+func (q queue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *queue) Push(x interface{}) {
+	e := x.(*entry)
+	e.index = len(*q)
+	*q = append(*q, e)
+}
+
+func (q *queue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	e := old[n-1]
+	old[n-1] = nil
+	e.index = -1
+	*q = old[:n-1]
+	return e
+}
+
+type dispatcher struct {
+	mutex       sync.Mutex
+	queue       queue
+	entries     map[primitive.ObjectID]*entry
+	wakeCh      chan struct{}
+	quitCh      chan struct{}
+	stopOnce    sync.Once
+	jobExecutor job_executor.JobExecutor
+	cache       cache.Cache
+}
+
+// Executor which reports state of failing checks gets dispatcher as listener
+func NewDispatcher(
+	jobExecutor job_executor.JobExecutor,
+	cache cache.Cache,
+) Dispatcher {
+	d := &dispatcher{
+		entries:     map[primitive.ObjectID]*entry{},
+		wakeCh:      make(chan struct{}, 1),
+		quitCh:      make(chan struct{}),
+		jobExecutor: jobExecutor,
+		cache:       cache,
+	}
+	if notifier, ok := jobExecutor.(job_executor.RecoveryNotifier); ok {
+		notifier.SetRecoveryListener(d)
+	}
+	go d.loop()
+	return d
+}
+
+func (d *dispatcher) schedule(s *schl) error {
+	res, err := d.cache.GetScheduleById(&apiPb.GetScheduleWithIdRequest{
+		Id: s.id.Hex(),
+	})
+	if err != nil && !errors.Is(err, cache.ErrScheduleNotFound) {
+		return fmt.Errorf("could not insert during run: %w", err)
+	}
+
+	next := time.Time{}
+	if res.GetScheduledNext() != nil {
+		// Other replica or previous run already planned it
+		next = res.GetScheduledNext().AsTime()
+	} else {
+		next = s.nextRun(time.Now(), s.recovering)
+		err := d.cache.InsertSchedule(&apiPb.InsertScheduleWithIdRequest{
+			Id:            s.id.Hex(),
+			ScheduledNext: timestamppb.New(next),
+		})
+		if err != nil {
+			return fmt.Errorf("could not insert during run: %w", err)
+		}
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.remove(s.id)
+	e := &entry{
+		schl:      s,
+		scheduled: next,
+		wakeAt:    next,
+		failing:   s.recovering,
+	}
+	d.entries[s.id] = e
+	heap.Push(&d.queue, e)
+	d.wake()
+	return nil
+}
+
+func (d *dispatcher) unschedule(id primitive.ObjectID) {
+	d.mutex.Lock()
+	d.remove(id)
+	d.wake()
+	d.mutex.Unlock()
+
+	err := d.cache.DeleteScheduleById(&apiPb.DeleteScheduleWithIdRequest{
+		Id: id.Hex(),
+	})
+	if err != nil {
+		logger.Info("could not delete schedule by id: " + err.Error())
+	}
+}
+
+func (d *dispatcher) Stop() {
+	d.stopOnce.Do(func() {
+		close(d.quitCh)
+	})
+}
+
+// Should be called under lock
+func (d *dispatcher) remove(id primitive.ObjectID) {
+	e, ok := d.entries[id]
+	if !ok {
+		return
+	}
+	delete(d.entries, id)
+	if e.index >= 0 {
+		heap.Remove(&d.queue, e.index)
+	}
+}
+
+// Reschedule moves next run of check with failure interval after its result, so failure interval
+// is used right after first failure and usual schedule is back right after recovery
+func (d *dispatcher) Reschedule(schedulerID primitive.ObjectID, failing bool) {
+	now := time.Now()
+	d.mutex.Lock()
+	e, ok := d.entries[schedulerID]
+	if !ok || e.schl.failureInterval <= 0 {
+		d.mutex.Unlock()
+		return
+	}
+	wasFailing := e.failing
+	e.failing = failing
+	current := e.scheduled
+	next := e.schl.nextRun(now, failing)
+	// Entry in the middle of dispatch would be planned by dispatch itself
+	skip := e.index < 0 || (!failing && !wasFailing) || (failing && !next.Before(current))
+	d.mutex.Unlock()
+	if skip {
+		return
+	}
+
+	res, err := d.cache.ClaimSchedules([]*apiPb.ClaimScheduleWithIdRequest{
+		{
+			Id:               schedulerID.Hex(),
+			ScheduledCurrent: timestamppb.New(current),
+			ScheduledNext:    timestamppb.New(next),
+		},
+	})
+	if err != nil {
+		logger.Error("could not reschedule " + schedulerID.Hex() + ": " + err.Error())
+		return
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if d.entries[schedulerID] != e || e.index < 0 || !e.scheduled.Equal(current) {
+		return
+	}
+	switch {
+	case res[0].GetClaimed():
+		e.scheduled = next
+	case res[0].GetScheduledNext() != nil:
+		// Other replica already moved it, follow its plan
+		e.scheduled = res[0].GetScheduledNext().AsTime()
+	default:
+		return
+	}
+	e.wakeAt = e.scheduled
+	heap.Fix(&d.queue, e.index)
+	d.wake()
+}
+
+func (d *dispatcher) wake() {
+	select {
+	case d.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+func (d *dispatcher) loop() {
+	for {
+		wait := idleWait
+		d.mutex.Lock()
+		if len(d.queue) > 0 {
+			wait = time.Until(d.queue[0].wakeAt)
+		}
+		d.mutex.Unlock()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+			d.dispatch(time.Now())
+		case <-d.wakeCh:
+			timer.Stop()
+		case <-d.quitCh:
+			timer.Stop()
+			return
+		}
+	}
+}
+
+func (d *dispatcher) dispatch(now time.Time) {
+	d.mutex.Lock()
+	due := []*entry{}
+	for len(d.queue) > 0 && !d.queue[0].wakeAt.After(now) {
+		due = append(due, heap.Pop(&d.queue).(*entry))
+	}
+	// Result of check could change it, executor reschedules it then
+	nextRuns := make([]time.Time, len(due))
+	for i, e := range due {
+		nextRuns[i] = e.schl.nextRun(now, e.failing)
+	}
+	d.mutex.Unlock()
+
+	if len(due) == 0 {
+		return
+	}
+
+	claims := make([]*apiPb.ClaimScheduleWithIdRequest, len(due))
+	for i, e := range due {
+		claims[i] = &apiPb.ClaimScheduleWithIdRequest{
+			Id:               e.schl.id.Hex(),
+			ScheduledCurrent: timestamppb.New(e.scheduled),
+			ScheduledNext:    timestamppb.New(nextRuns[i]),
+		}
+	}
+	res, err := d.cache.ClaimSchedules(claims)
+	if err != nil {
+		logger.Error("could not claim schedules" + err.Error())
+	}
+
+	missing := []*apiPb.InsertScheduleWithIdRequest{}
+	d.mutex.Lock()
+	for i, e := range due {
+		if d.entries[e.schl.id] != e {
+			// Stopped while claiming
+			continue
+		}
+		switch {
+		case err != nil:
+			e.wakeAt = now.Add(config.SmallestInterval)
+		case res[i].GetClaimed():
+			go d.jobExecutor.Execute(e.schl.id)
+			e.scheduled = nextRuns[i]
+			e.wakeAt = e.scheduled
+		case res[i].GetScheduledNext() != nil:
+			// Tick was claimed by other replica, follow its plan
+			e.scheduled = res[i].GetScheduledNext().AsTime()
+			e.wakeAt = e.scheduled
+		default:
+			// Schedule was removed from cache like after cache restart, plan it again as schedule does
+			e.scheduled = nextRuns[i]
+			e.wakeAt = e.scheduled
+			missing = append(missing, &apiPb.InsertScheduleWithIdRequest{
+				Id:            e.schl.id.Hex(),
+				ScheduledNext: timestamppb.New(nextRuns[i]),
+			})
+		}
+		heap.Push(&d.queue, e)
+	}
+	d.mutex.Unlock()
+
+	// Not inserted schedule is missing on next claim too, so it is inserted again then
+	for _, rq := range missing {
+		if errI := d.cache.InsertSchedule(rq); errI != nil {
+			logger.Error("could not insert schedule " + rq.Id + ": " + errI.Error())
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package scheduler
+
+import (
+	"container/heap"
+	"github.com/squzy/squzy/apps/squzy_monitoring/config"
+	"github.com/squzy/squzy/internal/cache"
+	job_executor "github.com/squzy/squzy/internal/job-executor"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sync"
+	"testing"
+	"time"
+)
+
+type cacheMockBatches struct {
+	cacheMock
+	mutex   *sync.Mutex
+	batches *[]int
+}
+
+func (c cacheMockBatches) ClaimSchedules(data []*apiPb.ClaimScheduleWithIdRequest) ([]*apiPb.ClaimScheduleWithIdResponse, error) {
+	c.mutex.Lock()
+	*c.batches = append(*c.batches, len(data))
+	c.mutex.Unlock()
+	return c.cacheMock.ClaimSchedules(data)
+}
+
+type orderedExecutor struct {
+	mutex sync.Mutex
+	ids   []primitive.ObjectID
+}
+
+func (o *orderedExecutor) Execute(schedulerId primitive.ObjectID) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	o.ids = append(o.ids, schedulerId)
+}
+
+type cacheMockOtherPlan struct {
+	cacheMock
+	next time.Time
+}
+
+func (c cacheMockOtherPlan) ClaimSchedules(data []*apiPb.ClaimScheduleWithIdRequest) ([]*apiPb.ClaimScheduleWithIdResponse, error) {
+	return []*apiPb.ClaimScheduleWithIdResponse{
+		{ScheduledNext: timestamppb.New(c.next)},
+	}, nil
+}
+
+type notifierExecutor struct {
+	jobExecutor
+	listener job_executor.RecoveryListener
+}
+
+func (n *notifierExecutor) SetRecoveryListener(listener job_executor.RecoveryListener) {
+	n.listener = listener
+}
+
+func TestNewDispatcher(t *testing.T) {
+	t.Run("Should: implement interface", func(t *testing.T) {
+		d := NewDispatcher(nil, nil)
+		defer d.Stop()
+		assert.Implements(t, (*Dispatcher)(nil), d)
+	})
+	t.Run("Should: listen state of failing checks", func(t *testing.T) {
+		executor := &notifierExecutor{}
+		d := NewDispatcher(executor, nil)
+		defer d.Stop()
+		assert.Equal(t, d, executor.listener)
+	})
+}
+
+func TestDispatcher_Stop(t *testing.T) {
+	t.Run("Should: stop twice without panic", func(t *testing.T) {
+		d := NewDispatcher(nil, nil)
+		d.Stop()
+		d.Stop()
+	})
+}
+
+func TestDispatcher_dispatch(t *testing.T) {
+	t.Run("Should: claim all due schedulers with one batch", func(t *testing.T) {
+		batches := []int{}
+		mutex := &sync.Mutex{}
+		store := &jobExecutor{}
+		d := NewDispatcher(store, &cacheMockBatches{
+			cacheMock: cacheMock{time.Now(), 300 * time.Millisecond},
+			mutex:     mutex,
+			batches:   &batches,
+		})
+		defer d.Stop()
+		for i := 0; i < 5; i++ {
+			s, _ := New(primitive.NewObjectID(), time.Minute, d)
+			assert.Nil(t, s.Run())
+		}
+		time.Sleep(config.SmallestInterval)
+		mutex.Lock()
+		defer mutex.Unlock()
+		assert.Equal(t, []int{5}, batches)
+		assert.Equal(t, 5, store.getCount())
+	})
+	t.Run("Should: wake up at nearest scheduler first", func(t *testing.T) {
+		first := primitive.NewObjectID()
+		second := primitive.NewObjectID()
+		store := &orderedExecutor{}
+		d := NewDispatcher(store, &cacheMock{}).(*dispatcher)
+		defer d.Stop()
+		now := time.Now()
+		d.mutex.Lock()
+		for _, e := range []*entry{
+			{schl: &schl{id: second, interval: time.Minute}, scheduled: now.Add(400 * time.Millisecond)},
+			{schl: &schl{id: first, interval: time.Minute}, scheduled: now.Add(200 * time.Millisecond)},
+		} {
+			e.wakeAt = e.scheduled
+			d.entries[e.schl.id] = e
+			heap.Push(&d.queue, e)
+		}
+		d.mutex.Unlock()
+		d.wake()
+		time.Sleep(config.SmallestInterval)
+		store.mutex.Lock()
+		defer store.mutex.Unlock()
+		assert.Equal(t, []primitive.ObjectID{first, second}, store.ids)
+	})
+	t.Run("Should: plan schedule again after it removed from cache", func(t *testing.T) {
+		memoryCache, err := cache.NewMemory("")
+		assert.Nil(t, err)
+		store := &jobExecutor{}
+		d := NewDispatcher(store, memoryCache)
+		defer d.Stop()
+		id := primitive.NewObjectID()
+		s, err := New(id, time.Second, d)
+		assert.Nil(t, err)
+		assert.Nil(t, s.Run())
+		defer s.Stop()
+		// Like cache was restarted
+		assert.Nil(t, memoryCache.DeleteScheduleById(&apiPb.DeleteScheduleWithIdRequest{Id: id.Hex()}))
+		time.Sleep(time.Second + config.SmallestInterval)
+		assert.Equal(t, 0, store.getCount())
+		res, err := memoryCache.GetScheduleById(&apiPb.GetScheduleWithIdRequest{Id: id.Hex()})
+		assert.Nil(t, err)
+		assert.NotNil(t, res.GetScheduledNext())
+		time.Sleep(time.Second)
+		assert.Equal(t, 1, store.getCount())
+	})
+}
+
+func TestDispatcher_Reschedule(t *testing.T) {
+	newDispatcher := func(c cache.Cache, failing bool) (*dispatcher, *entry) {
+		d := NewDispatcher(nil, c).(*dispatcher)
+		e := &entry{
+			schl:      &schl{id: primitive.NewObjectID(), interval: time.Minute, failureInterval: time.Second * 15},
+			scheduled: time.Now().Add(time.Minute),
+			failing:   failing,
+		}
+		e.wakeAt = e.scheduled
+		d.mutex.Lock()
+		d.entries[e.schl.id] = e
+		heap.Push(&d.queue, e)
+		d.mutex.Unlock()
+		return d, e
+	}
+	t.Run("Should: use failure interval right after failure", func(t *testing.T) {
+		d, e := newDispatcher(&cacheMock{}, false)
+		defer d.Stop()
+		d.Reschedule(e.schl.id, true)
+		d.mutex.Lock()
+		defer d.mutex.Unlock()
+		assert.True(t, e.failing)
+		assert.WithinDuration(t, time.Now().Add(time.Second*15), e.scheduled, time.Second)
+		assert.Equal(t, e.scheduled, e.wakeAt)
+	})
+	t.Run("Should: use usual schedule right after recovery", func(t *testing.T) {
+		d, e := newDispatcher(&cacheMock{}, true)
+		defer d.Stop()
+		d.mutex.Lock()
+		e.scheduled = time.Now().Add(time.Second * 15)
+		d.mutex.Unlock()
+		d.Reschedule(e.schl.id, false)
+		d.mutex.Lock()
+		defer d.mutex.Unlock()
+		assert.False(t, e.failing)
+		assert.WithinDuration(t, time.Now().Add(time.Minute), e.scheduled, time.Second)
+	})
+	t.Run("Should: keep plan of healthy check", func(t *testing.T) {
+		d, e := newDispatcher(&cacheMockErr{}, false)
+		defer d.Stop()
+		scheduled := e.scheduled
+		d.Reschedule(e.schl.id, false)
+		d.Reschedule(primitive.NewObjectID(), true)
+		d.mutex.Lock()
+		defer d.mutex.Unlock()
+		assert.Equal(t, scheduled, e.scheduled)
+	})
+	t.Run("Should: follow plan of other replica", func(t *testing.T) {
+		next := time.Now().Add(time.Second * 5).Truncate(time.Second)
+		d, e := newDispatcher(&cacheMockOtherPlan{next: next}, false)
+		defer d.Stop()
+		d.Reschedule(e.schl.id, true)
+		d.mutex.Lock()
+		defer d.mutex.Unlock()
+		assert.True(t, next.Equal(e.scheduled))
+	})
+	t.Run("Should: keep plan when cache is not available", func(t *testing.T) {
+		d, e := newDispatcher(&cacheMockErr{}, false)
+		defer d.Stop()
+		scheduled := e.scheduled
+		d.Reschedule(e.schl.id, true)
+		d.mutex.Lock()
+		defer d.mutex.Unlock()
+		assert.Equal(t, scheduled, e.scheduled)
+		assert.True(t, e.failing)
+	})
+	t.Run("Should: use failure interval on claim while check is failing", func(t *testing.T) {
+		d, e := newDispatcher(&cacheMock{}, true)
+		defer d.Stop()
+		d.mutex.Lock()
+		e.wakeAt = time.Now()
+		heap.Fix(&d.queue, e.index)
+		d.mutex.Unlock()
+		d.jobExecutor = &jobExecutor{}
+		now := time.Now()
+		d.dispatch(now)
+		d.mutex.Lock()
+		defer d.mutex.Unlock()
+		assert.Equal(t, now.Add(time.Second*15), e.scheduled)
+	})
+}
@@ -3,19 +3,21 @@ package scheduler
 import (
 	"errors"
 	"fmt"
-	"github.com/go-redis/redis/v8"
+	"github.com/robfig/cron/v3"
 	"github.com/squzy/squzy/apps/squzy_monitoring/config"
-	"github.com/squzy/squzy/internal/cache"
-	job_executor "github.com/squzy/squzy/internal/job-executor"
-	"github.com/squzy/squzy/internal/logger"
-	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/squzy/squzy/internal/helpers"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
 	"go.mongodb.org/mongo-driver/bson/primitive"
-	"google.golang.org/protobuf/types/known/timestamppb"
+	"sync"
 	"time"
 )
 
 var (
 	errIntervalLessHalfSecondError = errors.New("INTERVAL_LESS_THAN_HALF_SECOND")
+	errInvalidCronExpressionError  = errors.New("INVALID_CRON_EXPRESSION")
+	cronParser                     = cron.NewParser(
+		cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
+	)
 )
 
 type Scheduler interface {
@@ -29,96 +31,115 @@ type Scheduler interface {
 	Stop()
 	// Return true/false depends from current state
 	IsRun() bool
+	// Return interval or cron expression, same value means same ticks
+	GetSchedule() string
 }
 
 type schl struct {
-	ticker      *time.Ticker
-	isStopped   bool
-	quitCh      chan bool
-	interval    time.Duration
-	id          primitive.ObjectID
-	jobExecutor job_executor.JobExecutor
-	cache       cache.Cache
+	// Run and Stop are called by API and resync at same time, state is changed with dispatcher under lock
+	mutex      sync.Mutex
+	isStopped  bool
+	interval   time.Duration
+	schedule   cron.Schedule
+	expression string
+	// Used instead of interval or cron while check is failing, 0 means never
+	failureInterval time.Duration
+	// Check was failing when scheduler was created, dispatcher keeps actual state
+	recovering bool
+	id         primitive.ObjectID
+	dispatcher Dispatcher
 }
 
-func New(id primitive.ObjectID, interval time.Duration, jobExecutor job_executor.JobExecutor, cache cache.Cache) (Scheduler, error) {
+func New(id primitive.ObjectID, interval time.Duration, dispatcher Dispatcher) (Scheduler, error) {
 	if interval < config.SmallestInterval {
 		return nil, errIntervalLessHalfSecondError
 	}
 	return &schl{
-		id:          id,
-		interval:    interval,
-		isStopped:   true,
-		jobExecutor: jobExecutor,
-		cache:       cache,
+		id:         id,
+		interval:   interval,
+		isStopped:  true,
+		dispatcher: dispatcher,
 	}, nil
 }
 
+// NewCron create scheduler which calculate next run from cron expression
+// like "0 9 * * 1-5" or "*/5 8-19 * * *", expression without CRON_TZ evaluated in UTC
+func NewCron(id primitive.ObjectID, expression string, dispatcher Dispatcher) (Scheduler, error) {
+	schedule, err := cronParser.Parse(expression)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errInvalidCronExpressionError, err.Error())
+	}
+	return &schl{
+		id:         id,
+		schedule:   schedule,
+		expression: expression,
+		isStopped:  true,
+		dispatcher: dispatcher,
+	}, nil
+}
+
+// NewFromConfig create scheduler depends on config, cron expression has priority over interval
+func NewFromConfig(config *scheduler_config_storage.SchedulerConfig, dispatcher Dispatcher) (Scheduler, error) {
+	var sched Scheduler
+	var err error
+	if config.Cron != "" {
+		sched, err = NewCron(config.ID, config.Cron, dispatcher)
+	} else {
+		sched, err = New(config.ID, helpers.DurationFromSecond(config.Interval), dispatcher)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if config.FailureInterval > 0 {
+		sched.(*schl).failureInterval = helpers.DurationFromSecond(config.FailureInterval)
+		sched.(*schl).recovering = config.RecoverLeft > 0
+	}
+	return sched, nil
+}
+
 func (s *schl) Run() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
 	if !s.isStopped {
 		return nil
 	}
-	next, err := s.cache.GetScheduleById(&apiPb.GetScheduleWithIdRequest{
-		Id: s.id.Hex(),
-	})
-	if err != redis.Nil && err != nil {
-		return fmt.Errorf("could not insert during run: %w", err)
-	}
-
-	if next.GetScheduledNext() == nil {
-		err := s.cache.InsertSchedule(&apiPb.InsertScheduleWithIdRequest{
-			Id:            s.id.Hex(),
-			ScheduledNext: timestamppb.New(time.Now().Add(s.interval)),
-		})
-		if err != nil {
-			return fmt.Errorf("could not insert during run: %w", err)
-		}
+	err := s.dispatcher.schedule(s)
+	if err != nil {
+		return err
 	}
-	s.ticker = time.NewTicker(config.SmallestInterval)
 	s.isStopped = false
-	s.quitCh = make(chan bool, 1)
-	s.observer()
 	return nil
 }
 
-func (s *schl) observer() {
-	go func() {
-	loop:
-		for {
-			select {
-			case <-s.ticker.C:
-				res, err := s.cache.GetScheduleById(&apiPb.GetScheduleWithIdRequest{
-					Id: s.id.Hex(),
-				})
-				if err != nil {
-					logger.Error("could not get schedule" + err.Error())
-					continue
-				}
-
-				next := res.ScheduledNext.AsTime()
-				now := time.Now()
-
-				if now.In(time.UTC).After(next) {
-					s.jobExecutor.Execute(s.id)
-					err = s.cache.InsertSchedule(&apiPb.InsertScheduleWithIdRequest{
-						Id:            s.id.Hex(),
-						ScheduledNext: timestamppb.New(now.Add(s.interval)),
-					})
-					if err != nil {
-						logger.Error("could not update schedule" + err.Error())
-					}
-				}
-			case <-s.quitCh:
-				break loop
-			}
-		}
-	}()
+// Failing check is executed with failure interval, unless usual schedule is sooner
+func (s *schl) nextRun(now time.Time, failing bool) time.Time {
+	next := now.Add(s.interval)
+	if s.schedule != nil {
+		next = s.schedule.Next(now.In(time.UTC))
+	}
+	if failing && s.failureInterval > 0 && now.Add(s.failureInterval).Before(next) {
+		return now.Add(s.failureInterval)
+	}
+	return next
 }
 
 func (s *schl) IsRun() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
 	return !s.isStopped
 }
 
+func (s *schl) GetSchedule() string {
+	schedule := s.interval.String()
+	if s.schedule != nil {
+		schedule = s.expression
+	}
+	if s.failureInterval > 0 {
+		schedule += ", " + s.failureInterval.String() + " while failing"
+	}
+	return schedule
+}
+
 func (s *schl) GetID() string {
 	return s.id.Hex()
 }
@@ -128,17 +149,11 @@ func (s *schl) GetIDBson() primitive.ObjectID {
 }
 
 func (s *schl) Stop() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
 	if s.isStopped {
 		return
 	}
-	err := s.cache.DeleteScheduleById(&apiPb.DeleteScheduleWithIdRequest{
-		Id: s.id.Hex(),
-	})
-	if err != nil {
-		logger.Info("could not delete schedule by id: " + err.Error())
-	}
-	s.ticker.Stop()
-	s.quitCh <- true
-	close(s.quitCh)
+	s.dispatcher.unschedule(s.id)
 	s.isStopped = true
 }
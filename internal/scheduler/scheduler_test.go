@@ -3,10 +3,13 @@ package scheduler
 import (
 	"errors"
 	"github.com/squzy/squzy/apps/squzy_monitoring/config"
+	"github.com/squzy/squzy/internal/cache"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"sync"
 	"testing"
 	"time"
 )
@@ -31,15 +34,19 @@ func (c cacheMock) DeleteScheduleById(data *apiPb.DeleteScheduleWithIdRequest) e
 	return nil
 }
 
-type cacheMockErrDelete struct {
-}
-
-func (c cacheMockErrDelete) InsertSchedule(data *apiPb.InsertScheduleWithIdRequest) error {
-	return nil
+func (c cacheMock) ClaimSchedules(data []*apiPb.ClaimScheduleWithIdRequest) ([]*apiPb.ClaimScheduleWithIdResponse, error) {
+	res := make([]*apiPb.ClaimScheduleWithIdResponse, len(data))
+	for i := range data {
+		res[i] = &apiPb.ClaimScheduleWithIdResponse{
+			Claimed:       true,
+			ScheduledNext: data[i].ScheduledNext,
+		}
+	}
+	return res, nil
 }
 
-func (c cacheMockErrDelete) GetScheduleById(data *apiPb.GetScheduleWithIdRequest) (*apiPb.GetScheduleWithIdResponse, error) {
-	return nil, nil
+type cacheMockErrDelete struct {
+	cacheMock
 }
 
 func (c cacheMockErrDelete) DeleteScheduleById(data *apiPb.DeleteScheduleWithIdRequest) error {
@@ -61,169 +68,287 @@ func (c cacheMockErr) DeleteScheduleById(data *apiPb.DeleteScheduleWithIdRequest
 	return errors.New("DeleteScheduleById")
 }
 
-var CacheMockErrGetCounter = 0
-
-type cacheMockErrGet struct {
-	nThInsert int
-}
-
-func (c cacheMockErrGet) InsertSchedule(data *apiPb.InsertScheduleWithIdRequest) error {
-	return nil
+func (c cacheMockErr) ClaimSchedules(data []*apiPb.ClaimScheduleWithIdRequest) ([]*apiPb.ClaimScheduleWithIdResponse, error) {
+	return nil, errors.New("ClaimSchedules")
 }
 
-func (c cacheMockErrGet) GetScheduleById(data *apiPb.GetScheduleWithIdRequest) (*apiPb.GetScheduleWithIdResponse, error) {
-	CacheMockErrGetCounter++
-	if CacheMockErrGetCounter >= c.nThInsert {
-		return &apiPb.GetScheduleWithIdResponse{
-			ScheduledNext: &timestamppb.Timestamp{},
-		}, errors.New("GetScheduleById")
-	}
-	return nil, nil
-}
-
-func (c cacheMockErrGet) DeleteScheduleById(data *apiPb.DeleteScheduleWithIdRequest) error {
-	return nil
-}
-
-var CacheMockErrInsertCounter = 0
-
-type cacheMockErrInsert struct {
-	nThInsert int
+type cacheMockErrInsertEmptyGet struct {
+	cacheMock
 }
 
-func (c cacheMockErrInsert) InsertSchedule(data *apiPb.InsertScheduleWithIdRequest) error {
-	CacheMockErrInsertCounter++
-	if CacheMockErrInsertCounter >= c.nThInsert {
-		return errors.New("InsertSchedule")
-
-	}
-	return nil
+func (c cacheMockErrInsertEmptyGet) InsertSchedule(data *apiPb.InsertScheduleWithIdRequest) error {
+	return errors.New("InsertSchedule")
 }
 
-func (c cacheMockErrInsert) GetScheduleById(data *apiPb.GetScheduleWithIdRequest) (*apiPb.GetScheduleWithIdResponse, error) {
+func (c cacheMockErrInsertEmptyGet) GetScheduleById(data *apiPb.GetScheduleWithIdRequest) (*apiPb.GetScheduleWithIdResponse, error) {
 	return &apiPb.GetScheduleWithIdResponse{
-		ScheduledNext: &timestamppb.Timestamp{},
+		ScheduledNext: nil,
 	}, nil
 }
 
-func (c cacheMockErrInsert) DeleteScheduleById(data *apiPb.DeleteScheduleWithIdRequest) error {
-	return nil
+type cacheMockErrClaim struct {
+	cacheMock
 }
 
-type cacheMockErrInsertEmptyGet struct {
-	nThInsert int
+func (c cacheMockErrClaim) ClaimSchedules(data []*apiPb.ClaimScheduleWithIdRequest) ([]*apiPb.ClaimScheduleWithIdResponse, error) {
+	return nil, errors.New("ClaimSchedules")
 }
 
-func (c cacheMockErrInsertEmptyGet) InsertSchedule(data *apiPb.InsertScheduleWithIdRequest) error {
-	CacheMockErrInsertCounter++
-	if CacheMockErrInsertCounter >= c.nThInsert {
-		return errors.New("InsertSchedule")
+type cacheMockNotClaimed struct {
+	cacheMock
+}
 
+func (c cacheMockNotClaimed) ClaimSchedules(data []*apiPb.ClaimScheduleWithIdRequest) ([]*apiPb.ClaimScheduleWithIdResponse, error) {
+	res := make([]*apiPb.ClaimScheduleWithIdResponse, len(data))
+	for i := range data {
+		res[i] = &apiPb.ClaimScheduleWithIdResponse{
+			Claimed:       false,
+			ScheduledNext: data[i].ScheduledNext,
+		}
 	}
-	return nil
+	return res, nil
 }
 
-func (c cacheMockErrInsertEmptyGet) GetScheduleById(data *apiPb.GetScheduleWithIdRequest) (*apiPb.GetScheduleWithIdResponse, error) {
-	return &apiPb.GetScheduleWithIdResponse{
-		ScheduledNext: nil,
-	}, nil
+type cacheMockMissing struct {
+	cacheMock
 }
 
-func (c cacheMockErrInsertEmptyGet) DeleteScheduleById(data *apiPb.DeleteScheduleWithIdRequest) error {
-	return nil
+func (c cacheMockMissing) ClaimSchedules(data []*apiPb.ClaimScheduleWithIdRequest) ([]*apiPb.ClaimScheduleWithIdResponse, error) {
+	res := make([]*apiPb.ClaimScheduleWithIdResponse, len(data))
+	for i := range data {
+		res[i] = &apiPb.ClaimScheduleWithIdResponse{
+			Claimed: false,
+		}
+	}
+	return res, nil
 }
 
 type jobExecutor struct {
+	mutex sync.Mutex
 	count int
 }
 
 func (j *jobExecutor) Execute(schedulerId primitive.ObjectID) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
 	j.count += 1
 }
 
+func (j *jobExecutor) getCount() int {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	return j.count
+}
+
 func TestNew(t *testing.T) {
 	t.Run("Tests: Scheduler.New()", func(t *testing.T) {
 		t.Run("Should: create new app without error", func(t *testing.T) {
-			_, err := New(primitive.NewObjectID(), time.Second, nil, &cacheMock{})
+			_, err := New(primitive.NewObjectID(), time.Second, nil)
 			assert.Equal(t, nil, err)
 		})
 		t.Run("Should: create new app with 'intervalLessHalfSecondError' error", func(t *testing.T) {
-			_, err := New(primitive.NewObjectID(), time.Millisecond, nil, &cacheMock{})
+			_, err := New(primitive.NewObjectID(), time.Millisecond, nil)
 			assert.Equal(t, errIntervalLessHalfSecondError, err)
 		})
 	})
 }
 
+func TestNewCron(t *testing.T) {
+	t.Run("Tests: Scheduler.NewCron()", func(t *testing.T) {
+		t.Run("Should: create new scheduler without error", func(t *testing.T) {
+			_, err := NewCron(primitive.NewObjectID(), "0 9 * * 1-5", nil)
+			assert.Equal(t, nil, err)
+		})
+		t.Run("Should: create new scheduler with descriptor", func(t *testing.T) {
+			_, err := NewCron(primitive.NewObjectID(), "@hourly", nil)
+			assert.Equal(t, nil, err)
+		})
+		t.Run("Should: return 'invalidCronExpression' error", func(t *testing.T) {
+			_, err := NewCron(primitive.NewObjectID(), "0 9 * *", nil)
+			assert.ErrorIs(t, err, errInvalidCronExpressionError)
+		})
+	})
+}
+
+func TestNewFromConfig(t *testing.T) {
+	t.Run("Tests: Scheduler.NewFromConfig()", func(t *testing.T) {
+		t.Run("Should: create interval scheduler", func(t *testing.T) {
+			s, err := NewFromConfig(&scheduler_config_storage.SchedulerConfig{
+				ID:       primitive.NewObjectID(),
+				Interval: 10,
+			}, nil)
+			assert.Equal(t, nil, err)
+			assert.Equal(t, time.Second*10, s.(*schl).interval)
+			assert.Nil(t, s.(*schl).schedule)
+		})
+		t.Run("Should: create cron scheduler", func(t *testing.T) {
+			s, err := NewFromConfig(&scheduler_config_storage.SchedulerConfig{
+				ID:       primitive.NewObjectID(),
+				Interval: 10,
+				Cron:     "*/5 * * * *",
+			}, nil)
+			assert.Equal(t, nil, err)
+			assert.NotNil(t, s.(*schl).schedule)
+		})
+		t.Run("Should: create scheduler with failure interval", func(t *testing.T) {
+			s, err := NewFromConfig(&scheduler_config_storage.SchedulerConfig{
+				ID:              primitive.NewObjectID(),
+				Cron:            "*/5 * * * *",
+				FailureInterval: 15,
+			}, nil)
+			assert.Equal(t, nil, err)
+			assert.Equal(t, time.Second*15, s.(*schl).failureInterval)
+		})
+		t.Run("Should: return error because interval", func(t *testing.T) {
+			_, err := NewFromConfig(&scheduler_config_storage.SchedulerConfig{
+				ID: primitive.NewObjectID(),
+			}, nil)
+			assert.Equal(t, errIntervalLessHalfSecondError, err)
+		})
+	})
+}
+
+func TestSchl_nextRun(t *testing.T) {
+	t.Run("Tests: Scheduler.nextRun()", func(t *testing.T) {
+		now := time.Date(2020, 6, 5, 10, 3, 0, 0, time.UTC) // Friday
+		t.Run("Should: add interval", func(t *testing.T) {
+			s, _ := New(primitive.NewObjectID(), time.Minute, nil)
+			assert.Equal(t, now.Add(time.Minute), s.(*schl).nextRun(now, false))
+		})
+		t.Run("Should: return next weekday at 09:00 UTC", func(t *testing.T) {
+			s, _ := NewCron(primitive.NewObjectID(), "0 9 * * 1-5", nil)
+			assert.True(t, time.Date(2020, 6, 8, 9, 0, 0, 0, time.UTC).Equal(s.(*schl).nextRun(now, false)))
+		})
+		t.Run("Should: return next 5 minutes inside working hours", func(t *testing.T) {
+			s, _ := NewCron(primitive.NewObjectID(), "*/5 8-19 * * *", nil)
+			assert.True(t, time.Date(2020, 6, 5, 10, 5, 0, 0, time.UTC).Equal(s.(*schl).nextRun(now, false)))
+			evening := time.Date(2020, 6, 5, 19, 57, 0, 0, time.UTC)
+			assert.True(t, time.Date(2020, 6, 6, 8, 0, 0, 0, time.UTC).Equal(s.(*schl).nextRun(evening, false)))
+		})
+		t.Run("Should: use failure interval while failing", func(t *testing.T) {
+			s, _ := NewCron(primitive.NewObjectID(), "*/5 8-19 * * *", nil)
+			s.(*schl).failureInterval = time.Second * 15
+			assert.Equal(t, now.Add(time.Second*15), s.(*schl).nextRun(now, true))
+			assert.True(t, time.Date(2020, 6, 5, 10, 5, 0, 0, time.UTC).Equal(s.(*schl).nextRun(now, false)))
+		})
+		t.Run("Should: keep usual schedule when it is sooner than failure interval", func(t *testing.T) {
+			s, _ := New(primitive.NewObjectID(), time.Minute, nil)
+			s.(*schl).failureInterval = time.Hour
+			assert.Equal(t, now.Add(time.Minute), s.(*schl).nextRun(now, true))
+		})
+		t.Run("Should: respect CRON_TZ", func(t *testing.T) {
+			s, _ := NewCron(primitive.NewObjectID(), "CRON_TZ=Etc/GMT-2 0 12 * * *", nil)
+			assert.True(t, time.Date(2020, 6, 6, 10, 0, 0, 0, time.UTC).Equal(s.(*schl).nextRun(now, false)))
+		})
+	})
+}
+
 func TestSchl_Run(t *testing.T) {
 	t.Run("Tests: Scheduler.Run()", func(t *testing.T) {
 		t.Run("Should: run without error ", func(t *testing.T) {
-			i, _ := New(primitive.NewObjectID(), time.Second, &jobExecutor{}, &cacheMock{})
-			i.Run()
-			i.Run()
+			d := NewDispatcher(&jobExecutor{}, &cacheMock{})
+			defer d.Stop()
+			i, _ := New(primitive.NewObjectID(), time.Second, d)
+			assert.Nil(t, i.Run())
+			assert.Nil(t, i.Run())
 			i.Stop()
 		})
 		t.Run("Should: run job every second ", func(t *testing.T) {
 			store := &jobExecutor{}
-			i, err := New(primitive.NewObjectID(), time.Second, store, &cacheMock{
-				time.Now(), 900 * time.Millisecond,
-			})
-			assert.Equal(t, nil, err)
-			err = i.Run()
-			assert.Equal(t, nil, err)
-			ch := make(chan bool)
-			time.AfterFunc(time.Millisecond*1100, func() {
-				assert.Equal(t, 1, store.count)
-				ch <- true
-			})
-			<-ch
-			time.AfterFunc(time.Millisecond*1100, func() {
-				assert.Equal(t, 3, store.count)
-				ch <- true
-			})
-			<-ch
-			i.Stop()
-		})
-		t.Run("Should: observer should receive quit ch ", func(t *testing.T) {
-			store := &jobExecutor{}
-			i, err := New(primitive.NewObjectID(), time.Second, store, &cacheMock{
+			d := NewDispatcher(store, &cacheMock{
 				time.Now(), 900 * time.Millisecond,
 			})
+			defer d.Stop()
+			i, err := New(primitive.NewObjectID(), time.Second, d)
 			assert.Equal(t, nil, err)
 			err = i.Run()
 			assert.Equal(t, nil, err)
+			time.Sleep(time.Millisecond * 1100)
+			assert.Equal(t, 1, store.getCount())
+			time.Sleep(time.Millisecond * 1100)
+			assert.Equal(t, 2, store.getCount())
 			i.Stop()
 		})
 		t.Run("Should: return err ", func(t *testing.T) {
-			store := &jobExecutor{}
-			i, err := New(primitive.NewObjectID(), time.Second, store, &cacheMockErr{})
+			d := NewDispatcher(&jobExecutor{}, &cacheMockErr{})
+			defer d.Stop()
+			i, err := New(primitive.NewObjectID(), time.Second, d)
 			assert.Equal(t, nil, err)
 			err = i.Run()
 			assert.ErrorContains(t, err, "GetScheduleById")
+			assert.False(t, i.IsRun())
 		})
 		t.Run("Should: return err", func(t *testing.T) {
-			store := &jobExecutor{}
-			i, err := New(primitive.NewObjectID(), time.Second, store,
-				&cacheMockErrInsertEmptyGet{})
+			d := NewDispatcher(&jobExecutor{}, &cacheMockErrInsertEmptyGet{})
+			defer d.Stop()
+			i, err := New(primitive.NewObjectID(), time.Second, d)
 			assert.Equal(t, nil, err)
 			err = i.Run()
 			assert.ErrorContains(t, err, "InsertSchedule")
 		})
-		t.Run("Should: observer return err", func(t *testing.T) {
+		t.Run("Should: not run job if claim failed", func(t *testing.T) {
 			store := &jobExecutor{}
-			i, err := New(primitive.NewObjectID(), time.Second, store, &cacheMockErrGet{2})
+			d := NewDispatcher(store, &cacheMockErrClaim{
+				cacheMock{time.Now(), -time.Second},
+			})
+			defer d.Stop()
+			i, err := New(primitive.NewObjectID(), time.Second, d)
 			assert.Equal(t, nil, err)
 			err = i.Run()
 			assert.Nil(t, err)
 			time.Sleep(config.SmallestInterval * 2)
+			i.Stop()
+			assert.Equal(t, 0, store.getCount())
 		})
-		t.Run("Should: observer return err for insert", func(t *testing.T) {
+		t.Run("Should: not run job if tick claimed by other replica", func(t *testing.T) {
 			store := &jobExecutor{}
-			i, err := New(primitive.NewObjectID(), time.Second, store,
-				&cacheMockErrInsert{nThInsert: 2})
+			d := NewDispatcher(store, &cacheMockNotClaimed{
+				cacheMock{time.Now(), -time.Second},
+			})
+			defer d.Stop()
+			i, err := New(primitive.NewObjectID(), time.Second, d)
 			assert.Equal(t, nil, err)
 			err = i.Run()
+			assert.Equal(t, nil, err)
+			time.Sleep(config.SmallestInterval * 3)
+			i.Stop()
+			assert.Equal(t, 0, store.getCount())
+		})
+		t.Run("Should: not run job if schedule removed from cache", func(t *testing.T) {
+			store := &jobExecutor{}
+			d := NewDispatcher(store, &cacheMockMissing{
+				cacheMock{time.Now(), -time.Second},
+			})
+			defer d.Stop()
+			i, err := New(primitive.NewObjectID(), time.Second, d)
+			assert.Equal(t, nil, err)
+			err = i.Run()
+			assert.Equal(t, nil, err)
+			time.Sleep(config.SmallestInterval * 3)
+			i.Stop()
+			assert.Equal(t, 0, store.getCount())
+		})
+		t.Run("Should: run job once per tick across replicas", func(t *testing.T) {
+			// Replicas share one cache like they share one redis
+			sharedCache, err := cache.NewMemory("")
 			assert.Nil(t, err)
-			time.Sleep(config.SmallestInterval * 2)
+			id := primitive.NewObjectID()
+			store := &jobExecutor{}
+			replicas := []Scheduler{}
+			for r := 0; r < 3; r++ {
+				d := NewDispatcher(store, sharedCache)
+				defer d.Stop()
+				replica, err := New(id, time.Second, d)
+				assert.Nil(t, err)
+				assert.Nil(t, replica.Run())
+				replicas = append(replicas, replica)
+			}
+			time.Sleep(time.Millisecond * 3200)
+			for _, replica := range replicas {
+				replica.Stop()
+			}
+			assert.GreaterOrEqual(t, store.getCount(), 2)
+			assert.LessOrEqual(t, store.getCount(), 4)
 		})
 	})
 }
@@ -231,36 +356,54 @@ func TestSchl_Run(t *testing.T) {
 func TestSchl_Stop(t *testing.T) {
 	t.Run("Tests: Scheduler.Stop()", func(t *testing.T) {
 		t.Run("Should: stop without error ", func(t *testing.T) {
-			i, _ := New(primitive.NewObjectID(), time.Second, &jobExecutor{}, &cacheMock{})
-			i.Run()
+			d := NewDispatcher(&jobExecutor{}, &cacheMock{})
+			defer d.Stop()
+			i, _ := New(primitive.NewObjectID(), time.Second, d)
+			_ = i.Run()
 			i.Stop()
 			i.Stop()
 		})
 		t.Run("Should: stop without error ", func(t *testing.T) {
-			i, _ := New(primitive.NewObjectID(), time.Second, &jobExecutor{}, &cacheMockErrDelete{})
-			i.Run()
+			d := NewDispatcher(&jobExecutor{}, &cacheMockErrDelete{})
+			defer d.Stop()
+			i, _ := New(primitive.NewObjectID(), time.Second, d)
+			_ = i.Run()
 			i.Stop()
 		})
+		t.Run("Should: not run job after stop", func(t *testing.T) {
+			store := &jobExecutor{}
+			d := NewDispatcher(store, &cacheMock{
+				time.Now(), 300 * time.Millisecond,
+			})
+			defer d.Stop()
+			i, _ := New(primitive.NewObjectID(), time.Second, d)
+			_ = i.Run()
+			i.Stop()
+			time.Sleep(config.SmallestInterval)
+			assert.Equal(t, 0, store.getCount())
+		})
 	})
 }
 
 func TestSchl_IsRun(t *testing.T) {
 	t.Run("Tests: Scheduler.IsRun()", func(t *testing.T) {
+		d := NewDispatcher(&jobExecutor{}, &cacheMock{})
+		defer d.Stop()
 		t.Run("Should: return true ", func(t *testing.T) {
-			i, _ := New(primitive.NewObjectID(), time.Second, &jobExecutor{}, &cacheMock{})
-			i.Run()
+			i, _ := New(primitive.NewObjectID(), time.Second, d)
+			_ = i.Run()
 			assert.Equal(t, true, i.IsRun())
 			i.Stop()
 
 		})
 		t.Run("Should: return false", func(t *testing.T) {
 			t.Run("Suite: after creation", func(t *testing.T) {
-				i, _ := New(primitive.NewObjectID(), time.Second, &jobExecutor{}, &cacheMock{})
+				i, _ := New(primitive.NewObjectID(), time.Second, d)
 				assert.Equal(t, false, i.IsRun())
 			})
 			t.Run("Suite: after stop", func(t *testing.T) {
-				i, _ := New(primitive.NewObjectID(), time.Second, &jobExecutor{}, &cacheMock{})
-				i.Run()
+				i, _ := New(primitive.NewObjectID(), time.Second, d)
+				_ = i.Run()
 				i.Stop()
 				assert.Equal(t, false, i.IsRun())
 			})
@@ -271,7 +414,7 @@ func TestSchl_IsRun(t *testing.T) {
 func TestSchl_GetId(t *testing.T) {
 	t.Run("Should: return id as string", func(t *testing.T) {
 		id := primitive.NewObjectID()
-		s, err := New(id, time.Second, &jobExecutor{}, &cacheMock{})
+		s, err := New(id, time.Second, nil)
 		assert.Equal(t, id.Hex(), s.GetID())
 		assert.IsType(t, "", s.GetID())
 		assert.Equal(t, nil, err)
@@ -281,9 +424,31 @@ func TestSchl_GetId(t *testing.T) {
 func TestSchl_GetIdBson(t *testing.T) {
 	t.Run("Should: return id as bson", func(t *testing.T) {
 		id := primitive.NewObjectID()
-		s, err := New(id, time.Second, &jobExecutor{}, &cacheMock{})
+		s, err := New(id, time.Second, nil)
 		assert.Equal(t, id, s.GetIDBson())
 		assert.IsType(t, primitive.ObjectID{}, s.GetIDBson())
 		assert.Equal(t, nil, err)
 	})
 }
+
+func TestSchl_GetSchedule(t *testing.T) {
+	t.Run("Should: return interval", func(t *testing.T) {
+		s, err := New(primitive.NewObjectID(), time.Second*10, nil)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, "10s", s.GetSchedule())
+	})
+	t.Run("Should: return cron expression", func(t *testing.T) {
+		s, err := NewCron(primitive.NewObjectID(), "*/5 * * * *", nil)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, "*/5 * * * *", s.GetSchedule())
+	})
+	t.Run("Should: return failure interval too", func(t *testing.T) {
+		s, err := NewFromConfig(&scheduler_config_storage.SchedulerConfig{
+			ID:              primitive.NewObjectID(),
+			Interval:        300,
+			FailureInterval: 15,
+		}, nil)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, "5m0s, 15s while failing", s.GetSchedule())
+	})
+}
@@ -5,6 +5,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"github.com/squzy/squzy/internal/parsers"
+	"github.com/squzy/squzy/internal/httptools"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
 	"testing"
 	"time"
 )
@@ -16,6 +18,10 @@ func (m mockHttp) SendRequestTimeoutStatusCode(req *http.Request, timeout time.D
 	panic("implement me")
 }
 
+func (m mockHttp) SendRequestTimeoutResponse(req *http.Request, timeout time.Duration, expectedCode int) (*http.Response, []byte, error) {
+	panic("implement me")
+}
+
 func (m mockHttp) SendRequestTimeout(req *http.Request, timeout time.Duration) (int, []byte, error) {
 	panic("implement me")
 }
@@ -28,10 +34,14 @@ func (m mockHttp) GetWithRedirects(url string) (int, []byte, error) {
 	return 200, nil, nil
 }
 
-func (m mockHttp) CreateRequest(method string, url string, headers *map[string]string, log string) *http.Request {
+func (m mockHttp) CreateRequest(method string, url string, headers *map[string]string, body *scheduler_config_storage.HTTPBody, log string) *http.Request {
 	return nil
 }
 
+func (m mockHttp) ForCheck(auth *scheduler_config_storage.HTTPAuth, tlsConfig *scheduler_config_storage.HTTPTLS) (httptools.HTTPTool, error) {
+	panic("implement me")
+}
+
 func (m mockHttp) SendRequest(req *http.Request) (int, []byte, error) {
 	return 200, nil, nil
 }
@@ -61,6 +71,10 @@ func (m mockHttpError) SendRequestTimeoutStatusCode(req *http.Request, timeout t
 	panic("implement me")
 }
 
+func (m mockHttpError) SendRequestTimeoutResponse(req *http.Request, timeout time.Duration, expectedCode int) (*http.Response, []byte, error) {
+	panic("implement me")
+}
+
 func (m mockHttpError) SendRequestTimeout(req *http.Request, timeout time.Duration) (int, []byte, error) {
 	panic("implement me")
 }
@@ -73,10 +87,14 @@ func (m mockHttpError) GetWithRedirects(url string) (int, []byte, error) {
 	return 0, nil, errors.New("ascss")
 }
 
-func (m mockHttpError) CreateRequest(method string, url string, headers *map[string]string, log string) *http.Request {
+func (m mockHttpError) CreateRequest(method string, url string, headers *map[string]string, body *scheduler_config_storage.HTTPBody, log string) *http.Request {
 	return nil
 }
 
+func (m mockHttpError) ForCheck(auth *scheduler_config_storage.HTTPAuth, tlsConfig *scheduler_config_storage.HTTPTLS) (httptools.HTTPTool, error) {
+	panic("implement me")
+}
+
 func (m mockHttpError) SendRequest(req *http.Request) (int, []byte, error) {
 	return 0, nil, errors.New("ascss")
 }
@@ -0,0 +1,562 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: proto/v1/squzy_cache.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type InsertScheduleWithIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ScheduledNext *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=scheduled_next,json=scheduledNext,proto3" json:"scheduled_next,omitempty"`
+}
+
+func (x *InsertScheduleWithIdRequest) Reset() {
+	*x = InsertScheduleWithIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_cache_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InsertScheduleWithIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertScheduleWithIdRequest) ProtoMessage() {}
+
+func (x *InsertScheduleWithIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_cache_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertScheduleWithIdRequest.ProtoReflect.Descriptor instead.
+func (*InsertScheduleWithIdRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_cache_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InsertScheduleWithIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InsertScheduleWithIdRequest) GetScheduledNext() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledNext
+	}
+	return nil
+}
+
+type GetScheduleWithIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetScheduleWithIdRequest) Reset() {
+	*x = GetScheduleWithIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_cache_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScheduleWithIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleWithIdRequest) ProtoMessage() {}
+
+func (x *GetScheduleWithIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_cache_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleWithIdRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduleWithIdRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_cache_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetScheduleWithIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetScheduleWithIdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduledNext *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=scheduled_next,json=scheduledNext,proto3" json:"scheduled_next,omitempty"`
+}
+
+func (x *GetScheduleWithIdResponse) Reset() {
+	*x = GetScheduleWithIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_cache_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScheduleWithIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleWithIdResponse) ProtoMessage() {}
+
+func (x *GetScheduleWithIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_cache_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleWithIdResponse.ProtoReflect.Descriptor instead.
+func (*GetScheduleWithIdResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_cache_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetScheduleWithIdResponse) GetScheduledNext() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledNext
+	}
+	return nil
+}
+
+// Move schedule to scheduled_next only if it still equals scheduled_current,
+// so one tick is executed just by one replica
+type ClaimScheduleWithIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ScheduledCurrent *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=scheduled_current,json=scheduledCurrent,proto3" json:"scheduled_current,omitempty"`
+	ScheduledNext    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=scheduled_next,json=scheduledNext,proto3" json:"scheduled_next,omitempty"`
+}
+
+func (x *ClaimScheduleWithIdRequest) Reset() {
+	*x = ClaimScheduleWithIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_cache_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimScheduleWithIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimScheduleWithIdRequest) ProtoMessage() {}
+
+func (x *ClaimScheduleWithIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_cache_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimScheduleWithIdRequest.ProtoReflect.Descriptor instead.
+func (*ClaimScheduleWithIdRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_cache_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ClaimScheduleWithIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ClaimScheduleWithIdRequest) GetScheduledCurrent() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledCurrent
+	}
+	return nil
+}
+
+func (x *ClaimScheduleWithIdRequest) GetScheduledNext() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledNext
+	}
+	return nil
+}
+
+type ClaimScheduleWithIdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Claimed bool `protobuf:"varint,1,opt,name=claimed,proto3" json:"claimed,omitempty"`
+	// Stored next run after claim, nil if schedule not exists
+	ScheduledNext *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=scheduled_next,json=scheduledNext,proto3" json:"scheduled_next,omitempty"`
+}
+
+func (x *ClaimScheduleWithIdResponse) Reset() {
+	*x = ClaimScheduleWithIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_cache_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimScheduleWithIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimScheduleWithIdResponse) ProtoMessage() {}
+
+func (x *ClaimScheduleWithIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_cache_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimScheduleWithIdResponse.ProtoReflect.Descriptor instead.
+func (*ClaimScheduleWithIdResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_cache_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ClaimScheduleWithIdResponse) GetClaimed() bool {
+	if x != nil {
+		return x.Claimed
+	}
+	return false
+}
+
+func (x *ClaimScheduleWithIdResponse) GetScheduledNext() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledNext
+	}
+	return nil
+}
+
+type DeleteScheduleWithIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteScheduleWithIdRequest) Reset() {
+	*x = DeleteScheduleWithIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_cache_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteScheduleWithIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScheduleWithIdRequest) ProtoMessage() {}
+
+func (x *DeleteScheduleWithIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_cache_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScheduleWithIdRequest.ProtoReflect.Descriptor instead.
+func (*DeleteScheduleWithIdRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_cache_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteScheduleWithIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_proto_v1_squzy_cache_proto protoreflect.FileDescriptor
+
+var file_proto_v1_squzy_cache_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x73, 0x71,
+	0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x1a, 0x1b, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x70, 0x0a, 0x1b, 0x49, 0x6e,
+	0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68,
+	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x41, 0x0a, 0x0e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4e, 0x65, 0x78, 0x74, 0x22, 0x2a, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5e, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x4e, 0x65, 0x78, 0x74, 0x22, 0xb8, 0x01, 0x0a, 0x1a, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x47, 0x0a, 0x11, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x12, 0x41, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x6e, 0x65,
+	0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4e,
+	0x65, 0x78, 0x74, 0x22, 0x7a, 0x0a, 0x1b, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x0e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4e, 0x65, 0x78, 0x74, 0x22,
+	0x2d, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x32, 0x97,
+	0x03, 0x0a, 0x05, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x5b, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x65,
+	0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x64,
+	0x12, 0x2b, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x57, 0x69, 0x74, 0x68, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x66, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x64, 0x12, 0x28, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57,
+	0x69, 0x74, 0x68, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a,
+	0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42,
+	0x79, 0x49, 0x64, 0x12, 0x2b, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x6e, 0x0a, 0x13, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x64, 0x12,
+	0x2a, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57, 0x69,
+	0x74, 0x68, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x71,
+	0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x24, 0x5a, 0x22, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2f, 0x73, 0x71, 0x75,
+	0x7a, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_v1_squzy_cache_proto_rawDescOnce sync.Once
+	file_proto_v1_squzy_cache_proto_rawDescData = file_proto_v1_squzy_cache_proto_rawDesc
+)
+
+func file_proto_v1_squzy_cache_proto_rawDescGZIP() []byte {
+	file_proto_v1_squzy_cache_proto_rawDescOnce.Do(func() {
+		file_proto_v1_squzy_cache_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_v1_squzy_cache_proto_rawDescData)
+	})
+	return file_proto_v1_squzy_cache_proto_rawDescData
+}
+
+var file_proto_v1_squzy_cache_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_v1_squzy_cache_proto_goTypes = []interface{}{
+	(*InsertScheduleWithIdRequest)(nil), // 0: squzy.v1.cache.InsertScheduleWithIdRequest
+	(*GetScheduleWithIdRequest)(nil),    // 1: squzy.v1.cache.GetScheduleWithIdRequest
+	(*GetScheduleWithIdResponse)(nil),   // 2: squzy.v1.cache.GetScheduleWithIdResponse
+	(*ClaimScheduleWithIdRequest)(nil),  // 3: squzy.v1.cache.ClaimScheduleWithIdRequest
+	(*ClaimScheduleWithIdResponse)(nil), // 4: squzy.v1.cache.ClaimScheduleWithIdResponse
+	(*DeleteScheduleWithIdRequest)(nil), // 5: squzy.v1.cache.DeleteScheduleWithIdRequest
+	(*timestamppb.Timestamp)(nil),       // 6: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),               // 7: google.protobuf.Empty
+}
+var file_proto_v1_squzy_cache_proto_depIdxs = []int32{
+	6, // 0: squzy.v1.cache.InsertScheduleWithIdRequest.scheduled_next:type_name -> google.protobuf.Timestamp
+	6, // 1: squzy.v1.cache.GetScheduleWithIdResponse.scheduled_next:type_name -> google.protobuf.Timestamp
+	6, // 2: squzy.v1.cache.ClaimScheduleWithIdRequest.scheduled_current:type_name -> google.protobuf.Timestamp
+	6, // 3: squzy.v1.cache.ClaimScheduleWithIdRequest.scheduled_next:type_name -> google.protobuf.Timestamp
+	6, // 4: squzy.v1.cache.ClaimScheduleWithIdResponse.scheduled_next:type_name -> google.protobuf.Timestamp
+	0, // 5: squzy.v1.cache.Cache.InsertScheduleWithId:input_type -> squzy.v1.cache.InsertScheduleWithIdRequest
+	1, // 6: squzy.v1.cache.Cache.GetScheduleById:input_type -> squzy.v1.cache.GetScheduleWithIdRequest
+	5, // 7: squzy.v1.cache.Cache.DeleteScheduleById:input_type -> squzy.v1.cache.DeleteScheduleWithIdRequest
+	3, // 8: squzy.v1.cache.Cache.ClaimScheduleWithId:input_type -> squzy.v1.cache.ClaimScheduleWithIdRequest
+	7, // 9: squzy.v1.cache.Cache.InsertScheduleWithId:output_type -> google.protobuf.Empty
+	2, // 10: squzy.v1.cache.Cache.GetScheduleById:output_type -> squzy.v1.cache.GetScheduleWithIdResponse
+	7, // 11: squzy.v1.cache.Cache.DeleteScheduleById:output_type -> google.protobuf.Empty
+	4, // 12: squzy.v1.cache.Cache.ClaimScheduleWithId:output_type -> squzy.v1.cache.ClaimScheduleWithIdResponse
+	9, // [9:13] is the sub-list for method output_type
+	5, // [5:9] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_squzy_cache_proto_init() }
+func file_proto_v1_squzy_cache_proto_init() {
+	if File_proto_v1_squzy_cache_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_v1_squzy_cache_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InsertScheduleWithIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_cache_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScheduleWithIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_cache_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScheduleWithIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_cache_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimScheduleWithIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_cache_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimScheduleWithIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_cache_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteScheduleWithIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_v1_squzy_cache_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_v1_squzy_cache_proto_goTypes,
+		DependencyIndexes: file_proto_v1_squzy_cache_proto_depIdxs,
+		MessageInfos:      file_proto_v1_squzy_cache_proto_msgTypes,
+	}.Build()
+	File_proto_v1_squzy_cache_proto = out.File
+	file_proto_v1_squzy_cache_proto_rawDesc = nil
+	file_proto_v1_squzy_cache_proto_goTypes = nil
+	file_proto_v1_squzy_cache_proto_depIdxs = nil
+}
@@ -0,0 +1,222 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: proto/v1/squzy_cache.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// CacheClient is the client API for Cache service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CacheClient interface {
+	// protolint:disable:next MAX_LINE_LENGTH
+	InsertScheduleWithId(ctx context.Context, in *InsertScheduleWithIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// protolint:disable:next MAX_LINE_LENGTH
+	GetScheduleById(ctx context.Context, in *GetScheduleWithIdRequest, opts ...grpc.CallOption) (*GetScheduleWithIdResponse, error)
+	// protolint:disable:next MAX_LINE_LENGTH
+	DeleteScheduleById(ctx context.Context, in *DeleteScheduleWithIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// protolint:disable:next MAX_LINE_LENGTH
+	ClaimScheduleWithId(ctx context.Context, in *ClaimScheduleWithIdRequest, opts ...grpc.CallOption) (*ClaimScheduleWithIdResponse, error)
+}
+
+type cacheClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCacheClient(cc grpc.ClientConnInterface) CacheClient {
+	return &cacheClient{cc}
+}
+
+func (c *cacheClient) InsertScheduleWithId(ctx context.Context, in *InsertScheduleWithIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/squzy.v1.cache.Cache/InsertScheduleWithId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) GetScheduleById(ctx context.Context, in *GetScheduleWithIdRequest, opts ...grpc.CallOption) (*GetScheduleWithIdResponse, error) {
+	out := new(GetScheduleWithIdResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.cache.Cache/GetScheduleById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) DeleteScheduleById(ctx context.Context, in *DeleteScheduleWithIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/squzy.v1.cache.Cache/DeleteScheduleById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) ClaimScheduleWithId(ctx context.Context, in *ClaimScheduleWithIdRequest, opts ...grpc.CallOption) (*ClaimScheduleWithIdResponse, error) {
+	out := new(ClaimScheduleWithIdResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.cache.Cache/ClaimScheduleWithId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CacheServer is the server API for Cache service.
+// All implementations must embed UnimplementedCacheServer
+// for forward compatibility
+type CacheServer interface {
+	// protolint:disable:next MAX_LINE_LENGTH
+	InsertScheduleWithId(context.Context, *InsertScheduleWithIdRequest) (*emptypb.Empty, error)
+	// protolint:disable:next MAX_LINE_LENGTH
+	GetScheduleById(context.Context, *GetScheduleWithIdRequest) (*GetScheduleWithIdResponse, error)
+	// protolint:disable:next MAX_LINE_LENGTH
+	DeleteScheduleById(context.Context, *DeleteScheduleWithIdRequest) (*emptypb.Empty, error)
+	// protolint:disable:next MAX_LINE_LENGTH
+	ClaimScheduleWithId(context.Context, *ClaimScheduleWithIdRequest) (*ClaimScheduleWithIdResponse, error)
+	mustEmbedUnimplementedCacheServer()
+}
+
+// UnimplementedCacheServer must be embedded to have forward compatible implementations.
+type UnimplementedCacheServer struct {
+}
+
+func (UnimplementedCacheServer) InsertScheduleWithId(context.Context, *InsertScheduleWithIdRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertScheduleWithId not implemented")
+}
+func (UnimplementedCacheServer) GetScheduleById(context.Context, *GetScheduleWithIdRequest) (*GetScheduleWithIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduleById not implemented")
+}
+func (UnimplementedCacheServer) DeleteScheduleById(context.Context, *DeleteScheduleWithIdRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScheduleById not implemented")
+}
+func (UnimplementedCacheServer) ClaimScheduleWithId(context.Context, *ClaimScheduleWithIdRequest) (*ClaimScheduleWithIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimScheduleWithId not implemented")
+}
+func (UnimplementedCacheServer) mustEmbedUnimplementedCacheServer() {}
+
+// UnsafeCacheServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CacheServer will
+// result in compilation errors.
+type UnsafeCacheServer interface {
+	mustEmbedUnimplementedCacheServer()
+}
+
+func RegisterCacheServer(s grpc.ServiceRegistrar, srv CacheServer) {
+	s.RegisterService(&Cache_ServiceDesc, srv)
+}
+
+func _Cache_InsertScheduleWithId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertScheduleWithIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).InsertScheduleWithId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.cache.Cache/InsertScheduleWithId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).InsertScheduleWithId(ctx, req.(*InsertScheduleWithIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_GetScheduleById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduleWithIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).GetScheduleById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.cache.Cache/GetScheduleById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).GetScheduleById(ctx, req.(*GetScheduleWithIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_DeleteScheduleById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduleWithIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).DeleteScheduleById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.cache.Cache/DeleteScheduleById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).DeleteScheduleById(ctx, req.(*DeleteScheduleWithIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_ClaimScheduleWithId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimScheduleWithIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).ClaimScheduleWithId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.cache.Cache/ClaimScheduleWithId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).ClaimScheduleWithId(ctx, req.(*ClaimScheduleWithIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Cache_ServiceDesc is the grpc.ServiceDesc for Cache service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Cache_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "squzy.v1.cache.Cache",
+	HandlerType: (*CacheServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InsertScheduleWithId",
+			Handler:    _Cache_InsertScheduleWithId_Handler,
+		},
+		{
+			MethodName: "GetScheduleById",
+			Handler:    _Cache_GetScheduleById_Handler,
+		},
+		{
+			MethodName: "DeleteScheduleById",
+			Handler:    _Cache_DeleteScheduleById_Handler,
+		},
+		{
+			MethodName: "ClaimScheduleWithId",
+			Handler:    _Cache_ClaimScheduleWithId_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/v1/squzy_cache.proto",
+}
@@ -0,0 +1,6974 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: proto/v1/squzy_monitoring.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SchedulerCode int32
+
+const (
+	SchedulerCode_SCHEDULER_CODE_UNSPECIFIED SchedulerCode = 0
+	SchedulerCode_OK                         SchedulerCode = 1
+	SchedulerCode_ERROR                      SchedulerCode = 2
+	// Snapshot was taken during maintenance window
+	SchedulerCode_MAINTENANCE SchedulerCode = 3
+	// Check failed while one of parent schedulers is failing
+	SchedulerCode_SKIPPED SchedulerCode = 4
+	// Check passed, but one of warning thresholds is exceeded
+	SchedulerCode_WARNING SchedulerCode = 5
+)
+
+// Enum value maps for SchedulerCode.
+var (
+	SchedulerCode_name = map[int32]string{
+		0: "SCHEDULER_CODE_UNSPECIFIED",
+		1: "OK",
+		2: "ERROR",
+		3: "MAINTENANCE",
+		4: "SKIPPED",
+		5: "WARNING",
+	}
+	SchedulerCode_value = map[string]int32{
+		"SCHEDULER_CODE_UNSPECIFIED": 0,
+		"OK":                         1,
+		"ERROR":                      2,
+		"MAINTENANCE":                3,
+		"SKIPPED":                    4,
+		"WARNING":                    5,
+	}
+)
+
+func (x SchedulerCode) Enum() *SchedulerCode {
+	p := new(SchedulerCode)
+	*p = x
+	return p
+}
+
+func (x SchedulerCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SchedulerCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_monitoring_proto_enumTypes[0].Descriptor()
+}
+
+func (SchedulerCode) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_monitoring_proto_enumTypes[0]
+}
+
+func (x SchedulerCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SchedulerCode.Descriptor instead.
+func (SchedulerCode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{0}
+}
+
+type SchedulerStatus int32
+
+const (
+	// Initial status
+	SchedulerStatus_SCHEDULER_STATUS_UNSPECIFIED SchedulerStatus = 0
+	SchedulerStatus_RUNNED                       SchedulerStatus = 1
+	SchedulerStatus_STOPPED                      SchedulerStatus = 2
+	SchedulerStatus_REMOVED                      SchedulerStatus = 3
+)
+
+// Enum value maps for SchedulerStatus.
+var (
+	SchedulerStatus_name = map[int32]string{
+		0: "SCHEDULER_STATUS_UNSPECIFIED",
+		1: "RUNNED",
+		2: "STOPPED",
+		3: "REMOVED",
+	}
+	SchedulerStatus_value = map[string]int32{
+		"SCHEDULER_STATUS_UNSPECIFIED": 0,
+		"RUNNED":                       1,
+		"STOPPED":                      2,
+		"REMOVED":                      3,
+	}
+)
+
+func (x SchedulerStatus) Enum() *SchedulerStatus {
+	p := new(SchedulerStatus)
+	*p = x
+	return p
+}
+
+func (x SchedulerStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SchedulerStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_monitoring_proto_enumTypes[1].Descriptor()
+}
+
+func (SchedulerStatus) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_monitoring_proto_enumTypes[1]
+}
+
+func (x SchedulerStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SchedulerStatus.Descriptor instead.
+func (SchedulerStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{1}
+}
+
+type DocumentFormat int32
+
+const (
+	// Same as YAML
+	DocumentFormat_DOCUMENT_FORMAT_UNSPECIFIED DocumentFormat = 0
+	DocumentFormat_DOCUMENT_FORMAT_YAML        DocumentFormat = 1
+	DocumentFormat_DOCUMENT_FORMAT_JSON        DocumentFormat = 2
+)
+
+// Enum value maps for DocumentFormat.
+var (
+	DocumentFormat_name = map[int32]string{
+		0: "DOCUMENT_FORMAT_UNSPECIFIED",
+		1: "DOCUMENT_FORMAT_YAML",
+		2: "DOCUMENT_FORMAT_JSON",
+	}
+	DocumentFormat_value = map[string]int32{
+		"DOCUMENT_FORMAT_UNSPECIFIED": 0,
+		"DOCUMENT_FORMAT_YAML":        1,
+		"DOCUMENT_FORMAT_JSON":        2,
+	}
+)
+
+func (x DocumentFormat) Enum() *DocumentFormat {
+	p := new(DocumentFormat)
+	*p = x
+	return p
+}
+
+func (x DocumentFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DocumentFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_monitoring_proto_enumTypes[2].Descriptor()
+}
+
+func (DocumentFormat) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_monitoring_proto_enumTypes[2]
+}
+
+func (x DocumentFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DocumentFormat.Descriptor instead.
+func (DocumentFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{2}
+}
+
+type MaintenanceMode int32
+
+const (
+	// Same as MARK
+	MaintenanceMode_MAINTENANCE_MODE_UNSPECIFIED MaintenanceMode = 0
+	// Check is executed, snapshot is saved with MAINTENANCE code
+	MaintenanceMode_MAINTENANCE_MODE_MARK MaintenanceMode = 1
+	// Check is not executed at all
+	MaintenanceMode_MAINTENANCE_MODE_SKIP MaintenanceMode = 2
+)
+
+// Enum value maps for MaintenanceMode.
+var (
+	MaintenanceMode_name = map[int32]string{
+		0: "MAINTENANCE_MODE_UNSPECIFIED",
+		1: "MAINTENANCE_MODE_MARK",
+		2: "MAINTENANCE_MODE_SKIP",
+	}
+	MaintenanceMode_value = map[string]int32{
+		"MAINTENANCE_MODE_UNSPECIFIED": 0,
+		"MAINTENANCE_MODE_MARK":        1,
+		"MAINTENANCE_MODE_SKIP":        2,
+	}
+)
+
+func (x MaintenanceMode) Enum() *MaintenanceMode {
+	p := new(MaintenanceMode)
+	*p = x
+	return p
+}
+
+func (x MaintenanceMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MaintenanceMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_monitoring_proto_enumTypes[3].Descriptor()
+}
+
+func (MaintenanceMode) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_monitoring_proto_enumTypes[3]
+}
+
+func (x MaintenanceMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MaintenanceMode.Descriptor instead.
+func (MaintenanceMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{3}
+}
+
+type SchedulerType int32
+
+const (
+	// Initial status
+	SchedulerType_SCHEDULER_TYPE_UNSPECIFIED SchedulerType = 0
+	SchedulerType_TCP                        SchedulerType = 1
+	SchedulerType_GRPC                       SchedulerType = 2
+	SchedulerType_HTTP                       SchedulerType = 3
+	SchedulerType_SITE_MAP                   SchedulerType = 4
+	SchedulerType_HTTP_JSON_VALUE            SchedulerType = 5
+	SchedulerType_SSL_EXPIRATION             SchedulerType = 6
+	SchedulerType_MONGO                      SchedulerType = 7
+	SchedulerType_POSTGRES                   SchedulerType = 8
+	SchedulerType_CASSANDRA                  SchedulerType = 9
+	SchedulerType_MYSQL                      SchedulerType = 10
+	SchedulerType_DNS                        SchedulerType = 11
+	SchedulerType_UDP                        SchedulerType = 12
+	SchedulerType_REDIS                      SchedulerType = 13
+	SchedulerType_HTTP_SCENARIO              SchedulerType = 14
+)
+
+// Enum value maps for SchedulerType.
+var (
+	SchedulerType_name = map[int32]string{
+		0:  "SCHEDULER_TYPE_UNSPECIFIED",
+		1:  "TCP",
+		2:  "GRPC",
+		3:  "HTTP",
+		4:  "SITE_MAP",
+		5:  "HTTP_JSON_VALUE",
+		6:  "SSL_EXPIRATION",
+		7:  "MONGO",
+		8:  "POSTGRES",
+		9:  "CASSANDRA",
+		10: "MYSQL",
+		11: "DNS",
+		12: "UDP",
+		13: "REDIS",
+		14: "HTTP_SCENARIO",
+	}
+	SchedulerType_value = map[string]int32{
+		"SCHEDULER_TYPE_UNSPECIFIED": 0,
+		"TCP":                        1,
+		"GRPC":                       2,
+		"HTTP":                       3,
+		"SITE_MAP":                   4,
+		"HTTP_JSON_VALUE":            5,
+		"SSL_EXPIRATION":             6,
+		"MONGO":                      7,
+		"POSTGRES":                   8,
+		"CASSANDRA":                  9,
+		"MYSQL":                      10,
+		"DNS":                        11,
+		"UDP":                        12,
+		"REDIS":                      13,
+		"HTTP_SCENARIO":              14,
+	}
+)
+
+func (x SchedulerType) Enum() *SchedulerType {
+	p := new(SchedulerType)
+	*p = x
+	return p
+}
+
+func (x SchedulerType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SchedulerType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_monitoring_proto_enumTypes[4].Descriptor()
+}
+
+func (SchedulerType) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_monitoring_proto_enumTypes[4]
+}
+
+func (x SchedulerType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SchedulerType.Descriptor instead.
+func (SchedulerType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{4}
+}
+
+type DnsConfig_RecordType int32
+
+const (
+	// Initial status
+	DnsConfig_RECORD_TYPE_UNSPECIFIED DnsConfig_RecordType = 0
+	DnsConfig_A                       DnsConfig_RecordType = 1
+	DnsConfig_AAAA                    DnsConfig_RecordType = 2
+	DnsConfig_CNAME                   DnsConfig_RecordType = 3
+	DnsConfig_MX                      DnsConfig_RecordType = 4
+	DnsConfig_TXT                     DnsConfig_RecordType = 5
+	DnsConfig_NS                      DnsConfig_RecordType = 6
+	DnsConfig_SRV                     DnsConfig_RecordType = 7
+)
+
+// Enum value maps for DnsConfig_RecordType.
+var (
+	DnsConfig_RecordType_name = map[int32]string{
+		0: "RECORD_TYPE_UNSPECIFIED",
+		1: "A",
+		2: "AAAA",
+		3: "CNAME",
+		4: "MX",
+		5: "TXT",
+		6: "NS",
+		7: "SRV",
+	}
+	DnsConfig_RecordType_value = map[string]int32{
+		"RECORD_TYPE_UNSPECIFIED": 0,
+		"A":                       1,
+		"AAAA":                    2,
+		"CNAME":                   3,
+		"MX":                      4,
+		"TXT":                     5,
+		"NS":                      6,
+		"SRV":                     7,
+	}
+)
+
+func (x DnsConfig_RecordType) Enum() *DnsConfig_RecordType {
+	p := new(DnsConfig_RecordType)
+	*p = x
+	return p
+}
+
+func (x DnsConfig_RecordType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DnsConfig_RecordType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_monitoring_proto_enumTypes[5].Descriptor()
+}
+
+func (DnsConfig_RecordType) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_monitoring_proto_enumTypes[5]
+}
+
+func (x DnsConfig_RecordType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DnsConfig_RecordType.Descriptor instead.
+func (DnsConfig_RecordType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{8, 0}
+}
+
+type HttpScenarioStep_Extract_Source int32
+
+const (
+	HttpScenarioStep_Extract_EXTRACT_SOURCE_UNSPECIFIED HttpScenarioStep_Extract_Source = 0
+	HttpScenarioStep_Extract_JSON                       HttpScenarioStep_Extract_Source = 1
+	HttpScenarioStep_Extract_HEADER                     HttpScenarioStep_Extract_Source = 2
+	HttpScenarioStep_Extract_REGEX                      HttpScenarioStep_Extract_Source = 3
+)
+
+// Enum value maps for HttpScenarioStep_Extract_Source.
+var (
+	HttpScenarioStep_Extract_Source_name = map[int32]string{
+		0: "EXTRACT_SOURCE_UNSPECIFIED",
+		1: "JSON",
+		2: "HEADER",
+		3: "REGEX",
+	}
+	HttpScenarioStep_Extract_Source_value = map[string]int32{
+		"EXTRACT_SOURCE_UNSPECIFIED": 0,
+		"JSON":                       1,
+		"HEADER":                     2,
+		"REGEX":                      3,
+	}
+)
+
+func (x HttpScenarioStep_Extract_Source) Enum() *HttpScenarioStep_Extract_Source {
+	p := new(HttpScenarioStep_Extract_Source)
+	*p = x
+	return p
+}
+
+func (x HttpScenarioStep_Extract_Source) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HttpScenarioStep_Extract_Source) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_monitoring_proto_enumTypes[6].Descriptor()
+}
+
+func (HttpScenarioStep_Extract_Source) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_monitoring_proto_enumTypes[6]
+}
+
+func (x HttpScenarioStep_Extract_Source) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HttpScenarioStep_Extract_Source.Descriptor instead.
+func (HttpScenarioStep_Extract_Source) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{16, 1, 0}
+}
+
+type HttpJsonValueConfig_JsonValueParseType int32
+
+const (
+	// Initial status
+	HttpJsonValueConfig_JSON_PARSE_VALUE_UNSPECIFIED HttpJsonValueConfig_JsonValueParseType = 0
+	HttpJsonValueConfig_STRING                       HttpJsonValueConfig_JsonValueParseType = 1
+	HttpJsonValueConfig_BOOL                         HttpJsonValueConfig_JsonValueParseType = 2
+	HttpJsonValueConfig_NUMBER                       HttpJsonValueConfig_JsonValueParseType = 3
+	HttpJsonValueConfig_TIME                         HttpJsonValueConfig_JsonValueParseType = 4
+	HttpJsonValueConfig_ANY                          HttpJsonValueConfig_JsonValueParseType = 5
+	HttpJsonValueConfig_RAW                          HttpJsonValueConfig_JsonValueParseType = 6
+)
+
+// Enum value maps for HttpJsonValueConfig_JsonValueParseType.
+var (
+	HttpJsonValueConfig_JsonValueParseType_name = map[int32]string{
+		0: "JSON_PARSE_VALUE_UNSPECIFIED",
+		1: "STRING",
+		2: "BOOL",
+		3: "NUMBER",
+		4: "TIME",
+		5: "ANY",
+		6: "RAW",
+	}
+	HttpJsonValueConfig_JsonValueParseType_value = map[string]int32{
+		"JSON_PARSE_VALUE_UNSPECIFIED": 0,
+		"STRING":                       1,
+		"BOOL":                         2,
+		"NUMBER":                       3,
+		"TIME":                         4,
+		"ANY":                          5,
+		"RAW":                          6,
+	}
+)
+
+func (x HttpJsonValueConfig_JsonValueParseType) Enum() *HttpJsonValueConfig_JsonValueParseType {
+	p := new(HttpJsonValueConfig_JsonValueParseType)
+	*p = x
+	return p
+}
+
+func (x HttpJsonValueConfig_JsonValueParseType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HttpJsonValueConfig_JsonValueParseType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_monitoring_proto_enumTypes[7].Descriptor()
+}
+
+func (HttpJsonValueConfig_JsonValueParseType) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_monitoring_proto_enumTypes[7]
+}
+
+func (x HttpJsonValueConfig_JsonValueParseType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HttpJsonValueConfig_JsonValueParseType.Descriptor instead.
+func (HttpJsonValueConfig_JsonValueParseType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{20, 0}
+}
+
+type SchedulerChange_Action int32
+
+const (
+	SchedulerChange_ACTION_UNSPECIFIED SchedulerChange_Action = 0
+	SchedulerChange_CREATE             SchedulerChange_Action = 1
+	SchedulerChange_UPDATE             SchedulerChange_Action = 2
+	SchedulerChange_REMOVE             SchedulerChange_Action = 3
+)
+
+// Enum value maps for SchedulerChange_Action.
+var (
+	SchedulerChange_Action_name = map[int32]string{
+		0: "ACTION_UNSPECIFIED",
+		1: "CREATE",
+		2: "UPDATE",
+		3: "REMOVE",
+	}
+	SchedulerChange_Action_value = map[string]int32{
+		"ACTION_UNSPECIFIED": 0,
+		"CREATE":             1,
+		"UPDATE":             2,
+		"REMOVE":             3,
+	}
+)
+
+func (x SchedulerChange_Action) Enum() *SchedulerChange_Action {
+	p := new(SchedulerChange_Action)
+	*p = x
+	return p
+}
+
+func (x SchedulerChange_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SchedulerChange_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_monitoring_proto_enumTypes[8].Descriptor()
+}
+
+func (SchedulerChange_Action) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_monitoring_proto_enumTypes[8]
+}
+
+func (x SchedulerChange_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SchedulerChange_Action.Descriptor instead.
+func (SchedulerChange_Action) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{36, 0}
+}
+
+type BulkActionRequest_Action int32
+
+const (
+	BulkActionRequest_ACTION_UNSPECIFIED BulkActionRequest_Action = 0
+	BulkActionRequest_RUN                BulkActionRequest_Action = 1
+	BulkActionRequest_STOP               BulkActionRequest_Action = 2
+	BulkActionRequest_REMOVE             BulkActionRequest_Action = 3
+)
+
+// Enum value maps for BulkActionRequest_Action.
+var (
+	BulkActionRequest_Action_name = map[int32]string{
+		0: "ACTION_UNSPECIFIED",
+		1: "RUN",
+		2: "STOP",
+		3: "REMOVE",
+	}
+	BulkActionRequest_Action_value = map[string]int32{
+		"ACTION_UNSPECIFIED": 0,
+		"RUN":                1,
+		"STOP":               2,
+		"REMOVE":             3,
+	}
+)
+
+func (x BulkActionRequest_Action) Enum() *BulkActionRequest_Action {
+	p := new(BulkActionRequest_Action)
+	*p = x
+	return p
+}
+
+func (x BulkActionRequest_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BulkActionRequest_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_monitoring_proto_enumTypes[9].Descriptor()
+}
+
+func (BulkActionRequest_Action) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_monitoring_proto_enumTypes[9]
+}
+
+func (x BulkActionRequest_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BulkActionRequest_Action.Descriptor instead.
+func (BulkActionRequest_Action) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{38, 0}
+}
+
+type SchedulerSnapshotWithId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshot *SchedulerSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Id       string             `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *SchedulerSnapshotWithId) Reset() {
+	*x = SchedulerSnapshotWithId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchedulerSnapshotWithId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulerSnapshotWithId) ProtoMessage() {}
+
+func (x *SchedulerSnapshotWithId) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulerSnapshotWithId.ProtoReflect.Descriptor instead.
+func (*SchedulerSnapshotWithId) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SchedulerSnapshotWithId) GetSnapshot() *SchedulerSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *SchedulerSnapshotWithId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SchedulerSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code  SchedulerCode               `protobuf:"varint,2,opt,name=code,proto3,enum=squzy.v1.monitoring.SchedulerCode" json:"code,omitempty"`
+	Type  SchedulerType               `protobuf:"varint,3,opt,name=type,proto3,enum=squzy.v1.monitoring.SchedulerType" json:"type,omitempty"`
+	Error *SchedulerSnapshot_Error    `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Meta  *SchedulerSnapshot_MetaData `protobuf:"bytes,5,opt,name=meta,proto3" json:"meta,omitempty"`
+}
+
+func (x *SchedulerSnapshot) Reset() {
+	*x = SchedulerSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchedulerSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulerSnapshot) ProtoMessage() {}
+
+func (x *SchedulerSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulerSnapshot.ProtoReflect.Descriptor instead.
+func (*SchedulerSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SchedulerSnapshot) GetCode() SchedulerCode {
+	if x != nil {
+		return x.Code
+	}
+	return SchedulerCode_SCHEDULER_CODE_UNSPECIFIED
+}
+
+func (x *SchedulerSnapshot) GetType() SchedulerType {
+	if x != nil {
+		return x.Type
+	}
+	return SchedulerType_SCHEDULER_TYPE_UNSPECIFIED
+}
+
+func (x *SchedulerSnapshot) GetError() *SchedulerSnapshot_Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *SchedulerSnapshot) GetMeta() *SchedulerSnapshot_MetaData {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type GetSchedulerByIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetSchedulerByIdRequest) Reset() {
+	*x = GetSchedulerByIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSchedulerByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchedulerByIdRequest) ProtoMessage() {}
+
+func (x *GetSchedulerByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchedulerByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetSchedulerByIdRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetSchedulerByIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Scheduler struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name   string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type   SchedulerType   `protobuf:"varint,3,opt,name=type,proto3,enum=squzy.v1.monitoring.SchedulerType" json:"type,omitempty"`
+	Status SchedulerStatus `protobuf:"varint,4,opt,name=status,proto3,enum=squzy.v1.monitoring.SchedulerStatus" json:"status,omitempty"`
+	// How often we need execute check
+	Interval int32 `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Timeout for check request
+	Timeout int32 `protobuf:"varint,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Config of the scheduler;
+	//
+	// Types that are assignable to Config:
+	//
+	//	*Scheduler_Tcp
+	//	*Scheduler_Sitemap
+	//	*Scheduler_Grpc
+	//	*Scheduler_Http
+	//	*Scheduler_HttpValue
+	//	*Scheduler_SslExpiration
+	//	*Scheduler_Mongo
+	//	*Scheduler_Postgres
+	//	*Scheduler_Cassandra
+	//	*Scheduler_Mysql
+	//	*Scheduler_Dns
+	//	*Scheduler_Udp
+	//	*Scheduler_Redis
+	//	*Scheduler_HttpScenario
+	Config isScheduler_Config `protobuf_oneof:"config"`
+	// Cron expression (UTC unless CRON_TZ= is set), used instead of interval
+	Cron string `protobuf:"bytes,17,opt,name=cron,proto3" json:"cron,omitempty"`
+	// Key/value labels like team, env or service
+	Labels      map[string]string `protobuf:"bytes,18,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RetryPolicy *RetryPolicy      `protobuf:"bytes,19,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// Schedulers this check depends on
+	ParentIds          []string       `protobuf:"bytes,20,rep,name=parent_ids,json=parentIds,proto3" json:"parent_ids,omitempty"`
+	Locations          []string       `protobuf:"bytes,21,rep,name=locations,proto3" json:"locations,omitempty"`
+	MinFailedLocations int32          `protobuf:"varint,22,opt,name=min_failed_locations,json=minFailedLocations,proto3" json:"min_failed_locations,omitempty"`
+	FailureInterval    int32          `protobuf:"varint,23,opt,name=failure_interval,json=failureInterval,proto3" json:"failure_interval,omitempty"`
+	RecoverAfter       int32          `protobuf:"varint,24,opt,name=recover_after,json=recoverAfter,proto3" json:"recover_after,omitempty"`
+	FlapDetection      *FlapDetection `protobuf:"bytes,25,opt,name=flap_detection,json=flapDetection,proto3" json:"flap_detection,omitempty"`
+	// Percent of state changes between recent results, only set with flap detection
+	StateChange float64 `protobuf:"fixed64,26,opt,name=state_change,json=stateChange,proto3" json:"state_change,omitempty"`
+	Flapping    bool    `protobuf:"varint,27,opt,name=flapping,proto3" json:"flapping,omitempty"`
+}
+
+func (x *Scheduler) Reset() {
+	*x = Scheduler{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Scheduler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scheduler) ProtoMessage() {}
+
+func (x *Scheduler) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scheduler.ProtoReflect.Descriptor instead.
+func (*Scheduler) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Scheduler) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Scheduler) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Scheduler) GetType() SchedulerType {
+	if x != nil {
+		return x.Type
+	}
+	return SchedulerType_SCHEDULER_TYPE_UNSPECIFIED
+}
+
+func (x *Scheduler) GetStatus() SchedulerStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SchedulerStatus_SCHEDULER_STATUS_UNSPECIFIED
+}
+
+func (x *Scheduler) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *Scheduler) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (m *Scheduler) GetConfig() isScheduler_Config {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (x *Scheduler) GetTcp() *TcpConfig {
+	if x, ok := x.GetConfig().(*Scheduler_Tcp); ok {
+		return x.Tcp
+	}
+	return nil
+}
+
+func (x *Scheduler) GetSitemap() *SiteMapConfig {
+	if x, ok := x.GetConfig().(*Scheduler_Sitemap); ok {
+		return x.Sitemap
+	}
+	return nil
+}
+
+func (x *Scheduler) GetGrpc() *GrpcConfig {
+	if x, ok := x.GetConfig().(*Scheduler_Grpc); ok {
+		return x.Grpc
+	}
+	return nil
+}
+
+func (x *Scheduler) GetHttp() *HttpConfig {
+	if x, ok := x.GetConfig().(*Scheduler_Http); ok {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *Scheduler) GetHttpValue() *HttpJsonValueConfig {
+	if x, ok := x.GetConfig().(*Scheduler_HttpValue); ok {
+		return x.HttpValue
+	}
+	return nil
+}
+
+func (x *Scheduler) GetSslExpiration() *SslExpirationConfig {
+	if x, ok := x.GetConfig().(*Scheduler_SslExpiration); ok {
+		return x.SslExpiration
+	}
+	return nil
+}
+
+func (x *Scheduler) GetMongo() *DbConfig {
+	if x, ok := x.GetConfig().(*Scheduler_Mongo); ok {
+		return x.Mongo
+	}
+	return nil
+}
+
+func (x *Scheduler) GetPostgres() *DbConfig {
+	if x, ok := x.GetConfig().(*Scheduler_Postgres); ok {
+		return x.Postgres
+	}
+	return nil
+}
+
+func (x *Scheduler) GetCassandra() *DbConfig {
+	if x, ok := x.GetConfig().(*Scheduler_Cassandra); ok {
+		return x.Cassandra
+	}
+	return nil
+}
+
+func (x *Scheduler) GetMysql() *DbConfig {
+	if x, ok := x.GetConfig().(*Scheduler_Mysql); ok {
+		return x.Mysql
+	}
+	return nil
+}
+
+func (x *Scheduler) GetDns() *DnsConfig {
+	if x, ok := x.GetConfig().(*Scheduler_Dns); ok {
+		return x.Dns
+	}
+	return nil
+}
+
+func (x *Scheduler) GetUdp() *TcpConfig {
+	if x, ok := x.GetConfig().(*Scheduler_Udp); ok {
+		return x.Udp
+	}
+	return nil
+}
+
+func (x *Scheduler) GetRedis() *RedisConfig {
+	if x, ok := x.GetConfig().(*Scheduler_Redis); ok {
+		return x.Redis
+	}
+	return nil
+}
+
+func (x *Scheduler) GetHttpScenario() *HttpScenarioConfig {
+	if x, ok := x.GetConfig().(*Scheduler_HttpScenario); ok {
+		return x.HttpScenario
+	}
+	return nil
+}
+
+func (x *Scheduler) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *Scheduler) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Scheduler) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *Scheduler) GetParentIds() []string {
+	if x != nil {
+		return x.ParentIds
+	}
+	return nil
+}
+
+func (x *Scheduler) GetLocations() []string {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+func (x *Scheduler) GetMinFailedLocations() int32 {
+	if x != nil {
+		return x.MinFailedLocations
+	}
+	return 0
+}
+
+func (x *Scheduler) GetFailureInterval() int32 {
+	if x != nil {
+		return x.FailureInterval
+	}
+	return 0
+}
+
+func (x *Scheduler) GetRecoverAfter() int32 {
+	if x != nil {
+		return x.RecoverAfter
+	}
+	return 0
+}
+
+func (x *Scheduler) GetFlapDetection() *FlapDetection {
+	if x != nil {
+		return x.FlapDetection
+	}
+	return nil
+}
+
+func (x *Scheduler) GetStateChange() float64 {
+	if x != nil {
+		return x.StateChange
+	}
+	return 0
+}
+
+func (x *Scheduler) GetFlapping() bool {
+	if x != nil {
+		return x.Flapping
+	}
+	return false
+}
+
+type isScheduler_Config interface {
+	isScheduler_Config()
+}
+
+type Scheduler_Tcp struct {
+	Tcp *TcpConfig `protobuf:"bytes,7,opt,name=tcp,proto3,oneof"`
+}
+
+type Scheduler_Sitemap struct {
+	Sitemap *SiteMapConfig `protobuf:"bytes,8,opt,name=sitemap,proto3,oneof"`
+}
+
+type Scheduler_Grpc struct {
+	Grpc *GrpcConfig `protobuf:"bytes,9,opt,name=grpc,proto3,oneof"`
+}
+
+type Scheduler_Http struct {
+	Http *HttpConfig `protobuf:"bytes,10,opt,name=http,proto3,oneof"`
+}
+
+type Scheduler_HttpValue struct {
+	HttpValue *HttpJsonValueConfig `protobuf:"bytes,11,opt,name=http_value,json=httpValue,proto3,oneof"`
+}
+
+type Scheduler_SslExpiration struct {
+	SslExpiration *SslExpirationConfig `protobuf:"bytes,12,opt,name=ssl_expiration,json=sslExpiration,proto3,oneof"`
+}
+
+type Scheduler_Mongo struct {
+	Mongo *DbConfig `protobuf:"bytes,13,opt,name=mongo,proto3,oneof"`
+}
+
+type Scheduler_Postgres struct {
+	Postgres *DbConfig `protobuf:"bytes,14,opt,name=postgres,proto3,oneof"`
+}
+
+type Scheduler_Cassandra struct {
+	Cassandra *DbConfig `protobuf:"bytes,15,opt,name=cassandra,proto3,oneof"`
+}
+
+type Scheduler_Mysql struct {
+	Mysql *DbConfig `protobuf:"bytes,16,opt,name=mysql,proto3,oneof"`
+}
+
+type Scheduler_Dns struct {
+	Dns *DnsConfig `protobuf:"bytes,28,opt,name=dns,proto3,oneof"`
+}
+
+type Scheduler_Udp struct {
+	Udp *TcpConfig `protobuf:"bytes,29,opt,name=udp,proto3,oneof"`
+}
+
+type Scheduler_Redis struct {
+	Redis *RedisConfig `protobuf:"bytes,30,opt,name=redis,proto3,oneof"`
+}
+
+type Scheduler_HttpScenario struct {
+	HttpScenario *HttpScenarioConfig `protobuf:"bytes,31,opt,name=http_scenario,json=httpScenario,proto3,oneof"`
+}
+
+func (*Scheduler_Tcp) isScheduler_Config() {}
+
+func (*Scheduler_Sitemap) isScheduler_Config() {}
+
+func (*Scheduler_Grpc) isScheduler_Config() {}
+
+func (*Scheduler_Http) isScheduler_Config() {}
+
+func (*Scheduler_HttpValue) isScheduler_Config() {}
+
+func (*Scheduler_SslExpiration) isScheduler_Config() {}
+
+func (*Scheduler_Mongo) isScheduler_Config() {}
+
+func (*Scheduler_Postgres) isScheduler_Config() {}
+
+func (*Scheduler_Cassandra) isScheduler_Config() {}
+
+func (*Scheduler_Mysql) isScheduler_Config() {}
+
+func (*Scheduler_Dns) isScheduler_Config() {}
+
+func (*Scheduler_Udp) isScheduler_Config() {}
+
+func (*Scheduler_Redis) isScheduler_Config() {}
+
+func (*Scheduler_HttpScenario) isScheduler_Config() {}
+
+type GetSchedulerListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Label selector like "team=payments,env!=prod", empty selector returns all
+	Selector string `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+}
+
+func (x *GetSchedulerListRequest) Reset() {
+	*x = GetSchedulerListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSchedulerListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchedulerListRequest) ProtoMessage() {}
+
+func (x *GetSchedulerListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchedulerListRequest.ProtoReflect.Descriptor instead.
+func (*GetSchedulerListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetSchedulerListRequest) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+type GetSchedulerListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lists []*Scheduler `protobuf:"bytes,1,rep,name=lists,proto3" json:"lists,omitempty"`
+}
+
+func (x *GetSchedulerListResponse) Reset() {
+	*x = GetSchedulerListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSchedulerListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchedulerListResponse) ProtoMessage() {}
+
+func (x *GetSchedulerListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchedulerListResponse.ProtoReflect.Descriptor instead.
+func (*GetSchedulerListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSchedulerListResponse) GetLists() []*Scheduler {
+	if x != nil {
+		return x.Lists
+	}
+	return nil
+}
+
+type SiteMapConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url         string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Concurrency int32  `protobuf:"varint,2,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+}
+
+func (x *SiteMapConfig) Reset() {
+	*x = SiteMapConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteMapConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteMapConfig) ProtoMessage() {}
+
+func (x *SiteMapConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteMapConfig.ProtoReflect.Descriptor instead.
+func (*SiteMapConfig) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SiteMapConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SiteMapConfig) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+type TcpConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Port int32  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// Check is WARNING when it takes longer, in milliseconds, 0 means never
+	WarningTime int32 `protobuf:"varint,3,opt,name=warning_time,json=warningTime,proto3" json:"warning_time,omitempty"`
+	// Payload sent after connect, it is required for UDP
+	Send string `protobuf:"bytes,4,opt,name=send,proto3" json:"send,omitempty"`
+	// Reply should be equal to expect or match expect_regex, only one of them could be set
+	Expect      string `protobuf:"bytes,5,opt,name=expect,proto3" json:"expect,omitempty"`
+	ExpectRegex string `protobuf:"bytes,6,opt,name=expect_regex,json=expectRegex,proto3" json:"expect_regex,omitempty"`
+	// Milliseconds to wait reply, timeout of check is used by default
+	ReadTimeout int32 `protobuf:"varint,7,opt,name=read_timeout,json=readTimeout,proto3" json:"read_timeout,omitempty"`
+}
+
+func (x *TcpConfig) Reset() {
+	*x = TcpConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TcpConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TcpConfig) ProtoMessage() {}
+
+func (x *TcpConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TcpConfig.ProtoReflect.Descriptor instead.
+func (*TcpConfig) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TcpConfig) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *TcpConfig) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *TcpConfig) GetWarningTime() int32 {
+	if x != nil {
+		return x.WarningTime
+	}
+	return 0
+}
+
+func (x *TcpConfig) GetSend() string {
+	if x != nil {
+		return x.Send
+	}
+	return ""
+}
+
+func (x *TcpConfig) GetExpect() string {
+	if x != nil {
+		return x.Expect
+	}
+	return ""
+}
+
+func (x *TcpConfig) GetExpectRegex() string {
+	if x != nil {
+		return x.ExpectRegex
+	}
+	return ""
+}
+
+func (x *TcpConfig) GetReadTimeout() int32 {
+	if x != nil {
+		return x.ReadTimeout
+	}
+	return 0
+}
+
+type DnsConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Address of resolver like 8.8.8.8:53, port 53 is used by default
+	Resolver   string               `protobuf:"bytes,1,opt,name=resolver,proto3" json:"resolver,omitempty"`
+	Name       string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RecordType DnsConfig_RecordType `protobuf:"varint,3,opt,name=record_type,json=recordType,proto3,enum=squzy.v1.monitoring.DnsConfig_RecordType" json:"record_type,omitempty"`
+	// Every expected value should be in answers, empty means any answer
+	Expected []string `protobuf:"bytes,4,rep,name=expected,proto3" json:"expected,omitempty"`
+	// Check fails when TTL of answer is bigger, in seconds, 0 means never
+	MaxTtl int32 `protobuf:"varint,5,opt,name=max_ttl,json=maxTtl,proto3" json:"max_ttl,omitempty"`
+}
+
+func (x *DnsConfig) Reset() {
+	*x = DnsConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DnsConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DnsConfig) ProtoMessage() {}
+
+func (x *DnsConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DnsConfig.ProtoReflect.Descriptor instead.
+func (*DnsConfig) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DnsConfig) GetResolver() string {
+	if x != nil {
+		return x.Resolver
+	}
+	return ""
+}
+
+func (x *DnsConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DnsConfig) GetRecordType() DnsConfig_RecordType {
+	if x != nil {
+		return x.RecordType
+	}
+	return DnsConfig_RECORD_TYPE_UNSPECIFIED
+}
+
+func (x *DnsConfig) GetExpected() []string {
+	if x != nil {
+		return x.Expected
+	}
+	return nil
+}
+
+func (x *DnsConfig) GetMaxTtl() int32 {
+	if x != nil {
+		return x.MaxTtl
+	}
+	return 0
+}
+
+type SslExpirationConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Port int32  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	// Check is WARNING when certificate expires in less days, 0 means never
+	WarningDays int32 `protobuf:"varint,4,opt,name=warning_days,json=warningDays,proto3" json:"warning_days,omitempty"`
+}
+
+func (x *SslExpirationConfig) Reset() {
+	*x = SslExpirationConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SslExpirationConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SslExpirationConfig) ProtoMessage() {}
+
+func (x *SslExpirationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SslExpirationConfig.ProtoReflect.Descriptor instead.
+func (*SslExpirationConfig) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SslExpirationConfig) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *SslExpirationConfig) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *SslExpirationConfig) GetWarningDays() int32 {
+	if x != nil {
+		return x.WarningDays
+	}
+	return 0
+}
+
+type DbConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host     string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Port     int32  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	User     string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	DbName   string `protobuf:"bytes,5,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	Cluster  string `protobuf:"bytes,6,opt,name=cluster,proto3" json:"cluster,omitempty"`
+}
+
+func (x *DbConfig) Reset() {
+	*x = DbConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DbConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DbConfig) ProtoMessage() {}
+
+func (x *DbConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DbConfig.ProtoReflect.Descriptor instead.
+func (*DbConfig) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DbConfig) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *DbConfig) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *DbConfig) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *DbConfig) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *DbConfig) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *DbConfig) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+type RedisConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Port int32  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// ACL user, only password is sent with AUTH when it is empty
+	User               string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Password           string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Db                 int32  `protobuf:"varint,5,opt,name=db,proto3" json:"db,omitempty"`
+	Tls                bool   `protobuf:"varint,6,opt,name=tls,proto3" json:"tls,omitempty"`
+	InsecureSkipVerify bool   `protobuf:"varint,7,opt,name=insecure_skip_verify,json=insecureSkipVerify,proto3" json:"insecure_skip_verify,omitempty"`
+	// Thresholds of INFO values, 0 means not checked
+	MaxUsedMemory       int64 `protobuf:"varint,8,opt,name=max_used_memory,json=maxUsedMemory,proto3" json:"max_used_memory,omitempty"`
+	MaxConnectedClients int64 `protobuf:"varint,9,opt,name=max_connected_clients,json=maxConnectedClients,proto3" json:"max_connected_clients,omitempty"`
+	// Sum of keys of all databases from keyspace section
+	MaxKeys int64 `protobuf:"varint,10,opt,name=max_keys,json=maxKeys,proto3" json:"max_keys,omitempty"`
+	// Replica fails when master_link_status is not up
+	MasterLinkUp bool `protobuf:"varint,11,opt,name=master_link_up,json=masterLinkUp,proto3" json:"master_link_up,omitempty"`
+}
+
+func (x *RedisConfig) Reset() {
+	*x = RedisConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedisConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedisConfig) ProtoMessage() {}
+
+func (x *RedisConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedisConfig.ProtoReflect.Descriptor instead.
+func (*RedisConfig) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RedisConfig) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *RedisConfig) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *RedisConfig) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *RedisConfig) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *RedisConfig) GetDb() int32 {
+	if x != nil {
+		return x.Db
+	}
+	return 0
+}
+
+func (x *RedisConfig) GetTls() bool {
+	if x != nil {
+		return x.Tls
+	}
+	return false
+}
+
+func (x *RedisConfig) GetInsecureSkipVerify() bool {
+	if x != nil {
+		return x.InsecureSkipVerify
+	}
+	return false
+}
+
+func (x *RedisConfig) GetMaxUsedMemory() int64 {
+	if x != nil {
+		return x.MaxUsedMemory
+	}
+	return 0
+}
+
+func (x *RedisConfig) GetMaxConnectedClients() int64 {
+	if x != nil {
+		return x.MaxConnectedClients
+	}
+	return 0
+}
+
+func (x *RedisConfig) GetMaxKeys() int64 {
+	if x != nil {
+		return x.MaxKeys
+	}
+	return 0
+}
+
+func (x *RedisConfig) GetMasterLinkUp() bool {
+	if x != nil {
+		return x.MasterLinkUp
+	}
+	return false
+}
+
+type GrpcConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Host    string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Port    int32  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	// Check is WARNING when it takes longer, in milliseconds, 0 means never
+	WarningTime int32 `protobuf:"varint,4,opt,name=warning_time,json=warningTime,proto3" json:"warning_time,omitempty"`
+}
+
+func (x *GrpcConfig) Reset() {
+	*x = GrpcConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrpcConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrpcConfig) ProtoMessage() {}
+
+func (x *GrpcConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrpcConfig.ProtoReflect.Descriptor instead.
+func (*GrpcConfig) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GrpcConfig) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *GrpcConfig) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *GrpcConfig) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *GrpcConfig) GetWarningTime() int32 {
+	if x != nil {
+		return x.WarningTime
+	}
+	return 0
+}
+
+type HttpConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method     string            `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Url        string            `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Headers    map[string]string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	StatusCode int32             `protobuf:"varint,4,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// Check is WARNING when it takes longer, in milliseconds, 0 means never
+	WarningTime int32           `protobuf:"varint,5,opt,name=warning_time,json=warningTime,proto3" json:"warning_time,omitempty"`
+	Assertions  *HttpAssertions `protobuf:"bytes,6,opt,name=assertions,proto3" json:"assertions,omitempty"`
+	Body        *HttpBody       `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"`
+	Auth        *HttpAuth       `protobuf:"bytes,8,opt,name=auth,proto3" json:"auth,omitempty"`
+	Tls         *HttpTls        `protobuf:"bytes,9,opt,name=tls,proto3" json:"tls,omitempty"`
+}
+
+func (x *HttpConfig) Reset() {
+	*x = HttpConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpConfig) ProtoMessage() {}
+
+func (x *HttpConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpConfig.ProtoReflect.Descriptor instead.
+func (*HttpConfig) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *HttpConfig) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *HttpConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *HttpConfig) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *HttpConfig) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *HttpConfig) GetWarningTime() int32 {
+	if x != nil {
+		return x.WarningTime
+	}
+	return 0
+}
+
+func (x *HttpConfig) GetAssertions() *HttpAssertions {
+	if x != nil {
+		return x.Assertions
+	}
+	return nil
+}
+
+func (x *HttpConfig) GetBody() *HttpBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *HttpConfig) GetAuth() *HttpAuth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *HttpConfig) GetTls() *HttpTls {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+// Only one kind of auth could be set, Authorization header from headers is replaced
+type HttpAuth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BasicUser     string           `protobuf:"bytes,1,opt,name=basic_user,json=basicUser,proto3" json:"basic_user,omitempty"`
+	BasicPassword string           `protobuf:"bytes,2,opt,name=basic_password,json=basicPassword,proto3" json:"basic_password,omitempty"`
+	BearerToken   string           `protobuf:"bytes,3,opt,name=bearer_token,json=bearerToken,proto3" json:"bearer_token,omitempty"`
+	Oauth2        *HttpAuth_OAuth2 `protobuf:"bytes,4,opt,name=oauth2,proto3" json:"oauth2,omitempty"`
+}
+
+func (x *HttpAuth) Reset() {
+	*x = HttpAuth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpAuth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpAuth) ProtoMessage() {}
+
+func (x *HttpAuth) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpAuth.ProtoReflect.Descriptor instead.
+func (*HttpAuth) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *HttpAuth) GetBasicUser() string {
+	if x != nil {
+		return x.BasicUser
+	}
+	return ""
+}
+
+func (x *HttpAuth) GetBasicPassword() string {
+	if x != nil {
+		return x.BasicPassword
+	}
+	return ""
+}
+
+func (x *HttpAuth) GetBearerToken() string {
+	if x != nil {
+		return x.BearerToken
+	}
+	return ""
+}
+
+func (x *HttpAuth) GetOauth2() *HttpAuth_OAuth2 {
+	if x != nil {
+		return x.Oauth2
+	}
+	return nil
+}
+
+// Steps are executed in order, scenario stops at first failed step
+type HttpScenarioConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Steps []*HttpScenarioStep `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+	// Check is WARNING when whole scenario takes longer, in milliseconds, 0 means never
+	WarningTime int32 `protobuf:"varint,2,opt,name=warning_time,json=warningTime,proto3" json:"warning_time,omitempty"`
+	// Used by every step
+	Auth *HttpAuth `protobuf:"bytes,3,opt,name=auth,proto3" json:"auth,omitempty"`
+	Tls  *HttpTls  `protobuf:"bytes,4,opt,name=tls,proto3" json:"tls,omitempty"`
+}
+
+func (x *HttpScenarioConfig) Reset() {
+	*x = HttpScenarioConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpScenarioConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpScenarioConfig) ProtoMessage() {}
+
+func (x *HttpScenarioConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpScenarioConfig.ProtoReflect.Descriptor instead.
+func (*HttpScenarioConfig) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *HttpScenarioConfig) GetSteps() []*HttpScenarioStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *HttpScenarioConfig) GetWarningTime() int32 {
+	if x != nil {
+		return x.WarningTime
+	}
+	return 0
+}
+
+func (x *HttpScenarioConfig) GetAuth() *HttpAuth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *HttpScenarioConfig) GetTls() *HttpTls {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+type HttpScenarioStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// Url, headers and body content could use extracted variables as {{name}}
+	Url     string            `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Headers map[string]string `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Body    *HttpBody         `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	// 200 when not set
+	StatusCode int32                       `protobuf:"varint,6,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Assertions *HttpAssertions             `protobuf:"bytes,7,opt,name=assertions,proto3" json:"assertions,omitempty"`
+	Extract    []*HttpScenarioStep_Extract `protobuf:"bytes,8,rep,name=extract,proto3" json:"extract,omitempty"`
+}
+
+func (x *HttpScenarioStep) Reset() {
+	*x = HttpScenarioStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpScenarioStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpScenarioStep) ProtoMessage() {}
+
+func (x *HttpScenarioStep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpScenarioStep.ProtoReflect.Descriptor instead.
+func (*HttpScenarioStep) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *HttpScenarioStep) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HttpScenarioStep) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *HttpScenarioStep) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *HttpScenarioStep) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *HttpScenarioStep) GetBody() *HttpBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *HttpScenarioStep) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *HttpScenarioStep) GetAssertions() *HttpAssertions {
+	if x != nil {
+		return x.Assertions
+	}
+	return nil
+}
+
+func (x *HttpScenarioStep) GetExtract() []*HttpScenarioStep_Extract {
+	if x != nil {
+		return x.Extract
+	}
+	return nil
+}
+
+// Certificates and keys are PEM encoded
+type HttpTls struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientCert         string `protobuf:"bytes,1,opt,name=client_cert,json=clientCert,proto3" json:"client_cert,omitempty"`
+	ClientKey          string `protobuf:"bytes,2,opt,name=client_key,json=clientKey,proto3" json:"client_key,omitempty"`
+	CaBundle           string `protobuf:"bytes,3,opt,name=ca_bundle,json=caBundle,proto3" json:"ca_bundle,omitempty"`
+	InsecureSkipVerify bool   `protobuf:"varint,4,opt,name=insecure_skip_verify,json=insecureSkipVerify,proto3" json:"insecure_skip_verify,omitempty"`
+}
+
+func (x *HttpTls) Reset() {
+	*x = HttpTls{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpTls) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpTls) ProtoMessage() {}
+
+func (x *HttpTls) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpTls.ProtoReflect.Descriptor instead.
+func (*HttpTls) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *HttpTls) GetClientCert() string {
+	if x != nil {
+		return x.ClientCert
+	}
+	return ""
+}
+
+func (x *HttpTls) GetClientKey() string {
+	if x != nil {
+		return x.ClientKey
+	}
+	return ""
+}
+
+func (x *HttpTls) GetCaBundle() string {
+	if x != nil {
+		return x.CaBundle
+	}
+	return ""
+}
+
+func (x *HttpTls) GetInsecureSkipVerify() bool {
+	if x != nil {
+		return x.InsecureSkipVerify
+	}
+	return false
+}
+
+// Content could have placeholders {{timestamp}}, {{timestamp_ms}} and {{uuid}}, they are replaced for every request
+type HttpBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// Sent as Content-Type header, header from headers has priority
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+}
+
+func (x *HttpBody) Reset() {
+	*x = HttpBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpBody) ProtoMessage() {}
+
+func (x *HttpBody) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpBody.ProtoReflect.Descriptor instead.
+func (*HttpBody) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *HttpBody) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *HttpBody) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+// Check fails when any of assertions is failed, every failed one is reported
+type HttpAssertions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BodyContains    []string                 `protobuf:"bytes,1,rep,name=body_contains,json=bodyContains,proto3" json:"body_contains,omitempty"`
+	BodyNotContains []string                 `protobuf:"bytes,2,rep,name=body_not_contains,json=bodyNotContains,proto3" json:"body_not_contains,omitempty"`
+	BodyRegex       string                   `protobuf:"bytes,3,opt,name=body_regex,json=bodyRegex,proto3" json:"body_regex,omitempty"`
+	Headers         []*HttpAssertions_Header `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty"`
+	// In milliseconds, 0 means not checked
+	MaxResponseTime int32 `protobuf:"varint,5,opt,name=max_response_time,json=maxResponseTime,proto3" json:"max_response_time,omitempty"`
+}
+
+func (x *HttpAssertions) Reset() {
+	*x = HttpAssertions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpAssertions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpAssertions) ProtoMessage() {}
+
+func (x *HttpAssertions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpAssertions.ProtoReflect.Descriptor instead.
+func (*HttpAssertions) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *HttpAssertions) GetBodyContains() []string {
+	if x != nil {
+		return x.BodyContains
+	}
+	return nil
+}
+
+func (x *HttpAssertions) GetBodyNotContains() []string {
+	if x != nil {
+		return x.BodyNotContains
+	}
+	return nil
+}
+
+func (x *HttpAssertions) GetBodyRegex() string {
+	if x != nil {
+		return x.BodyRegex
+	}
+	return ""
+}
+
+func (x *HttpAssertions) GetHeaders() []*HttpAssertions_Header {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *HttpAssertions) GetMaxResponseTime() int32 {
+	if x != nil {
+		return x.MaxResponseTime
+	}
+	return 0
+}
+
+type HttpJsonValueConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method    string                           `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Url       string                           `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Headers   map[string]string                `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Selectors []*HttpJsonValueConfig_Selectors `protobuf:"bytes,4,rep,name=selectors,proto3" json:"selectors,omitempty"`
+	// Check is WARNING when it takes longer, in milliseconds, 0 means never
+	WarningTime int32     `protobuf:"varint,5,opt,name=warning_time,json=warningTime,proto3" json:"warning_time,omitempty"`
+	Body        *HttpBody `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	Auth        *HttpAuth `protobuf:"bytes,7,opt,name=auth,proto3" json:"auth,omitempty"`
+	Tls         *HttpTls  `protobuf:"bytes,8,opt,name=tls,proto3" json:"tls,omitempty"`
+}
+
+func (x *HttpJsonValueConfig) Reset() {
+	*x = HttpJsonValueConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpJsonValueConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpJsonValueConfig) ProtoMessage() {}
+
+func (x *HttpJsonValueConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpJsonValueConfig.ProtoReflect.Descriptor instead.
+func (*HttpJsonValueConfig) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *HttpJsonValueConfig) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *HttpJsonValueConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *HttpJsonValueConfig) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *HttpJsonValueConfig) GetSelectors() []*HttpJsonValueConfig_Selectors {
+	if x != nil {
+		return x.Selectors
+	}
+	return nil
+}
+
+func (x *HttpJsonValueConfig) GetWarningTime() int32 {
+	if x != nil {
+		return x.WarningTime
+	}
+	return 0
+}
+
+func (x *HttpJsonValueConfig) GetBody() *HttpBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *HttpJsonValueConfig) GetAuth() *HttpAuth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *HttpJsonValueConfig) GetTls() *HttpTls {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+type AddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// How often we need execute check
+	Interval int32 `protobuf:"varint,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Timeout for check request
+	Timeout int32  `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Name    string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are assignable to Config:
+	//
+	//	*AddRequest_Tcp
+	//	*AddRequest_Sitemap
+	//	*AddRequest_Grpc
+	//	*AddRequest_Http
+	//	*AddRequest_HttpValue
+	//	*AddRequest_SslExpiration
+	//	*AddRequest_Mongo
+	//	*AddRequest_Postgres
+	//	*AddRequest_Cassandra
+	//	*AddRequest_Mysql
+	//	*AddRequest_Dns
+	//	*AddRequest_Udp
+	//	*AddRequest_Redis
+	//	*AddRequest_HttpScenario
+	Config isAddRequest_Config `protobuf_oneof:"config"`
+	// Cron expression (UTC unless CRON_TZ= is set), used instead of interval
+	Cron        string            `protobuf:"bytes,14,opt,name=cron,proto3" json:"cron,omitempty"`
+	Labels      map[string]string `protobuf:"bytes,15,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RetryPolicy *RetryPolicy      `protobuf:"bytes,16,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// Failed check is SKIPPED when one of parents is failing
+	ParentIds []string `protobuf:"bytes,17,rep,name=parent_ids,json=parentIds,proto3" json:"parent_ids,omitempty"`
+	// Check is executed by probes of every location instead of squzy monitoring
+	Locations []string `protobuf:"bytes,18,rep,name=locations,proto3" json:"locations,omitempty"`
+	// Check fails when it failed in so many locations, 0 means 1
+	MinFailedLocations int32 `protobuf:"varint,19,opt,name=min_failed_locations,json=minFailedLocations,proto3" json:"min_failed_locations,omitempty"`
+	// How often check is executed while it is failing, 0 means same as usual
+	FailureInterval int32 `protobuf:"varint,20,opt,name=failure_interval,json=failureInterval,proto3" json:"failure_interval,omitempty"`
+	// Usual schedule is back after so many OK results in a row, 0 means 1
+	RecoverAfter int32 `protobuf:"varint,21,opt,name=recover_after,json=recoverAfter,proto3" json:"recover_after,omitempty"`
+	// Recent results are tracked only with flap detection
+	FlapDetection *FlapDetection `protobuf:"bytes,22,opt,name=flap_detection,json=flapDetection,proto3" json:"flap_detection,omitempty"`
+}
+
+func (x *AddRequest) Reset() {
+	*x = AddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRequest) ProtoMessage() {}
+
+func (x *AddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRequest.ProtoReflect.Descriptor instead.
+func (*AddRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AddRequest) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *AddRequest) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *AddRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (m *AddRequest) GetConfig() isAddRequest_Config {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (x *AddRequest) GetTcp() *TcpConfig {
+	if x, ok := x.GetConfig().(*AddRequest_Tcp); ok {
+		return x.Tcp
+	}
+	return nil
+}
+
+func (x *AddRequest) GetSitemap() *SiteMapConfig {
+	if x, ok := x.GetConfig().(*AddRequest_Sitemap); ok {
+		return x.Sitemap
+	}
+	return nil
+}
+
+func (x *AddRequest) GetGrpc() *GrpcConfig {
+	if x, ok := x.GetConfig().(*AddRequest_Grpc); ok {
+		return x.Grpc
+	}
+	return nil
+}
+
+func (x *AddRequest) GetHttp() *HttpConfig {
+	if x, ok := x.GetConfig().(*AddRequest_Http); ok {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *AddRequest) GetHttpValue() *HttpJsonValueConfig {
+	if x, ok := x.GetConfig().(*AddRequest_HttpValue); ok {
+		return x.HttpValue
+	}
+	return nil
+}
+
+func (x *AddRequest) GetSslExpiration() *SslExpirationConfig {
+	if x, ok := x.GetConfig().(*AddRequest_SslExpiration); ok {
+		return x.SslExpiration
+	}
+	return nil
+}
+
+func (x *AddRequest) GetMongo() *DbConfig {
+	if x, ok := x.GetConfig().(*AddRequest_Mongo); ok {
+		return x.Mongo
+	}
+	return nil
+}
+
+func (x *AddRequest) GetPostgres() *DbConfig {
+	if x, ok := x.GetConfig().(*AddRequest_Postgres); ok {
+		return x.Postgres
+	}
+	return nil
+}
+
+func (x *AddRequest) GetCassandra() *DbConfig {
+	if x, ok := x.GetConfig().(*AddRequest_Cassandra); ok {
+		return x.Cassandra
+	}
+	return nil
+}
+
+func (x *AddRequest) GetMysql() *DbConfig {
+	if x, ok := x.GetConfig().(*AddRequest_Mysql); ok {
+		return x.Mysql
+	}
+	return nil
+}
+
+func (x *AddRequest) GetDns() *DnsConfig {
+	if x, ok := x.GetConfig().(*AddRequest_Dns); ok {
+		return x.Dns
+	}
+	return nil
+}
+
+func (x *AddRequest) GetUdp() *TcpConfig {
+	if x, ok := x.GetConfig().(*AddRequest_Udp); ok {
+		return x.Udp
+	}
+	return nil
+}
+
+func (x *AddRequest) GetRedis() *RedisConfig {
+	if x, ok := x.GetConfig().(*AddRequest_Redis); ok {
+		return x.Redis
+	}
+	return nil
+}
+
+func (x *AddRequest) GetHttpScenario() *HttpScenarioConfig {
+	if x, ok := x.GetConfig().(*AddRequest_HttpScenario); ok {
+		return x.HttpScenario
+	}
+	return nil
+}
+
+func (x *AddRequest) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *AddRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *AddRequest) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *AddRequest) GetParentIds() []string {
+	if x != nil {
+		return x.ParentIds
+	}
+	return nil
+}
+
+func (x *AddRequest) GetLocations() []string {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+func (x *AddRequest) GetMinFailedLocations() int32 {
+	if x != nil {
+		return x.MinFailedLocations
+	}
+	return 0
+}
+
+func (x *AddRequest) GetFailureInterval() int32 {
+	if x != nil {
+		return x.FailureInterval
+	}
+	return 0
+}
+
+func (x *AddRequest) GetRecoverAfter() int32 {
+	if x != nil {
+		return x.RecoverAfter
+	}
+	return 0
+}
+
+func (x *AddRequest) GetFlapDetection() *FlapDetection {
+	if x != nil {
+		return x.FlapDetection
+	}
+	return nil
+}
+
+type isAddRequest_Config interface {
+	isAddRequest_Config()
+}
+
+type AddRequest_Tcp struct {
+	Tcp *TcpConfig `protobuf:"bytes,4,opt,name=tcp,proto3,oneof"`
+}
+
+type AddRequest_Sitemap struct {
+	Sitemap *SiteMapConfig `protobuf:"bytes,5,opt,name=sitemap,proto3,oneof"`
+}
+
+type AddRequest_Grpc struct {
+	Grpc *GrpcConfig `protobuf:"bytes,6,opt,name=grpc,proto3,oneof"`
+}
+
+type AddRequest_Http struct {
+	Http *HttpConfig `protobuf:"bytes,7,opt,name=http,proto3,oneof"`
+}
+
+type AddRequest_HttpValue struct {
+	HttpValue *HttpJsonValueConfig `protobuf:"bytes,8,opt,name=http_value,json=httpValue,proto3,oneof"`
+}
+
+type AddRequest_SslExpiration struct {
+	SslExpiration *SslExpirationConfig `protobuf:"bytes,9,opt,name=ssl_expiration,json=sslExpiration,proto3,oneof"`
+}
+
+type AddRequest_Mongo struct {
+	Mongo *DbConfig `protobuf:"bytes,10,opt,name=mongo,proto3,oneof"`
+}
+
+type AddRequest_Postgres struct {
+	Postgres *DbConfig `protobuf:"bytes,11,opt,name=postgres,proto3,oneof"`
+}
+
+type AddRequest_Cassandra struct {
+	Cassandra *DbConfig `protobuf:"bytes,12,opt,name=cassandra,proto3,oneof"`
+}
+
+type AddRequest_Mysql struct {
+	Mysql *DbConfig `protobuf:"bytes,13,opt,name=mysql,proto3,oneof"`
+}
+
+type AddRequest_Dns struct {
+	Dns *DnsConfig `protobuf:"bytes,23,opt,name=dns,proto3,oneof"`
+}
+
+type AddRequest_Udp struct {
+	Udp *TcpConfig `protobuf:"bytes,24,opt,name=udp,proto3,oneof"`
+}
+
+type AddRequest_Redis struct {
+	Redis *RedisConfig `protobuf:"bytes,25,opt,name=redis,proto3,oneof"`
+}
+
+type AddRequest_HttpScenario struct {
+	HttpScenario *HttpScenarioConfig `protobuf:"bytes,26,opt,name=http_scenario,json=httpScenario,proto3,oneof"`
+}
+
+func (*AddRequest_Tcp) isAddRequest_Config() {}
+
+func (*AddRequest_Sitemap) isAddRequest_Config() {}
+
+func (*AddRequest_Grpc) isAddRequest_Config() {}
+
+func (*AddRequest_Http) isAddRequest_Config() {}
+
+func (*AddRequest_HttpValue) isAddRequest_Config() {}
+
+func (*AddRequest_SslExpiration) isAddRequest_Config() {}
+
+func (*AddRequest_Mongo) isAddRequest_Config() {}
+
+func (*AddRequest_Postgres) isAddRequest_Config() {}
+
+func (*AddRequest_Cassandra) isAddRequest_Config() {}
+
+func (*AddRequest_Mysql) isAddRequest_Config() {}
+
+func (*AddRequest_Dns) isAddRequest_Config() {}
+
+func (*AddRequest_Udp) isAddRequest_Config() {}
+
+func (*AddRequest_Redis) isAddRequest_Config() {}
+
+func (*AddRequest_HttpScenario) isAddRequest_Config() {}
+
+// Check is flapping when percent of state changes between recent results is above threshold
+type FlapDetection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// How many recent results are compared, 0 means 21
+	Window int32 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// Percent of state changes, 0 means 50
+	Threshold float64 `protobuf:"fixed64,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (x *FlapDetection) Reset() {
+	*x = FlapDetection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlapDetection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlapDetection) ProtoMessage() {}
+
+func (x *FlapDetection) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlapDetection.ProtoReflect.Descriptor instead.
+func (*FlapDetection) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FlapDetection) GetWindow() int32 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *FlapDetection) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+// Failed check is executed again before snapshot is saved
+type RetryPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Total attempts including first one, 0 or 1 means no retries
+	Attempts int32 `protobuf:"varint,1,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Delay before second attempt in milliseconds
+	Backoff int32 `protobuf:"varint,2,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	// Next delay is multiplied by it, 0 means constant delay
+	BackoffMultiplier float64 `protobuf:"fixed64,3,opt,name=backoff_multiplier,json=backoffMultiplier,proto3" json:"backoff_multiplier,omitempty"`
+	// Only errors containing one of these strings are retried, empty means all errors
+	RetryableErrors []string `protobuf:"bytes,4,rep,name=retryable_errors,json=retryableErrors,proto3" json:"retryable_errors,omitempty"`
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RetryPolicy) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetBackoff() int32 {
+	if x != nil {
+		return x.Backoff
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetBackoffMultiplier() float64 {
+	if x != nil {
+		return x.BackoffMultiplier
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetRetryableErrors() []string {
+	if x != nil {
+		return x.RetryableErrors
+	}
+	return nil
+}
+
+type AddResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AddResponse) Reset() {
+	*x = AddResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddResponse) ProtoMessage() {}
+
+func (x *AddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddResponse.ProtoReflect.Descriptor instead.
+func (*AddResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AddResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RemoveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RemoveRequest) Reset() {
+	*x = RemoveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRequest) ProtoMessage() {}
+
+func (x *RemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RemoveRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RemoveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RemoveResponse) Reset() {
+	*x = RemoveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveResponse) ProtoMessage() {}
+
+func (x *RemoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveResponse.ProtoReflect.Descriptor instead.
+func (*RemoveResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RemoveResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RunRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RunRequest) Reset() {
+	*x = RunRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunRequest) ProtoMessage() {}
+
+func (x *RunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunRequest.ProtoReflect.Descriptor instead.
+func (*RunRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RunRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type StopRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *StopRequest) Reset() {
+	*x = StopRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopRequest) ProtoMessage() {}
+
+func (x *StopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopRequest.ProtoReflect.Descriptor instead.
+func (*StopRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *StopRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RunResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RunResponse) Reset() {
+	*x = RunResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunResponse) ProtoMessage() {}
+
+func (x *RunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunResponse.ProtoReflect.Descriptor instead.
+func (*RunResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RunResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type StopResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *StopResponse) Reset() {
+	*x = StopResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopResponse) ProtoMessage() {}
+
+func (x *StopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopResponse.ProtoReflect.Descriptor instead.
+func (*StopResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *StopResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// New config of the scheduler, replace the old one completely
+	Scheduler *AddRequest `protobuf:"bytes,2,opt,name=scheduler,proto3" json:"scheduler,omitempty"`
+}
+
+func (x *UpdateRequest) Reset() {
+	*x = UpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRequest) ProtoMessage() {}
+
+func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetScheduler() *AddRequest {
+	if x != nil {
+		return x.Scheduler
+	}
+	return nil
+}
+
+type UpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *UpdateResponse) Reset() {
+	*x = UpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateResponse) ProtoMessage() {}
+
+func (x *UpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ExportSchedulersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format DocumentFormat `protobuf:"varint,1,opt,name=format,proto3,enum=squzy.v1.monitoring.DocumentFormat" json:"format,omitempty"`
+}
+
+func (x *ExportSchedulersRequest) Reset() {
+	*x = ExportSchedulersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportSchedulersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSchedulersRequest) ProtoMessage() {}
+
+func (x *ExportSchedulersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSchedulersRequest.ProtoReflect.Descriptor instead.
+func (*ExportSchedulersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ExportSchedulersRequest) GetFormat() DocumentFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DocumentFormat_DOCUMENT_FORMAT_UNSPECIFIED
+}
+
+type ExportSchedulersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Document []byte `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+}
+
+func (x *ExportSchedulersResponse) Reset() {
+	*x = ExportSchedulersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportSchedulersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSchedulersResponse) ProtoMessage() {}
+
+func (x *ExportSchedulersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSchedulersResponse.ProtoReflect.Descriptor instead.
+func (*ExportSchedulersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ExportSchedulersResponse) GetDocument() []byte {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+type ApplySchedulersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format   DocumentFormat `protobuf:"varint,1,opt,name=format,proto3,enum=squzy.v1.monitoring.DocumentFormat" json:"format,omitempty"`
+	Document []byte         `protobuf:"bytes,2,opt,name=document,proto3" json:"document,omitempty"`
+	// Only return plan without any changes
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *ApplySchedulersRequest) Reset() {
+	*x = ApplySchedulersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplySchedulersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplySchedulersRequest) ProtoMessage() {}
+
+func (x *ApplySchedulersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplySchedulersRequest.ProtoReflect.Descriptor instead.
+func (*ApplySchedulersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ApplySchedulersRequest) GetFormat() DocumentFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DocumentFormat_DOCUMENT_FORMAT_UNSPECIFIED
+}
+
+func (x *ApplySchedulersRequest) GetDocument() []byte {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *ApplySchedulersRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type SchedulerChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action SchedulerChange_Action `protobuf:"varint,1,opt,name=action,proto3,enum=squzy.v1.monitoring.SchedulerChange_Action" json:"action,omitempty"`
+	// Empty for planned create
+	Id   string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Changed fields like "interval: 10 -> 20", only for update
+	Diff []string `protobuf:"bytes,4,rep,name=diff,proto3" json:"diff,omitempty"`
+}
+
+func (x *SchedulerChange) Reset() {
+	*x = SchedulerChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchedulerChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulerChange) ProtoMessage() {}
+
+func (x *SchedulerChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulerChange.ProtoReflect.Descriptor instead.
+func (*SchedulerChange) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SchedulerChange) GetAction() SchedulerChange_Action {
+	if x != nil {
+		return x.Action
+	}
+	return SchedulerChange_ACTION_UNSPECIFIED
+}
+
+func (x *SchedulerChange) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SchedulerChange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SchedulerChange) GetDiff() []string {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+type ApplySchedulersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*SchedulerChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *ApplySchedulersResponse) Reset() {
+	*x = ApplySchedulersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplySchedulersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplySchedulersResponse) ProtoMessage() {}
+
+func (x *ApplySchedulersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplySchedulersResponse.ProtoReflect.Descriptor instead.
+func (*ApplySchedulersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ApplySchedulersResponse) GetChanges() []*SchedulerChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type BulkActionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required, empty selector is not allowed
+	Selector string                   `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	Action   BulkActionRequest_Action `protobuf:"varint,2,opt,name=action,proto3,enum=squzy.v1.monitoring.BulkActionRequest_Action" json:"action,omitempty"`
+}
+
+func (x *BulkActionRequest) Reset() {
+	*x = BulkActionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkActionRequest) ProtoMessage() {}
+
+func (x *BulkActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkActionRequest.ProtoReflect.Descriptor instead.
+func (*BulkActionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BulkActionRequest) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *BulkActionRequest) GetAction() BulkActionRequest_Action {
+	if x != nil {
+		return x.Action
+	}
+	return BulkActionRequest_ACTION_UNSPECIFIED
+}
+
+type BulkActionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ids of schedulers with applied action
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// Scheduler id to error message for failed ones
+	Errors map[string]string `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *BulkActionResponse) Reset() {
+	*x = BulkActionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkActionResponse) ProtoMessage() {}
+
+func (x *BulkActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkActionResponse.ProtoReflect.Descriptor instead.
+func (*BulkActionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BulkActionResponse) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BulkActionResponse) GetErrors() map[string]string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type MaintenanceWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Window is applied to schedulers from list and to schedulers matched by selector
+	SchedulerIds []string `protobuf:"bytes,3,rep,name=scheduler_ids,json=schedulerIds,proto3" json:"scheduler_ids,omitempty"`
+	Selector     string   `protobuf:"bytes,4,opt,name=selector,proto3" json:"selector,omitempty"`
+	// One-off window
+	Start *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`
+	End   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end,proto3" json:"end,omitempty"`
+	// Recurring window, starts by cron expression and lasts duration seconds
+	Cron     string          `protobuf:"bytes,7,opt,name=cron,proto3" json:"cron,omitempty"`
+	Duration int32           `protobuf:"varint,8,opt,name=duration,proto3" json:"duration,omitempty"`
+	Mode     MaintenanceMode `protobuf:"varint,9,opt,name=mode,proto3,enum=squzy.v1.monitoring.MaintenanceMode" json:"mode,omitempty"`
+}
+
+func (x *MaintenanceWindow) Reset() {
+	*x = MaintenanceWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintenanceWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindow) ProtoMessage() {}
+
+func (x *MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *MaintenanceWindow) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetSchedulerIds() []string {
+	if x != nil {
+		return x.SchedulerIds
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *MaintenanceWindow) GetMode() MaintenanceMode {
+	if x != nil {
+		return x.Mode
+	}
+	return MaintenanceMode_MAINTENANCE_MODE_UNSPECIFIED
+}
+
+type AddMaintenanceWindowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Window *MaintenanceWindow `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (x *AddMaintenanceWindowRequest) Reset() {
+	*x = AddMaintenanceWindowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *AddMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*AddMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AddMaintenanceWindowRequest) GetWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type AddMaintenanceWindowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AddMaintenanceWindowResponse) Reset() {
+	*x = AddMaintenanceWindowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddMaintenanceWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMaintenanceWindowResponse) ProtoMessage() {}
+
+func (x *AddMaintenanceWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMaintenanceWindowResponse.ProtoReflect.Descriptor instead.
+func (*AddMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AddMaintenanceWindowResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RemoveMaintenanceWindowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RemoveMaintenanceWindowRequest) Reset() {
+	*x = RemoveMaintenanceWindowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *RemoveMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RemoveMaintenanceWindowRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RemoveMaintenanceWindowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RemoveMaintenanceWindowResponse) Reset() {
+	*x = RemoveMaintenanceWindowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveMaintenanceWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMaintenanceWindowResponse) ProtoMessage() {}
+
+func (x *RemoveMaintenanceWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMaintenanceWindowResponse.ProtoReflect.Descriptor instead.
+func (*RemoveMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RemoveMaintenanceWindowResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetMaintenanceWindowListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetMaintenanceWindowListRequest) Reset() {
+	*x = GetMaintenanceWindowListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMaintenanceWindowListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceWindowListRequest) ProtoMessage() {}
+
+func (x *GetMaintenanceWindowListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceWindowListRequest.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceWindowListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{45}
+}
+
+type GetMaintenanceWindowListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Windows []*MaintenanceWindow `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (x *GetMaintenanceWindowListResponse) Reset() {
+	*x = GetMaintenanceWindowListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMaintenanceWindowListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceWindowListResponse) ProtoMessage() {}
+
+func (x *GetMaintenanceWindowListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceWindowListResponse.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceWindowListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetMaintenanceWindowListResponse) GetWindows() []*MaintenanceWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+// Same shape as storage SchedulerResponse
+type TestSchedulerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SchedulerId string             `protobuf:"bytes,1,opt,name=scheduler_id,json=schedulerId,proto3" json:"scheduler_id,omitempty"`
+	Snapshot    *SchedulerSnapshot `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (x *TestSchedulerResponse) Reset() {
+	*x = TestSchedulerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestSchedulerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestSchedulerResponse) ProtoMessage() {}
+
+func (x *TestSchedulerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestSchedulerResponse.ProtoReflect.Descriptor instead.
+func (*TestSchedulerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *TestSchedulerResponse) GetSchedulerId() string {
+	if x != nil {
+		return x.SchedulerId
+	}
+	return ""
+}
+
+func (x *TestSchedulerResponse) GetSnapshot() *SchedulerSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type LocationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location string        `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Code     SchedulerCode `protobuf:"varint,2,opt,name=code,proto3,enum=squzy.v1.monitoring.SchedulerCode" json:"code,omitempty"`
+	Error    string        `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *LocationResult) Reset() {
+	*x = LocationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationResult) ProtoMessage() {}
+
+func (x *LocationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationResult.ProtoReflect.Descriptor instead.
+func (*LocationResult) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *LocationResult) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *LocationResult) GetCode() SchedulerCode {
+	if x != nil {
+		return x.Code
+	}
+	return SchedulerCode_SCHEDULER_CODE_UNSPECIFIED
+}
+
+func (x *LocationResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ProbeTasksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location string `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *ProbeTasksRequest) Reset() {
+	*x = ProbeTasksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProbeTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeTasksRequest) ProtoMessage() {}
+
+func (x *ProbeTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeTasksRequest.ProtoReflect.Descriptor instead.
+func (*ProbeTasksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ProbeTasksRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+type ProbeTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Should be sent back with result
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SchedulerId string `protobuf:"bytes,2,opt,name=scheduler_id,json=schedulerId,proto3" json:"scheduler_id,omitempty"`
+	// BSON encoded scheduler config, probe should be same version as squzy monitoring
+	Config []byte `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *ProbeTask) Reset() {
+	*x = ProbeTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProbeTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeTask) ProtoMessage() {}
+
+func (x *ProbeTask) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeTask.ProtoReflect.Descriptor instead.
+func (*ProbeTask) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ProbeTask) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProbeTask) GetSchedulerId() string {
+	if x != nil {
+		return x.SchedulerId
+	}
+	return ""
+}
+
+func (x *ProbeTask) GetConfig() []byte {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ProbeResultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskId   string             `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Location string             `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	Snapshot *SchedulerSnapshot `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (x *ProbeResultRequest) Reset() {
+	*x = ProbeResultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProbeResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeResultRequest) ProtoMessage() {}
+
+func (x *ProbeResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeResultRequest.ProtoReflect.Descriptor instead.
+func (*ProbeResultRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ProbeResultRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ProbeResultRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ProbeResultRequest) GetSnapshot() *SchedulerSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type ProbeResultResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ProbeResultResponse) Reset() {
+	*x = ProbeResultResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProbeResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeResultResponse) ProtoMessage() {}
+
+func (x *ProbeResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeResultResponse.ProtoReflect.Descriptor instead.
+func (*ProbeResultResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{52}
+}
+
+type SchedulerSnapshot_Error struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SchedulerSnapshot_Error) Reset() {
+	*x = SchedulerSnapshot_Error{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchedulerSnapshot_Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulerSnapshot_Error) ProtoMessage() {}
+
+func (x *SchedulerSnapshot_Error) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulerSnapshot_Error.ProtoReflect.Descriptor instead.
+func (*SchedulerSnapshot_Error) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *SchedulerSnapshot_Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SchedulerSnapshot_MetaData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Value     *structpb.Value        `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// How many times check was executed, 0 means retry policy is not set
+	Attempts int32 `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Errors of failed attempts in order
+	AttemptErrors []string `protobuf:"bytes,5,rep,name=attempt_errors,json=attemptErrors,proto3" json:"attempt_errors,omitempty"`
+	// Failing parent scheduler for SKIPPED snapshot
+	SkippedBy string `protobuf:"bytes,6,opt,name=skipped_by,json=skippedBy,proto3" json:"skipped_by,omitempty"`
+	// Result of every location when check is executed by probes
+	Locations []*LocationResult `protobuf:"bytes,7,rep,name=locations,proto3" json:"locations,omitempty"`
+}
+
+func (x *SchedulerSnapshot_MetaData) Reset() {
+	*x = SchedulerSnapshot_MetaData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchedulerSnapshot_MetaData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulerSnapshot_MetaData) ProtoMessage() {}
+
+func (x *SchedulerSnapshot_MetaData) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulerSnapshot_MetaData.ProtoReflect.Descriptor instead.
+func (*SchedulerSnapshot_MetaData) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *SchedulerSnapshot_MetaData) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *SchedulerSnapshot_MetaData) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *SchedulerSnapshot_MetaData) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *SchedulerSnapshot_MetaData) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *SchedulerSnapshot_MetaData) GetAttemptErrors() []string {
+	if x != nil {
+		return x.AttemptErrors
+	}
+	return nil
+}
+
+func (x *SchedulerSnapshot_MetaData) GetSkippedBy() string {
+	if x != nil {
+		return x.SkippedBy
+	}
+	return ""
+}
+
+func (x *SchedulerSnapshot_MetaData) GetLocations() []*LocationResult {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+// Client credentials grant, token is cached until it expires
+type HttpAuth_OAuth2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenUrl     string   `protobuf:"bytes,1,opt,name=token_url,json=tokenUrl,proto3" json:"token_url,omitempty"`
+	ClientId     string   `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret string   `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	Scopes       []string `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+}
+
+func (x *HttpAuth_OAuth2) Reset() {
+	*x = HttpAuth_OAuth2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpAuth_OAuth2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpAuth_OAuth2) ProtoMessage() {}
+
+func (x *HttpAuth_OAuth2) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpAuth_OAuth2.ProtoReflect.Descriptor instead.
+func (*HttpAuth_OAuth2) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *HttpAuth_OAuth2) GetTokenUrl() string {
+	if x != nil {
+		return x.TokenUrl
+	}
+	return ""
+}
+
+func (x *HttpAuth_OAuth2) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *HttpAuth_OAuth2) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *HttpAuth_OAuth2) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type HttpScenarioStep_Extract struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of variable, available in next steps
+	Name   string                          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Source HttpScenarioStep_Extract_Source `protobuf:"varint,2,opt,name=source,proto3,enum=squzy.v1.monitoring.HttpScenarioStep_Extract_Source" json:"source,omitempty"`
+	// Gjson path, header name or regex of body, first group of regex is used when present
+	Expression string `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
+}
+
+func (x *HttpScenarioStep_Extract) Reset() {
+	*x = HttpScenarioStep_Extract{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpScenarioStep_Extract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpScenarioStep_Extract) ProtoMessage() {}
+
+func (x *HttpScenarioStep_Extract) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpScenarioStep_Extract.ProtoReflect.Descriptor instead.
+func (*HttpScenarioStep_Extract) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{16, 1}
+}
+
+func (x *HttpScenarioStep_Extract) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HttpScenarioStep_Extract) GetSource() HttpScenarioStep_Extract_Source {
+	if x != nil {
+		return x.Source
+	}
+	return HttpScenarioStep_Extract_EXTRACT_SOURCE_UNSPECIFIED
+}
+
+func (x *HttpScenarioStep_Extract) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+type HttpAssertions_Header struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Only one of equals and regex could be set
+	Equals string `protobuf:"bytes,2,opt,name=equals,proto3" json:"equals,omitempty"`
+	Regex  string `protobuf:"bytes,3,opt,name=regex,proto3" json:"regex,omitempty"`
+}
+
+func (x *HttpAssertions_Header) Reset() {
+	*x = HttpAssertions_Header{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpAssertions_Header) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpAssertions_Header) ProtoMessage() {}
+
+func (x *HttpAssertions_Header) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpAssertions_Header.ProtoReflect.Descriptor instead.
+func (*HttpAssertions_Header) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *HttpAssertions_Header) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HttpAssertions_Header) GetEquals() string {
+	if x != nil {
+		return x.Equals
+	}
+	return ""
+}
+
+func (x *HttpAssertions_Header) GetRegex() string {
+	if x != nil {
+		return x.Regex
+	}
+	return ""
+}
+
+type HttpJsonValueConfig_Selectors struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type HttpJsonValueConfig_JsonValueParseType `protobuf:"varint,1,opt,name=type,proto3,enum=squzy.v1.monitoring.HttpJsonValueConfig_JsonValueParseType" json:"type,omitempty"`
+	Path string                                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Check is WARNING when NUMBER value is out of range, empty bound is not checked
+	WarningMin *wrapperspb.DoubleValue `protobuf:"bytes,3,opt,name=warning_min,json=warningMin,proto3" json:"warning_min,omitempty"`
+	WarningMax *wrapperspb.DoubleValue `protobuf:"bytes,4,opt,name=warning_max,json=warningMax,proto3" json:"warning_max,omitempty"`
+	// Check is ERROR when value does not pass assertion
+	Assertion *HttpJsonValueConfig_Assertion `protobuf:"bytes,5,opt,name=assertion,proto3" json:"assertion,omitempty"`
+}
+
+func (x *HttpJsonValueConfig_Selectors) Reset() {
+	*x = HttpJsonValueConfig_Selectors{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpJsonValueConfig_Selectors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpJsonValueConfig_Selectors) ProtoMessage() {}
+
+func (x *HttpJsonValueConfig_Selectors) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpJsonValueConfig_Selectors.ProtoReflect.Descriptor instead.
+func (*HttpJsonValueConfig_Selectors) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{20, 1}
+}
+
+func (x *HttpJsonValueConfig_Selectors) GetType() HttpJsonValueConfig_JsonValueParseType {
+	if x != nil {
+		return x.Type
+	}
+	return HttpJsonValueConfig_JSON_PARSE_VALUE_UNSPECIFIED
+}
+
+func (x *HttpJsonValueConfig_Selectors) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *HttpJsonValueConfig_Selectors) GetWarningMin() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.WarningMin
+	}
+	return nil
+}
+
+func (x *HttpJsonValueConfig_Selectors) GetWarningMax() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.WarningMax
+	}
+	return nil
+}
+
+func (x *HttpJsonValueConfig_Selectors) GetAssertion() *HttpJsonValueConfig_Assertion {
+	if x != nil {
+		return x.Assertion
+	}
+	return nil
+}
+
+// Every set field is checked, value is compared as string except numbers and bool
+type HttpJsonValueConfig_Assertion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Equals    *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=equals,proto3" json:"equals,omitempty"`
+	NotEquals *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=not_equals,json=notEquals,proto3" json:"not_equals,omitempty"`
+	// Both bounds make range, bounds are exclusive
+	GreaterThan *wrapperspb.DoubleValue `protobuf:"bytes,3,opt,name=greater_than,json=greaterThan,proto3" json:"greater_than,omitempty"`
+	LessThan    *wrapperspb.DoubleValue `protobuf:"bytes,4,opt,name=less_than,json=lessThan,proto3" json:"less_than,omitempty"`
+	Regex       string                  `protobuf:"bytes,5,opt,name=regex,proto3" json:"regex,omitempty"`
+	BoolEquals  *wrapperspb.BoolValue   `protobuf:"bytes,6,opt,name=bool_equals,json=boolEquals,proto3" json:"bool_equals,omitempty"`
+}
+
+func (x *HttpJsonValueConfig_Assertion) Reset() {
+	*x = HttpJsonValueConfig_Assertion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpJsonValueConfig_Assertion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpJsonValueConfig_Assertion) ProtoMessage() {}
+
+func (x *HttpJsonValueConfig_Assertion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpJsonValueConfig_Assertion.ProtoReflect.Descriptor instead.
+func (*HttpJsonValueConfig_Assertion) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{20, 2}
+}
+
+func (x *HttpJsonValueConfig_Assertion) GetEquals() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Equals
+	}
+	return nil
+}
+
+func (x *HttpJsonValueConfig_Assertion) GetNotEquals() *wrapperspb.StringValue {
+	if x != nil {
+		return x.NotEquals
+	}
+	return nil
+}
+
+func (x *HttpJsonValueConfig_Assertion) GetGreaterThan() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.GreaterThan
+	}
+	return nil
+}
+
+func (x *HttpJsonValueConfig_Assertion) GetLessThan() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.LessThan
+	}
+	return nil
+}
+
+func (x *HttpJsonValueConfig_Assertion) GetRegex() string {
+	if x != nil {
+		return x.Regex
+	}
+	return ""
+}
+
+func (x *HttpJsonValueConfig_Assertion) GetBoolEquals() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.BoolEquals
+	}
+	return nil
+}
+
+var File_proto_v1_squzy_monitoring_proto protoreflect.FileDescriptor
+
+var file_proto_v1_squzy_monitoring_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x13, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6d, 0x0a, 0x17, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x57, 0x69, 0x74, 0x68, 0x49, 0x64,
+	0x12, 0x42, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x81, 0x05, 0x0a, 0x11, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x36, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x22, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x42, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x71, 0x75, 0x7a,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x43,
+	0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73,
+	0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x6d,
+	0x65, 0x74, 0x61, 0x1a, 0x21, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0xcf, 0x02, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6b, 0x69, 0x70,
+	0x70, 0x65, 0x64, 0x42, 0x79, 0x12, 0x41, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x09, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x29, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0xe2, 0x0c, 0x0a, 0x09, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3c, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e,
+	0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x32, 0x0a, 0x03, 0x74, 0x63, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x63, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00,
+	0x52, 0x03, 0x74, 0x63, 0x70, 0x12, 0x3e, 0x0a, 0x07, 0x73, 0x69, 0x74, 0x65, 0x6d, 0x61, 0x70,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x69, 0x74,
+	0x65, 0x4d, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x07, 0x73, 0x69,
+	0x74, 0x65, 0x6d, 0x61, 0x70, 0x12, 0x35, 0x0a, 0x04, 0x67, 0x72, 0x70, 0x63, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x04, 0x67, 0x72, 0x70, 0x63, 0x12, 0x35, 0x0a, 0x04,
+	0x68, 0x74, 0x74, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x71, 0x75,
+	0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x48, 0x74, 0x74, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x04, 0x68,
+	0x74, 0x74, 0x70, 0x12, 0x49, 0x0a, 0x0a, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74,
+	0x74, 0x70, 0x4a, 0x73, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x48, 0x00, 0x52, 0x09, 0x68, 0x74, 0x74, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x51,
+	0x0a, 0x0e, 0x73, 0x73, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x73, 0x6c,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x48, 0x00, 0x52, 0x0d, 0x73, 0x73, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x35, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x67, 0x6f, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48,
+	0x00, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x67, 0x6f, 0x12, 0x3b, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x74,
+	0x67, 0x72, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x71, 0x75,
+	0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x44, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x08, 0x70, 0x6f, 0x73,
+	0x74, 0x67, 0x72, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x09, 0x63, 0x61, 0x73, 0x73, 0x61, 0x6e, 0x64,
+	0x72, 0x61, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x44,
+	0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x09, 0x63, 0x61, 0x73, 0x73, 0x61,
+	0x6e, 0x64, 0x72, 0x61, 0x12, 0x35, 0x0a, 0x05, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x62, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x12, 0x32, 0x0a, 0x03, 0x64,
+	0x6e, 0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x44,
+	0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x03, 0x64, 0x6e, 0x73, 0x12,
+	0x32, 0x0a, 0x03, 0x75, 0x64, 0x70, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73,
+	0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x2e, 0x54, 0x63, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x03,
+	0x75, 0x64, 0x70, 0x12, 0x38, 0x0a, 0x05, 0x72, 0x65, 0x64, 0x69, 0x73, 0x18, 0x1e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x73, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x05, 0x72, 0x65, 0x64, 0x69, 0x73, 0x12, 0x4e, 0x0a,
+	0x0d, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x18, 0x1f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x53,
+	0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52,
+	0x0c, 0x68, 0x74, 0x74, 0x70, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x72, 0x6f,
+	0x6e, 0x12, 0x42, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x43, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x71,
+	0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b, 0x72,
+	0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6d, 0x69, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x17, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x18, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x0e, 0x66, 0x6c, 0x61,
+	0x70, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x6c, 0x61, 0x70, 0x44, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x66, 0x6c, 0x61, 0x70, 0x44, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x6c, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x6c, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x08,
+	0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x35, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22,
+	0x50, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x6c,
+	0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x71, 0x75,
+	0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x52, 0x05, 0x6c, 0x69, 0x73, 0x74,
+	0x73, 0x22, 0x43, 0x0a, 0x0d, 0x53, 0x69, 0x74, 0x65, 0x4d, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xc8, 0x01, 0x0a, 0x09, 0x54, 0x63, 0x70, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73,
+	0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x67, 0x65, 0x78, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x22, 0xa5, 0x02, 0x0a, 0x09, 0x44, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x4a, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x6e, 0x73, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x74,
+	0x74, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x54, 0x74, 0x6c,
+	0x22, 0x67, 0x0a, 0x0a, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b,
+	0x0a, 0x17, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x05, 0x0a, 0x01, 0x41,
+	0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x41, 0x41, 0x41, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05,
+	0x43, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x03, 0x12, 0x06, 0x0a, 0x02, 0x4d, 0x58, 0x10, 0x04, 0x12,
+	0x07, 0x0a, 0x03, 0x54, 0x58, 0x54, 0x10, 0x05, 0x12, 0x06, 0x0a, 0x02, 0x4e, 0x53, 0x10, 0x06,
+	0x12, 0x07, 0x0a, 0x03, 0x53, 0x52, 0x56, 0x10, 0x07, 0x22, 0x60, 0x0a, 0x13, 0x53, 0x73, 0x6c,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x79, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x08,
+	0x44, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x22, 0xd6, 0x02, 0x0a, 0x0b, 0x52, 0x65, 0x64, 0x69, 0x73, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x64,
+	0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x64, 0x62, 0x12, 0x10, 0x0a, 0x03, 0x74,
+	0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x12, 0x30, 0x0a,
+	0x14, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12,
+	0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x65,
+	0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6d,
+	0x61, 0x78, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d,
+	0x61, 0x78, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x75, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x55, 0x70, 0x22, 0x71, 0x0a, 0x0a,
+	0x47, 0x72, 0x70, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0xd9, 0x03, 0x0a, 0x0a, 0x48, 0x74, 0x74, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x46, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x71, 0x75, 0x7a,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x48, 0x74, 0x74, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48,
+	0x74, 0x74, 0x70, 0x41, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x61,
+	0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74,
+	0x74, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x31, 0x0a, 0x04,
+	0x61, 0x75, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x71, 0x75,
+	0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x48, 0x74, 0x74, 0x70, 0x41, 0x75, 0x74, 0x68, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12,
+	0x2e, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73,
+	0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x54, 0x6c, 0x73, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x1a,
+	0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb2, 0x02, 0x0a, 0x08,
+	0x48, 0x74, 0x74, 0x70, 0x41, 0x75, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x69,
+	0x63, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61,
+	0x73, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x61, 0x73, 0x69, 0x63,
+	0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x62, 0x61, 0x73, 0x69, 0x63, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x3c, 0x0a, 0x06, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x41, 0x75, 0x74, 0x68,
+	0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x52, 0x06, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x32, 0x1a,
+	0x7f, 0x0a, 0x06, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73,
+	0x22, 0xd7, 0x01, 0x0a, 0x12, 0x48, 0x74, 0x74, 0x70, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
+	0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3b, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74, 0x74,
+	0x70, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73,
+	0x74, 0x65, 0x70, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x77, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74, 0x74, 0x70,
+	0x41, 0x75, 0x74, 0x68, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x03, 0x74, 0x6c,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74,
+	0x74, 0x70, 0x54, 0x6c, 0x73, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x22, 0x95, 0x05, 0x0a, 0x10, 0x48,
+	0x74, 0x74, 0x70, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x70, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x4c, 0x0a,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32,
+	0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
+	0x6f, 0x53, 0x74, 0x65, 0x70, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x31, 0x0a, 0x04, 0x62,
+	0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x71, 0x75, 0x7a,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x48, 0x74, 0x74, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x43, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x41, 0x73,
+	0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x47, 0x0a, 0x07, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74, 0x74, 0x70,
+	0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x70, 0x2e, 0x45, 0x78, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x52, 0x07, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0x3a, 0x0a,
+	0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xd6, 0x01, 0x0a, 0x07, 0x45, 0x78,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4c, 0x0a, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x73, 0x71, 0x75, 0x7a,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x48, 0x74, 0x74, 0x70, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x70,
+	0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x49, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x58, 0x54, 0x52, 0x41, 0x43, 0x54, 0x5f, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x48,
+	0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x45, 0x47, 0x45, 0x58,
+	0x10, 0x03, 0x22, 0x98, 0x01, 0x0a, 0x07, 0x48, 0x74, 0x74, 0x70, 0x54, 0x6c, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x61, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x61, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x69,
+	0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x22, 0x47, 0x0a,
+	0x08, 0x48, 0x74, 0x74, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0xbe, 0x02, 0x0a, 0x0e, 0x48, 0x74, 0x74, 0x70, 0x41,
+	0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6f, 0x64,
+	0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0c, 0x62, 0x6f, 0x64, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x2a,
+	0x0a, 0x11, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x6f, 0x64, 0x79, 0x4e,
+	0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f,
+	0x64, 0x79, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x62, 0x6f, 0x64, 0x79, 0x52, 0x65, 0x67, 0x65, 0x78, 0x12, 0x44, 0x0a, 0x07, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x71, 0x75,
+	0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x48, 0x74, 0x74, 0x70, 0x41, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0x4a, 0x0a, 0x06, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x71, 0x75,
+	0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x71, 0x75, 0x61, 0x6c,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x22, 0xe0, 0x09, 0x0a, 0x13, 0x48, 0x74, 0x74, 0x70,
+	0x4a, 0x73, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x4f, 0x0a, 0x07, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x73, 0x71, 0x75,
+	0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x48, 0x74, 0x74, 0x70, 0x4a, 0x73, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x50, 0x0a, 0x09, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e,
+	0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x4a, 0x73, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x73, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x31, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f,
+	0x64, 0x79, 0x12, 0x31, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x41, 0x75, 0x74, 0x68, 0x52,
+	0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x54, 0x6c, 0x73,
+	0x52, 0x03, 0x74, 0x6c, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0xc0, 0x02, 0x0a, 0x09, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x4f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e,
+	0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x4a, 0x73, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x50, 0x61, 0x72, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x3d, 0x0a, 0x0b, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x6d, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x4d, 0x69, 0x6e, 0x12, 0x3d, 0x0a, 0x0b, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6d,
+	0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x4d,
+	0x61, 0x78, 0x12, 0x50, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74, 0x74, 0x70,
+	0x4a, 0x73, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x41, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x72,
+	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xcd, 0x02, 0x0a, 0x09, 0x41, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x06, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x06, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f,
+	0x65, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x45,
+	0x71, 0x75, 0x61, 0x6c, 0x73, 0x12, 0x3f, 0x0a, 0x0c, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72,
+	0x5f, 0x74, 0x68, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x67, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x72, 0x54, 0x68, 0x61, 0x6e, 0x12, 0x39, 0x0a, 0x09, 0x6c, 0x65, 0x73, 0x73, 0x5f, 0x74,
+	0x68, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6c, 0x65, 0x73, 0x73, 0x54, 0x68, 0x61,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x12, 0x3b, 0x0a, 0x0b, 0x62, 0x6f, 0x6f, 0x6c, 0x5f,
+	0x65, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x45, 0x71,
+	0x75, 0x61, 0x6c, 0x73, 0x22, 0x74, 0x0a, 0x12, 0x4a, 0x73, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x50, 0x61, 0x72, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x4a, 0x53,
+	0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x52, 0x53, 0x45, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x4f, 0x4c,
+	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x03, 0x12, 0x08,
+	0x0a, 0x04, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10,
+	0x05, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x41, 0x57, 0x10, 0x06, 0x22, 0x9f, 0x0b, 0x0a, 0x0a, 0x41,
+	0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x03, 0x74, 0x63, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x63, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x48, 0x00, 0x52, 0x03, 0x74, 0x63, 0x70, 0x12, 0x3e, 0x0a, 0x07, 0x73, 0x69, 0x74, 0x65, 0x6d,
+	0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53,
+	0x69, 0x74, 0x65, 0x4d, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x07,
+	0x73, 0x69, 0x74, 0x65, 0x6d, 0x61, 0x70, 0x12, 0x35, 0x0a, 0x04, 0x67, 0x72, 0x70, 0x63, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x72, 0x70, 0x63,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x04, 0x67, 0x72, 0x70, 0x63, 0x12, 0x35,
+	0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73,
+	0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52,
+	0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x49, 0x0a, 0x0a, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x71, 0x75, 0x7a,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x48, 0x74, 0x74, 0x70, 0x4a, 0x73, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x09, 0x68, 0x74, 0x74, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x51, 0x0a, 0x0e, 0x73, 0x73, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53,
+	0x73, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x73, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x67, 0x6f, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x67, 0x6f, 0x12, 0x3b, 0x0a, 0x08, 0x70, 0x6f,
+	0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73,
+	0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x2e, 0x44, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x08, 0x70,
+	0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x09, 0x63, 0x61, 0x73, 0x73, 0x61,
+	0x6e, 0x64, 0x72, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x71, 0x75,
+	0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x44, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x09, 0x63, 0x61, 0x73,
+	0x73, 0x61, 0x6e, 0x64, 0x72, 0x61, 0x12, 0x35, 0x0a, 0x05, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x62, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x12, 0x32, 0x0a,
+	0x03, 0x64, 0x6e, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x71, 0x75,
+	0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x44, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x03, 0x64, 0x6e,
+	0x73, 0x12, 0x32, 0x0a, 0x03, 0x75, 0x64, 0x70, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x63, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00,
+	0x52, 0x03, 0x75, 0x64, 0x70, 0x12, 0x38, 0x0a, 0x05, 0x72, 0x65, 0x64, 0x69, 0x73, 0x18, 0x19,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x73,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x05, 0x72, 0x65, 0x64, 0x69, 0x73, 0x12,
+	0x4e, 0x0a, 0x0d, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f,
+	0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74, 0x74,
+	0x70, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48,
+	0x00, 0x52, 0x0c, 0x68, 0x74, 0x74, 0x70, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x72, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0f, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x43, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x69,
+	0x6e, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6d, 0x69, 0x6e, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x15, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x0e,
+	0x66, 0x6c, 0x61, 0x70, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x6c, 0x61, 0x70, 0x44,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x66, 0x6c, 0x61, 0x70, 0x44, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x45, 0x0a, 0x0d,
+	0x46, 0x6c, 0x61, 0x70, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x22, 0x9d, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12, 0x2d, 0x0a, 0x12, 0x62, 0x61, 0x63,
+	0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x22, 0x1d, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x1f, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x20, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1c, 0x0a, 0x0a, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x1d, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x1d, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x1e, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x5e, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x3d, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x64, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x22, 0x20, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x56, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23,
+	0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x36, 0x0a, 0x18, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23,
+	0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x22, 0xd4, 0x01, 0x0a, 0x0f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x22, 0x44, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12,
+	0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x52,
+	0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x03, 0x22, 0x59, 0x0a, 0x17, 0x41, 0x70, 0x70, 0x6c, 0x79,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x11, 0x42, 0x75, 0x6c, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x45, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3f, 0x0a, 0x06, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a,
+	0x03, 0x52, 0x55, 0x4e, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x54, 0x4f, 0x50, 0x10, 0x02,
+	0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x03, 0x22, 0xae, 0x01, 0x0a,
+	0x12, 0x42, 0x75, 0x6c, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x4b, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x75, 0x6c, 0x6b,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc2, 0x02,
+	0x0a, 0x11, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x22, 0x5d, 0x0a, 0x1b, 0x41, 0x64, 0x64, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3e, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x22, 0x2e, 0x0a, 0x1c, 0x41, 0x64, 0x64, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x30, 0x0a, 0x1e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x31, 0x0a, 0x1f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x21, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x64, 0x0a, 0x20, 0x47, 0x65, 0x74,
+	0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a,
+	0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x22,
+	0x7e, 0x0a, 0x15, 0x54, 0x65, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x08, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22,
+	0x7a, 0x0a, 0x0e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x73, 0x71,
+	0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x2f, 0x0a, 0x11, 0x50,
+	0x72, 0x6f, 0x62, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x56, 0x0a, 0x09,
+	0x50, 0x72, 0x6f, 0x62, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x22, 0x8d, 0x01, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74,
+	0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61,
+	0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x42, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x6d, 0x0a, 0x0d, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x1a,
+	0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02,
+	0x4f, 0x4b, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x12,
+	0x0f, 0x0a, 0x0b, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x03,
+	0x12, 0x0b, 0x0a, 0x07, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0b, 0x0a,
+	0x07, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x2a, 0x59, 0x0a, 0x0f, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a,
+	0x1c, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x52, 0x55, 0x4e, 0x4e, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53,
+	0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4d, 0x4f,
+	0x56, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x65, 0x0a, 0x0e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x4f, 0x43, 0x55, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x4f, 0x43, 0x55,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x59, 0x41, 0x4d, 0x4c,
+	0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x4f, 0x43, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x2a, 0x69, 0x0a, 0x0f,
+	0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x20, 0x0a, 0x1c, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15,
+	0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x02, 0x2a, 0xe6, 0x01, 0x0a, 0x0d, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x43, 0x48,
+	0x45, 0x44, 0x55, 0x4c, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x43, 0x50,
+	0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x47, 0x52, 0x50, 0x43, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04,
+	0x48, 0x54, 0x54, 0x50, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x49, 0x54, 0x45, 0x5f, 0x4d,
+	0x41, 0x50, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x4a, 0x53, 0x4f,
+	0x4e, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x53, 0x4c,
+	0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x06, 0x12, 0x09, 0x0a,
+	0x05, 0x4d, 0x4f, 0x4e, 0x47, 0x4f, 0x10, 0x07, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x4f, 0x53, 0x54,
+	0x47, 0x52, 0x45, 0x53, 0x10, 0x08, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x53, 0x53, 0x41, 0x4e,
+	0x44, 0x52, 0x41, 0x10, 0x09, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x59, 0x53, 0x51, 0x4c, 0x10, 0x0a,
+	0x12, 0x07, 0x0a, 0x03, 0x44, 0x4e, 0x53, 0x10, 0x0b, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x44, 0x50,
+	0x10, 0x0c, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x45, 0x44, 0x49, 0x53, 0x10, 0x0d, 0x12, 0x11, 0x0a,
+	0x0d, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x53, 0x43, 0x45, 0x4e, 0x41, 0x52, 0x49, 0x4f, 0x10, 0x0e,
+	0x32, 0xd2, 0x0c, 0x0a, 0x12, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x73, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x12, 0x6f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x2e, 0x73, 0x71,
+	0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x73, 0x71, 0x75, 0x7a,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x12, 0x2c, 0x2e, 0x73,
+	0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x42,
+	0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x71, 0x75,
+	0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x03, 0x41, 0x64,
+	0x64, 0x12, 0x1f, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x22,
+	0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x03, 0x52, 0x75, 0x6e, 0x12, 0x1f,
+	0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4b, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x20, 0x2e, 0x73, 0x71, 0x75, 0x7a,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x71,
+	0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51,
+	0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73,
+	0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5d, 0x0a, 0x0a, 0x42, 0x75, 0x6c, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x26, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x75,
+	0x6c, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x6f, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x6c, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x7b, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x30, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x64,
+	0x64, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73, 0x71, 0x75, 0x7a,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x41, 0x64, 0x64, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x84, 0x01, 0x0a,
+	0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x33, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e,
+	0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x87, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x34, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a,
+	0x0d, 0x54, 0x65, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x1f,
+	0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0a, 0x50,
+	0x72, 0x6f, 0x62, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x26, 0x2e, 0x73, 0x71, 0x75, 0x7a,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x50, 0x72, 0x6f, 0x62, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x54, 0x61, 0x73,
+	0x6b, 0x30, 0x01, 0x12, 0x60, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x27, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x71,
+	0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x24, 0x5a, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2f, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_v1_squzy_monitoring_proto_rawDescOnce sync.Once
+	file_proto_v1_squzy_monitoring_proto_rawDescData = file_proto_v1_squzy_monitoring_proto_rawDesc
+)
+
+func file_proto_v1_squzy_monitoring_proto_rawDescGZIP() []byte {
+	file_proto_v1_squzy_monitoring_proto_rawDescOnce.Do(func() {
+		file_proto_v1_squzy_monitoring_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_v1_squzy_monitoring_proto_rawDescData)
+	})
+	return file_proto_v1_squzy_monitoring_proto_rawDescData
+}
+
+var file_proto_v1_squzy_monitoring_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_proto_v1_squzy_monitoring_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
+var file_proto_v1_squzy_monitoring_proto_goTypes = []interface{}{
+	(SchedulerCode)(0),                          // 0: squzy.v1.monitoring.SchedulerCode
+	(SchedulerStatus)(0),                        // 1: squzy.v1.monitoring.SchedulerStatus
+	(DocumentFormat)(0),                         // 2: squzy.v1.monitoring.DocumentFormat
+	(MaintenanceMode)(0),                        // 3: squzy.v1.monitoring.MaintenanceMode
+	(SchedulerType)(0),                          // 4: squzy.v1.monitoring.SchedulerType
+	(DnsConfig_RecordType)(0),                   // 5: squzy.v1.monitoring.DnsConfig.RecordType
+	(HttpScenarioStep_Extract_Source)(0),        // 6: squzy.v1.monitoring.HttpScenarioStep.Extract.Source
+	(HttpJsonValueConfig_JsonValueParseType)(0), // 7: squzy.v1.monitoring.HttpJsonValueConfig.JsonValueParseType
+	(SchedulerChange_Action)(0),                 // 8: squzy.v1.monitoring.SchedulerChange.Action
+	(BulkActionRequest_Action)(0),               // 9: squzy.v1.monitoring.BulkActionRequest.Action
+	(*SchedulerSnapshotWithId)(nil),             // 10: squzy.v1.monitoring.SchedulerSnapshotWithId
+	(*SchedulerSnapshot)(nil),                   // 11: squzy.v1.monitoring.SchedulerSnapshot
+	(*GetSchedulerByIdRequest)(nil),             // 12: squzy.v1.monitoring.GetSchedulerByIdRequest
+	(*Scheduler)(nil),                           // 13: squzy.v1.monitoring.Scheduler
+	(*GetSchedulerListRequest)(nil),             // 14: squzy.v1.monitoring.GetSchedulerListRequest
+	(*GetSchedulerListResponse)(nil),            // 15: squzy.v1.monitoring.GetSchedulerListResponse
+	(*SiteMapConfig)(nil),                       // 16: squzy.v1.monitoring.SiteMapConfig
+	(*TcpConfig)(nil),                           // 17: squzy.v1.monitoring.TcpConfig
+	(*DnsConfig)(nil),                           // 18: squzy.v1.monitoring.DnsConfig
+	(*SslExpirationConfig)(nil),                 // 19: squzy.v1.monitoring.SslExpirationConfig
+	(*DbConfig)(nil),                            // 20: squzy.v1.monitoring.DbConfig
+	(*RedisConfig)(nil),                         // 21: squzy.v1.monitoring.RedisConfig
+	(*GrpcConfig)(nil),                          // 22: squzy.v1.monitoring.GrpcConfig
+	(*HttpConfig)(nil),                          // 23: squzy.v1.monitoring.HttpConfig
+	(*HttpAuth)(nil),                            // 24: squzy.v1.monitoring.HttpAuth
+	(*HttpScenarioConfig)(nil),                  // 25: squzy.v1.monitoring.HttpScenarioConfig
+	(*HttpScenarioStep)(nil),                    // 26: squzy.v1.monitoring.HttpScenarioStep
+	(*HttpTls)(nil),                             // 27: squzy.v1.monitoring.HttpTls
+	(*HttpBody)(nil),                            // 28: squzy.v1.monitoring.HttpBody
+	(*HttpAssertions)(nil),                      // 29: squzy.v1.monitoring.HttpAssertions
+	(*HttpJsonValueConfig)(nil),                 // 30: squzy.v1.monitoring.HttpJsonValueConfig
+	(*AddRequest)(nil),                          // 31: squzy.v1.monitoring.AddRequest
+	(*FlapDetection)(nil),                       // 32: squzy.v1.monitoring.FlapDetection
+	(*RetryPolicy)(nil),                         // 33: squzy.v1.monitoring.RetryPolicy
+	(*AddResponse)(nil),                         // 34: squzy.v1.monitoring.AddResponse
+	(*RemoveRequest)(nil),                       // 35: squzy.v1.monitoring.RemoveRequest
+	(*RemoveResponse)(nil),                      // 36: squzy.v1.monitoring.RemoveResponse
+	(*RunRequest)(nil),                          // 37: squzy.v1.monitoring.RunRequest
+	(*StopRequest)(nil),                         // 38: squzy.v1.monitoring.StopRequest
+	(*RunResponse)(nil),                         // 39: squzy.v1.monitoring.RunResponse
+	(*StopResponse)(nil),                        // 40: squzy.v1.monitoring.StopResponse
+	(*UpdateRequest)(nil),                       // 41: squzy.v1.monitoring.UpdateRequest
+	(*UpdateResponse)(nil),                      // 42: squzy.v1.monitoring.UpdateResponse
+	(*ExportSchedulersRequest)(nil),             // 43: squzy.v1.monitoring.ExportSchedulersRequest
+	(*ExportSchedulersResponse)(nil),            // 44: squzy.v1.monitoring.ExportSchedulersResponse
+	(*ApplySchedulersRequest)(nil),              // 45: squzy.v1.monitoring.ApplySchedulersRequest
+	(*SchedulerChange)(nil),                     // 46: squzy.v1.monitoring.SchedulerChange
+	(*ApplySchedulersResponse)(nil),             // 47: squzy.v1.monitoring.ApplySchedulersResponse
+	(*BulkActionRequest)(nil),                   // 48: squzy.v1.monitoring.BulkActionRequest
+	(*BulkActionResponse)(nil),                  // 49: squzy.v1.monitoring.BulkActionResponse
+	(*MaintenanceWindow)(nil),                   // 50: squzy.v1.monitoring.MaintenanceWindow
+	(*AddMaintenanceWindowRequest)(nil),         // 51: squzy.v1.monitoring.AddMaintenanceWindowRequest
+	(*AddMaintenanceWindowResponse)(nil),        // 52: squzy.v1.monitoring.AddMaintenanceWindowResponse
+	(*RemoveMaintenanceWindowRequest)(nil),      // 53: squzy.v1.monitoring.RemoveMaintenanceWindowRequest
+	(*RemoveMaintenanceWindowResponse)(nil),     // 54: squzy.v1.monitoring.RemoveMaintenanceWindowResponse
+	(*GetMaintenanceWindowListRequest)(nil),     // 55: squzy.v1.monitoring.GetMaintenanceWindowListRequest
+	(*GetMaintenanceWindowListResponse)(nil),    // 56: squzy.v1.monitoring.GetMaintenanceWindowListResponse
+	(*TestSchedulerResponse)(nil),               // 57: squzy.v1.monitoring.TestSchedulerResponse
+	(*LocationResult)(nil),                      // 58: squzy.v1.monitoring.LocationResult
+	(*ProbeTasksRequest)(nil),                   // 59: squzy.v1.monitoring.ProbeTasksRequest
+	(*ProbeTask)(nil),                           // 60: squzy.v1.monitoring.ProbeTask
+	(*ProbeResultRequest)(nil),                  // 61: squzy.v1.monitoring.ProbeResultRequest
+	(*ProbeResultResponse)(nil),                 // 62: squzy.v1.monitoring.ProbeResultResponse
+	(*SchedulerSnapshot_Error)(nil),             // 63: squzy.v1.monitoring.SchedulerSnapshot.Error
+	(*SchedulerSnapshot_MetaData)(nil),          // 64: squzy.v1.monitoring.SchedulerSnapshot.MetaData
+	nil,                                         // 65: squzy.v1.monitoring.Scheduler.LabelsEntry
+	nil,                                         // 66: squzy.v1.monitoring.HttpConfig.HeadersEntry
+	(*HttpAuth_OAuth2)(nil),                     // 67: squzy.v1.monitoring.HttpAuth.OAuth2
+	nil,                                         // 68: squzy.v1.monitoring.HttpScenarioStep.HeadersEntry
+	(*HttpScenarioStep_Extract)(nil),            // 69: squzy.v1.monitoring.HttpScenarioStep.Extract
+	(*HttpAssertions_Header)(nil),               // 70: squzy.v1.monitoring.HttpAssertions.Header
+	nil,                                         // 71: squzy.v1.monitoring.HttpJsonValueConfig.HeadersEntry
+	(*HttpJsonValueConfig_Selectors)(nil),       // 72: squzy.v1.monitoring.HttpJsonValueConfig.Selectors
+	(*HttpJsonValueConfig_Assertion)(nil),       // 73: squzy.v1.monitoring.HttpJsonValueConfig.Assertion
+	nil,                                         // 74: squzy.v1.monitoring.AddRequest.LabelsEntry
+	nil,                                         // 75: squzy.v1.monitoring.BulkActionResponse.ErrorsEntry
+	(*timestamppb.Timestamp)(nil),               // 76: google.protobuf.Timestamp
+	(*structpb.Value)(nil),                      // 77: google.protobuf.Value
+	(*wrapperspb.DoubleValue)(nil),              // 78: google.protobuf.DoubleValue
+	(*wrapperspb.StringValue)(nil),              // 79: google.protobuf.StringValue
+	(*wrapperspb.BoolValue)(nil),                // 80: google.protobuf.BoolValue
+}
+var file_proto_v1_squzy_monitoring_proto_depIdxs = []int32{
+	11,  // 0: squzy.v1.monitoring.SchedulerSnapshotWithId.snapshot:type_name -> squzy.v1.monitoring.SchedulerSnapshot
+	0,   // 1: squzy.v1.monitoring.SchedulerSnapshot.code:type_name -> squzy.v1.monitoring.SchedulerCode
+	4,   // 2: squzy.v1.monitoring.SchedulerSnapshot.type:type_name -> squzy.v1.monitoring.SchedulerType
+	63,  // 3: squzy.v1.monitoring.SchedulerSnapshot.error:type_name -> squzy.v1.monitoring.SchedulerSnapshot.Error
+	64,  // 4: squzy.v1.monitoring.SchedulerSnapshot.meta:type_name -> squzy.v1.monitoring.SchedulerSnapshot.MetaData
+	4,   // 5: squzy.v1.monitoring.Scheduler.type:type_name -> squzy.v1.monitoring.SchedulerType
+	1,   // 6: squzy.v1.monitoring.Scheduler.status:type_name -> squzy.v1.monitoring.SchedulerStatus
+	17,  // 7: squzy.v1.monitoring.Scheduler.tcp:type_name -> squzy.v1.monitoring.TcpConfig
+	16,  // 8: squzy.v1.monitoring.Scheduler.sitemap:type_name -> squzy.v1.monitoring.SiteMapConfig
+	22,  // 9: squzy.v1.monitoring.Scheduler.grpc:type_name -> squzy.v1.monitoring.GrpcConfig
+	23,  // 10: squzy.v1.monitoring.Scheduler.http:type_name -> squzy.v1.monitoring.HttpConfig
+	30,  // 11: squzy.v1.monitoring.Scheduler.http_value:type_name -> squzy.v1.monitoring.HttpJsonValueConfig
+	19,  // 12: squzy.v1.monitoring.Scheduler.ssl_expiration:type_name -> squzy.v1.monitoring.SslExpirationConfig
+	20,  // 13: squzy.v1.monitoring.Scheduler.mongo:type_name -> squzy.v1.monitoring.DbConfig
+	20,  // 14: squzy.v1.monitoring.Scheduler.postgres:type_name -> squzy.v1.monitoring.DbConfig
+	20,  // 15: squzy.v1.monitoring.Scheduler.cassandra:type_name -> squzy.v1.monitoring.DbConfig
+	20,  // 16: squzy.v1.monitoring.Scheduler.mysql:type_name -> squzy.v1.monitoring.DbConfig
+	18,  // 17: squzy.v1.monitoring.Scheduler.dns:type_name -> squzy.v1.monitoring.DnsConfig
+	17,  // 18: squzy.v1.monitoring.Scheduler.udp:type_name -> squzy.v1.monitoring.TcpConfig
+	21,  // 19: squzy.v1.monitoring.Scheduler.redis:type_name -> squzy.v1.monitoring.RedisConfig
+	25,  // 20: squzy.v1.monitoring.Scheduler.http_scenario:type_name -> squzy.v1.monitoring.HttpScenarioConfig
+	65,  // 21: squzy.v1.monitoring.Scheduler.labels:type_name -> squzy.v1.monitoring.Scheduler.LabelsEntry
+	33,  // 22: squzy.v1.monitoring.Scheduler.retry_policy:type_name -> squzy.v1.monitoring.RetryPolicy
+	32,  // 23: squzy.v1.monitoring.Scheduler.flap_detection:type_name -> squzy.v1.monitoring.FlapDetection
+	13,  // 24: squzy.v1.monitoring.GetSchedulerListResponse.lists:type_name -> squzy.v1.monitoring.Scheduler
+	5,   // 25: squzy.v1.monitoring.DnsConfig.record_type:type_name -> squzy.v1.monitoring.DnsConfig.RecordType
+	66,  // 26: squzy.v1.monitoring.HttpConfig.headers:type_name -> squzy.v1.monitoring.HttpConfig.HeadersEntry
+	29,  // 27: squzy.v1.monitoring.HttpConfig.assertions:type_name -> squzy.v1.monitoring.HttpAssertions
+	28,  // 28: squzy.v1.monitoring.HttpConfig.body:type_name -> squzy.v1.monitoring.HttpBody
+	24,  // 29: squzy.v1.monitoring.HttpConfig.auth:type_name -> squzy.v1.monitoring.HttpAuth
+	27,  // 30: squzy.v1.monitoring.HttpConfig.tls:type_name -> squzy.v1.monitoring.HttpTls
+	67,  // 31: squzy.v1.monitoring.HttpAuth.oauth2:type_name -> squzy.v1.monitoring.HttpAuth.OAuth2
+	26,  // 32: squzy.v1.monitoring.HttpScenarioConfig.steps:type_name -> squzy.v1.monitoring.HttpScenarioStep
+	24,  // 33: squzy.v1.monitoring.HttpScenarioConfig.auth:type_name -> squzy.v1.monitoring.HttpAuth
+	27,  // 34: squzy.v1.monitoring.HttpScenarioConfig.tls:type_name -> squzy.v1.monitoring.HttpTls
+	68,  // 35: squzy.v1.monitoring.HttpScenarioStep.headers:type_name -> squzy.v1.monitoring.HttpScenarioStep.HeadersEntry
+	28,  // 36: squzy.v1.monitoring.HttpScenarioStep.body:type_name -> squzy.v1.monitoring.HttpBody
+	29,  // 37: squzy.v1.monitoring.HttpScenarioStep.assertions:type_name -> squzy.v1.monitoring.HttpAssertions
+	69,  // 38: squzy.v1.monitoring.HttpScenarioStep.extract:type_name -> squzy.v1.monitoring.HttpScenarioStep.Extract
+	70,  // 39: squzy.v1.monitoring.HttpAssertions.headers:type_name -> squzy.v1.monitoring.HttpAssertions.Header
+	71,  // 40: squzy.v1.monitoring.HttpJsonValueConfig.headers:type_name -> squzy.v1.monitoring.HttpJsonValueConfig.HeadersEntry
+	72,  // 41: squzy.v1.monitoring.HttpJsonValueConfig.selectors:type_name -> squzy.v1.monitoring.HttpJsonValueConfig.Selectors
+	28,  // 42: squzy.v1.monitoring.HttpJsonValueConfig.body:type_name -> squzy.v1.monitoring.HttpBody
+	24,  // 43: squzy.v1.monitoring.HttpJsonValueConfig.auth:type_name -> squzy.v1.monitoring.HttpAuth
+	27,  // 44: squzy.v1.monitoring.HttpJsonValueConfig.tls:type_name -> squzy.v1.monitoring.HttpTls
+	17,  // 45: squzy.v1.monitoring.AddRequest.tcp:type_name -> squzy.v1.monitoring.TcpConfig
+	16,  // 46: squzy.v1.monitoring.AddRequest.sitemap:type_name -> squzy.v1.monitoring.SiteMapConfig
+	22,  // 47: squzy.v1.monitoring.AddRequest.grpc:type_name -> squzy.v1.monitoring.GrpcConfig
+	23,  // 48: squzy.v1.monitoring.AddRequest.http:type_name -> squzy.v1.monitoring.HttpConfig
+	30,  // 49: squzy.v1.monitoring.AddRequest.http_value:type_name -> squzy.v1.monitoring.HttpJsonValueConfig
+	19,  // 50: squzy.v1.monitoring.AddRequest.ssl_expiration:type_name -> squzy.v1.monitoring.SslExpirationConfig
+	20,  // 51: squzy.v1.monitoring.AddRequest.mongo:type_name -> squzy.v1.monitoring.DbConfig
+	20,  // 52: squzy.v1.monitoring.AddRequest.postgres:type_name -> squzy.v1.monitoring.DbConfig
+	20,  // 53: squzy.v1.monitoring.AddRequest.cassandra:type_name -> squzy.v1.monitoring.DbConfig
+	20,  // 54: squzy.v1.monitoring.AddRequest.mysql:type_name -> squzy.v1.monitoring.DbConfig
+	18,  // 55: squzy.v1.monitoring.AddRequest.dns:type_name -> squzy.v1.monitoring.DnsConfig
+	17,  // 56: squzy.v1.monitoring.AddRequest.udp:type_name -> squzy.v1.monitoring.TcpConfig
+	21,  // 57: squzy.v1.monitoring.AddRequest.redis:type_name -> squzy.v1.monitoring.RedisConfig
+	25,  // 58: squzy.v1.monitoring.AddRequest.http_scenario:type_name -> squzy.v1.monitoring.HttpScenarioConfig
+	74,  // 59: squzy.v1.monitoring.AddRequest.labels:type_name -> squzy.v1.monitoring.AddRequest.LabelsEntry
+	33,  // 60: squzy.v1.monitoring.AddRequest.retry_policy:type_name -> squzy.v1.monitoring.RetryPolicy
+	32,  // 61: squzy.v1.monitoring.AddRequest.flap_detection:type_name -> squzy.v1.monitoring.FlapDetection
+	31,  // 62: squzy.v1.monitoring.UpdateRequest.scheduler:type_name -> squzy.v1.monitoring.AddRequest
+	2,   // 63: squzy.v1.monitoring.ExportSchedulersRequest.format:type_name -> squzy.v1.monitoring.DocumentFormat
+	2,   // 64: squzy.v1.monitoring.ApplySchedulersRequest.format:type_name -> squzy.v1.monitoring.DocumentFormat
+	8,   // 65: squzy.v1.monitoring.SchedulerChange.action:type_name -> squzy.v1.monitoring.SchedulerChange.Action
+	46,  // 66: squzy.v1.monitoring.ApplySchedulersResponse.changes:type_name -> squzy.v1.monitoring.SchedulerChange
+	9,   // 67: squzy.v1.monitoring.BulkActionRequest.action:type_name -> squzy.v1.monitoring.BulkActionRequest.Action
+	75,  // 68: squzy.v1.monitoring.BulkActionResponse.errors:type_name -> squzy.v1.monitoring.BulkActionResponse.ErrorsEntry
+	76,  // 69: squzy.v1.monitoring.MaintenanceWindow.start:type_name -> google.protobuf.Timestamp
+	76,  // 70: squzy.v1.monitoring.MaintenanceWindow.end:type_name -> google.protobuf.Timestamp
+	3,   // 71: squzy.v1.monitoring.MaintenanceWindow.mode:type_name -> squzy.v1.monitoring.MaintenanceMode
+	50,  // 72: squzy.v1.monitoring.AddMaintenanceWindowRequest.window:type_name -> squzy.v1.monitoring.MaintenanceWindow
+	50,  // 73: squzy.v1.monitoring.GetMaintenanceWindowListResponse.windows:type_name -> squzy.v1.monitoring.MaintenanceWindow
+	11,  // 74: squzy.v1.monitoring.TestSchedulerResponse.snapshot:type_name -> squzy.v1.monitoring.SchedulerSnapshot
+	0,   // 75: squzy.v1.monitoring.LocationResult.code:type_name -> squzy.v1.monitoring.SchedulerCode
+	11,  // 76: squzy.v1.monitoring.ProbeResultRequest.snapshot:type_name -> squzy.v1.monitoring.SchedulerSnapshot
+	76,  // 77: squzy.v1.monitoring.SchedulerSnapshot.MetaData.start_time:type_name -> google.protobuf.Timestamp
+	76,  // 78: squzy.v1.monitoring.SchedulerSnapshot.MetaData.end_time:type_name -> google.protobuf.Timestamp
+	77,  // 79: squzy.v1.monitoring.SchedulerSnapshot.MetaData.value:type_name -> google.protobuf.Value
+	58,  // 80: squzy.v1.monitoring.SchedulerSnapshot.MetaData.locations:type_name -> squzy.v1.monitoring.LocationResult
+	6,   // 81: squzy.v1.monitoring.HttpScenarioStep.Extract.source:type_name -> squzy.v1.monitoring.HttpScenarioStep.Extract.Source
+	7,   // 82: squzy.v1.monitoring.HttpJsonValueConfig.Selectors.type:type_name -> squzy.v1.monitoring.HttpJsonValueConfig.JsonValueParseType
+	78,  // 83: squzy.v1.monitoring.HttpJsonValueConfig.Selectors.warning_min:type_name -> google.protobuf.DoubleValue
+	78,  // 84: squzy.v1.monitoring.HttpJsonValueConfig.Selectors.warning_max:type_name -> google.protobuf.DoubleValue
+	73,  // 85: squzy.v1.monitoring.HttpJsonValueConfig.Selectors.assertion:type_name -> squzy.v1.monitoring.HttpJsonValueConfig.Assertion
+	79,  // 86: squzy.v1.monitoring.HttpJsonValueConfig.Assertion.equals:type_name -> google.protobuf.StringValue
+	79,  // 87: squzy.v1.monitoring.HttpJsonValueConfig.Assertion.not_equals:type_name -> google.protobuf.StringValue
+	78,  // 88: squzy.v1.monitoring.HttpJsonValueConfig.Assertion.greater_than:type_name -> google.protobuf.DoubleValue
+	78,  // 89: squzy.v1.monitoring.HttpJsonValueConfig.Assertion.less_than:type_name -> google.protobuf.DoubleValue
+	80,  // 90: squzy.v1.monitoring.HttpJsonValueConfig.Assertion.bool_equals:type_name -> google.protobuf.BoolValue
+	14,  // 91: squzy.v1.monitoring.SchedulersExecutor.GetSchedulerList:input_type -> squzy.v1.monitoring.GetSchedulerListRequest
+	12,  // 92: squzy.v1.monitoring.SchedulersExecutor.GetSchedulerById:input_type -> squzy.v1.monitoring.GetSchedulerByIdRequest
+	31,  // 93: squzy.v1.monitoring.SchedulersExecutor.Add:input_type -> squzy.v1.monitoring.AddRequest
+	35,  // 94: squzy.v1.monitoring.SchedulersExecutor.Remove:input_type -> squzy.v1.monitoring.RemoveRequest
+	37,  // 95: squzy.v1.monitoring.SchedulersExecutor.Run:input_type -> squzy.v1.monitoring.RunRequest
+	38,  // 96: squzy.v1.monitoring.SchedulersExecutor.Stop:input_type -> squzy.v1.monitoring.StopRequest
+	41,  // 97: squzy.v1.monitoring.SchedulersExecutor.Update:input_type -> squzy.v1.monitoring.UpdateRequest
+	48,  // 98: squzy.v1.monitoring.SchedulersExecutor.BulkAction:input_type -> squzy.v1.monitoring.BulkActionRequest
+	43,  // 99: squzy.v1.monitoring.SchedulersExecutor.ExportSchedulers:input_type -> squzy.v1.monitoring.ExportSchedulersRequest
+	45,  // 100: squzy.v1.monitoring.SchedulersExecutor.ApplySchedulers:input_type -> squzy.v1.monitoring.ApplySchedulersRequest
+	51,  // 101: squzy.v1.monitoring.SchedulersExecutor.AddMaintenanceWindow:input_type -> squzy.v1.monitoring.AddMaintenanceWindowRequest
+	53,  // 102: squzy.v1.monitoring.SchedulersExecutor.RemoveMaintenanceWindow:input_type -> squzy.v1.monitoring.RemoveMaintenanceWindowRequest
+	55,  // 103: squzy.v1.monitoring.SchedulersExecutor.GetMaintenanceWindowList:input_type -> squzy.v1.monitoring.GetMaintenanceWindowListRequest
+	31,  // 104: squzy.v1.monitoring.SchedulersExecutor.TestScheduler:input_type -> squzy.v1.monitoring.AddRequest
+	59,  // 105: squzy.v1.monitoring.SchedulersExecutor.ProbeTasks:input_type -> squzy.v1.monitoring.ProbeTasksRequest
+	61,  // 106: squzy.v1.monitoring.SchedulersExecutor.ProbeResult:input_type -> squzy.v1.monitoring.ProbeResultRequest
+	15,  // 107: squzy.v1.monitoring.SchedulersExecutor.GetSchedulerList:output_type -> squzy.v1.monitoring.GetSchedulerListResponse
+	13,  // 108: squzy.v1.monitoring.SchedulersExecutor.GetSchedulerById:output_type -> squzy.v1.monitoring.Scheduler
+	34,  // 109: squzy.v1.monitoring.SchedulersExecutor.Add:output_type -> squzy.v1.monitoring.AddResponse
+	36,  // 110: squzy.v1.monitoring.SchedulersExecutor.Remove:output_type -> squzy.v1.monitoring.RemoveResponse
+	39,  // 111: squzy.v1.monitoring.SchedulersExecutor.Run:output_type -> squzy.v1.monitoring.RunResponse
+	40,  // 112: squzy.v1.monitoring.SchedulersExecutor.Stop:output_type -> squzy.v1.monitoring.StopResponse
+	42,  // 113: squzy.v1.monitoring.SchedulersExecutor.Update:output_type -> squzy.v1.monitoring.UpdateResponse
+	49,  // 114: squzy.v1.monitoring.SchedulersExecutor.BulkAction:output_type -> squzy.v1.monitoring.BulkActionResponse
+	44,  // 115: squzy.v1.monitoring.SchedulersExecutor.ExportSchedulers:output_type -> squzy.v1.monitoring.ExportSchedulersResponse
+	47,  // 116: squzy.v1.monitoring.SchedulersExecutor.ApplySchedulers:output_type -> squzy.v1.monitoring.ApplySchedulersResponse
+	52,  // 117: squzy.v1.monitoring.SchedulersExecutor.AddMaintenanceWindow:output_type -> squzy.v1.monitoring.AddMaintenanceWindowResponse
+	54,  // 118: squzy.v1.monitoring.SchedulersExecutor.RemoveMaintenanceWindow:output_type -> squzy.v1.monitoring.RemoveMaintenanceWindowResponse
+	56,  // 119: squzy.v1.monitoring.SchedulersExecutor.GetMaintenanceWindowList:output_type -> squzy.v1.monitoring.GetMaintenanceWindowListResponse
+	57,  // 120: squzy.v1.monitoring.SchedulersExecutor.TestScheduler:output_type -> squzy.v1.monitoring.TestSchedulerResponse
+	60,  // 121: squzy.v1.monitoring.SchedulersExecutor.ProbeTasks:output_type -> squzy.v1.monitoring.ProbeTask
+	62,  // 122: squzy.v1.monitoring.SchedulersExecutor.ProbeResult:output_type -> squzy.v1.monitoring.ProbeResultResponse
+	107, // [107:123] is the sub-list for method output_type
+	91,  // [91:107] is the sub-list for method input_type
+	91,  // [91:91] is the sub-list for extension type_name
+	91,  // [91:91] is the sub-list for extension extendee
+	0,   // [0:91] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_squzy_monitoring_proto_init() }
+func file_proto_v1_squzy_monitoring_proto_init() {
+	if File_proto_v1_squzy_monitoring_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_v1_squzy_monitoring_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchedulerSnapshotWithId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchedulerSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSchedulerByIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Scheduler); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSchedulerListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSchedulerListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteMapConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TcpConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DnsConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SslExpirationConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DbConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedisConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpAuth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpScenarioConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpScenarioStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpTls); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpAssertions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpJsonValueConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlapDetection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportSchedulersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportSchedulersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplySchedulersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchedulerChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplySchedulersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkActionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkActionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaintenanceWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddMaintenanceWindowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddMaintenanceWindowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveMaintenanceWindowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveMaintenanceWindowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMaintenanceWindowListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMaintenanceWindowListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestSchedulerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProbeTasksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProbeTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProbeResultRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProbeResultResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchedulerSnapshot_Error); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchedulerSnapshot_MetaData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpAuth_OAuth2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpScenarioStep_Extract); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpAssertions_Header); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpJsonValueConfig_Selectors); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpJsonValueConfig_Assertion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_proto_v1_squzy_monitoring_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*Scheduler_Tcp)(nil),
+		(*Scheduler_Sitemap)(nil),
+		(*Scheduler_Grpc)(nil),
+		(*Scheduler_Http)(nil),
+		(*Scheduler_HttpValue)(nil),
+		(*Scheduler_SslExpiration)(nil),
+		(*Scheduler_Mongo)(nil),
+		(*Scheduler_Postgres)(nil),
+		(*Scheduler_Cassandra)(nil),
+		(*Scheduler_Mysql)(nil),
+		(*Scheduler_Dns)(nil),
+		(*Scheduler_Udp)(nil),
+		(*Scheduler_Redis)(nil),
+		(*Scheduler_HttpScenario)(nil),
+	}
+	file_proto_v1_squzy_monitoring_proto_msgTypes[21].OneofWrappers = []interface{}{
+		(*AddRequest_Tcp)(nil),
+		(*AddRequest_Sitemap)(nil),
+		(*AddRequest_Grpc)(nil),
+		(*AddRequest_Http)(nil),
+		(*AddRequest_HttpValue)(nil),
+		(*AddRequest_SslExpiration)(nil),
+		(*AddRequest_Mongo)(nil),
+		(*AddRequest_Postgres)(nil),
+		(*AddRequest_Cassandra)(nil),
+		(*AddRequest_Mysql)(nil),
+		(*AddRequest_Dns)(nil),
+		(*AddRequest_Udp)(nil),
+		(*AddRequest_Redis)(nil),
+		(*AddRequest_HttpScenario)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_v1_squzy_monitoring_proto_rawDesc,
+			NumEnums:      10,
+			NumMessages:   66,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_v1_squzy_monitoring_proto_goTypes,
+		DependencyIndexes: file_proto_v1_squzy_monitoring_proto_depIdxs,
+		EnumInfos:         file_proto_v1_squzy_monitoring_proto_enumTypes,
+		MessageInfos:      file_proto_v1_squzy_monitoring_proto_msgTypes,
+	}.Build()
+	File_proto_v1_squzy_monitoring_proto = out.File
+	file_proto_v1_squzy_monitoring_proto_rawDesc = nil
+	file_proto_v1_squzy_monitoring_proto_goTypes = nil
+	file_proto_v1_squzy_monitoring_proto_depIdxs = nil
+}
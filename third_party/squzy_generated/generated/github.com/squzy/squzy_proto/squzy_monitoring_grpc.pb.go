@@ -0,0 +1,695 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: proto/v1/squzy_monitoring.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// SchedulersExecutorClient is the client API for SchedulersExecutor service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SchedulersExecutorClient interface {
+	// protolint:disable:next MAX_LINE_LENGTH
+	GetSchedulerList(ctx context.Context, in *GetSchedulerListRequest, opts ...grpc.CallOption) (*GetSchedulerListResponse, error)
+	GetSchedulerById(ctx context.Context, in *GetSchedulerByIdRequest, opts ...grpc.CallOption) (*Scheduler, error)
+	Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*AddResponse, error)
+	Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error)
+	Run(ctx context.Context, in *RunRequest, opts ...grpc.CallOption) (*RunResponse, error)
+	Stop(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error)
+	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
+	// Run, stop or remove all schedulers matched by selector
+	BulkAction(ctx context.Context, in *BulkActionRequest, opts ...grpc.CallOption) (*BulkActionResponse, error)
+	// protolint:disable:next MAX_LINE_LENGTH
+	ExportSchedulers(ctx context.Context, in *ExportSchedulersRequest, opts ...grpc.CallOption) (*ExportSchedulersResponse, error)
+	// protolint:disable:next MAX_LINE_LENGTH
+	ApplySchedulers(ctx context.Context, in *ApplySchedulersRequest, opts ...grpc.CallOption) (*ApplySchedulersResponse, error)
+	// protolint:disable:next MAX_LINE_LENGTH
+	AddMaintenanceWindow(ctx context.Context, in *AddMaintenanceWindowRequest, opts ...grpc.CallOption) (*AddMaintenanceWindowResponse, error)
+	// protolint:disable:next MAX_LINE_LENGTH
+	RemoveMaintenanceWindow(ctx context.Context, in *RemoveMaintenanceWindowRequest, opts ...grpc.CallOption) (*RemoveMaintenanceWindowResponse, error)
+	// protolint:disable:next MAX_LINE_LENGTH
+	GetMaintenanceWindowList(ctx context.Context, in *GetMaintenanceWindowListRequest, opts ...grpc.CallOption) (*GetMaintenanceWindowListResponse, error)
+	// Run check once without saving scheduler and result.
+	// Check runs in squzy monitoring itself, locations are ignored, so result is not per location
+	TestScheduler(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*TestSchedulerResponse, error)
+	// Probe receives checks of its location until stream is closed
+	ProbeTasks(ctx context.Context, in *ProbeTasksRequest, opts ...grpc.CallOption) (SchedulersExecutor_ProbeTasksClient, error)
+	// Result of probe task tagged with location
+	ProbeResult(ctx context.Context, in *ProbeResultRequest, opts ...grpc.CallOption) (*ProbeResultResponse, error)
+}
+
+type schedulersExecutorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSchedulersExecutorClient(cc grpc.ClientConnInterface) SchedulersExecutorClient {
+	return &schedulersExecutorClient{cc}
+}
+
+func (c *schedulersExecutorClient) GetSchedulerList(ctx context.Context, in *GetSchedulerListRequest, opts ...grpc.CallOption) (*GetSchedulerListResponse, error) {
+	out := new(GetSchedulerListResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/GetSchedulerList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulersExecutorClient) GetSchedulerById(ctx context.Context, in *GetSchedulerByIdRequest, opts ...grpc.CallOption) (*Scheduler, error) {
+	out := new(Scheduler)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/GetSchedulerById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulersExecutorClient) Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*AddResponse, error) {
+	out := new(AddResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/Add", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulersExecutorClient) Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error) {
+	out := new(RemoveResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/Remove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulersExecutorClient) Run(ctx context.Context, in *RunRequest, opts ...grpc.CallOption) (*RunResponse, error) {
+	out := new(RunResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/Run", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulersExecutorClient) Stop(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error) {
+	out := new(StopResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/Stop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulersExecutorClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	out := new(UpdateResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulersExecutorClient) BulkAction(ctx context.Context, in *BulkActionRequest, opts ...grpc.CallOption) (*BulkActionResponse, error) {
+	out := new(BulkActionResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/BulkAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulersExecutorClient) ExportSchedulers(ctx context.Context, in *ExportSchedulersRequest, opts ...grpc.CallOption) (*ExportSchedulersResponse, error) {
+	out := new(ExportSchedulersResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/ExportSchedulers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulersExecutorClient) ApplySchedulers(ctx context.Context, in *ApplySchedulersRequest, opts ...grpc.CallOption) (*ApplySchedulersResponse, error) {
+	out := new(ApplySchedulersResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/ApplySchedulers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulersExecutorClient) AddMaintenanceWindow(ctx context.Context, in *AddMaintenanceWindowRequest, opts ...grpc.CallOption) (*AddMaintenanceWindowResponse, error) {
+	out := new(AddMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/AddMaintenanceWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulersExecutorClient) RemoveMaintenanceWindow(ctx context.Context, in *RemoveMaintenanceWindowRequest, opts ...grpc.CallOption) (*RemoveMaintenanceWindowResponse, error) {
+	out := new(RemoveMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/RemoveMaintenanceWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulersExecutorClient) GetMaintenanceWindowList(ctx context.Context, in *GetMaintenanceWindowListRequest, opts ...grpc.CallOption) (*GetMaintenanceWindowListResponse, error) {
+	out := new(GetMaintenanceWindowListResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/GetMaintenanceWindowList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulersExecutorClient) TestScheduler(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*TestSchedulerResponse, error) {
+	out := new(TestSchedulerResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/TestScheduler", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulersExecutorClient) ProbeTasks(ctx context.Context, in *ProbeTasksRequest, opts ...grpc.CallOption) (SchedulersExecutor_ProbeTasksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SchedulersExecutor_ServiceDesc.Streams[0], "/squzy.v1.monitoring.SchedulersExecutor/ProbeTasks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &schedulersExecutorProbeTasksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SchedulersExecutor_ProbeTasksClient interface {
+	Recv() (*ProbeTask, error)
+	grpc.ClientStream
+}
+
+type schedulersExecutorProbeTasksClient struct {
+	grpc.ClientStream
+}
+
+func (x *schedulersExecutorProbeTasksClient) Recv() (*ProbeTask, error) {
+	m := new(ProbeTask)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *schedulersExecutorClient) ProbeResult(ctx context.Context, in *ProbeResultRequest, opts ...grpc.CallOption) (*ProbeResultResponse, error) {
+	out := new(ProbeResultResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/ProbeResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SchedulersExecutorServer is the server API for SchedulersExecutor service.
+// All implementations must embed UnimplementedSchedulersExecutorServer
+// for forward compatibility
+type SchedulersExecutorServer interface {
+	// protolint:disable:next MAX_LINE_LENGTH
+	GetSchedulerList(context.Context, *GetSchedulerListRequest) (*GetSchedulerListResponse, error)
+	GetSchedulerById(context.Context, *GetSchedulerByIdRequest) (*Scheduler, error)
+	Add(context.Context, *AddRequest) (*AddResponse, error)
+	Remove(context.Context, *RemoveRequest) (*RemoveResponse, error)
+	Run(context.Context, *RunRequest) (*RunResponse, error)
+	Stop(context.Context, *StopRequest) (*StopResponse, error)
+	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
+	// Run, stop or remove all schedulers matched by selector
+	BulkAction(context.Context, *BulkActionRequest) (*BulkActionResponse, error)
+	// protolint:disable:next MAX_LINE_LENGTH
+	ExportSchedulers(context.Context, *ExportSchedulersRequest) (*ExportSchedulersResponse, error)
+	// protolint:disable:next MAX_LINE_LENGTH
+	ApplySchedulers(context.Context, *ApplySchedulersRequest) (*ApplySchedulersResponse, error)
+	// protolint:disable:next MAX_LINE_LENGTH
+	AddMaintenanceWindow(context.Context, *AddMaintenanceWindowRequest) (*AddMaintenanceWindowResponse, error)
+	// protolint:disable:next MAX_LINE_LENGTH
+	RemoveMaintenanceWindow(context.Context, *RemoveMaintenanceWindowRequest) (*RemoveMaintenanceWindowResponse, error)
+	// protolint:disable:next MAX_LINE_LENGTH
+	GetMaintenanceWindowList(context.Context, *GetMaintenanceWindowListRequest) (*GetMaintenanceWindowListResponse, error)
+	// Run check once without saving scheduler and result.
+	// Check runs in squzy monitoring itself, locations are ignored, so result is not per location
+	TestScheduler(context.Context, *AddRequest) (*TestSchedulerResponse, error)
+	// Probe receives checks of its location until stream is closed
+	ProbeTasks(*ProbeTasksRequest, SchedulersExecutor_ProbeTasksServer) error
+	// Result of probe task tagged with location
+	ProbeResult(context.Context, *ProbeResultRequest) (*ProbeResultResponse, error)
+	mustEmbedUnimplementedSchedulersExecutorServer()
+}
+
+// UnimplementedSchedulersExecutorServer must be embedded to have forward compatible implementations.
+type UnimplementedSchedulersExecutorServer struct {
+}
+
+func (UnimplementedSchedulersExecutorServer) GetSchedulerList(context.Context, *GetSchedulerListRequest) (*GetSchedulerListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedulerList not implemented")
+}
+func (UnimplementedSchedulersExecutorServer) GetSchedulerById(context.Context, *GetSchedulerByIdRequest) (*Scheduler, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedulerById not implemented")
+}
+func (UnimplementedSchedulersExecutorServer) Add(context.Context, *AddRequest) (*AddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (UnimplementedSchedulersExecutorServer) Remove(context.Context, *RemoveRequest) (*RemoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Remove not implemented")
+}
+func (UnimplementedSchedulersExecutorServer) Run(context.Context, *RunRequest) (*RunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Run not implemented")
+}
+func (UnimplementedSchedulersExecutorServer) Stop(context.Context, *StopRequest) (*StopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
+}
+func (UnimplementedSchedulersExecutorServer) Update(context.Context, *UpdateRequest) (*UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedSchedulersExecutorServer) BulkAction(context.Context, *BulkActionRequest) (*BulkActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkAction not implemented")
+}
+func (UnimplementedSchedulersExecutorServer) ExportSchedulers(context.Context, *ExportSchedulersRequest) (*ExportSchedulersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSchedulers not implemented")
+}
+func (UnimplementedSchedulersExecutorServer) ApplySchedulers(context.Context, *ApplySchedulersRequest) (*ApplySchedulersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplySchedulers not implemented")
+}
+func (UnimplementedSchedulersExecutorServer) AddMaintenanceWindow(context.Context, *AddMaintenanceWindowRequest) (*AddMaintenanceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMaintenanceWindow not implemented")
+}
+func (UnimplementedSchedulersExecutorServer) RemoveMaintenanceWindow(context.Context, *RemoveMaintenanceWindowRequest) (*RemoveMaintenanceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMaintenanceWindow not implemented")
+}
+func (UnimplementedSchedulersExecutorServer) GetMaintenanceWindowList(context.Context, *GetMaintenanceWindowListRequest) (*GetMaintenanceWindowListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaintenanceWindowList not implemented")
+}
+func (UnimplementedSchedulersExecutorServer) TestScheduler(context.Context, *AddRequest) (*TestSchedulerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestScheduler not implemented")
+}
+func (UnimplementedSchedulersExecutorServer) ProbeTasks(*ProbeTasksRequest, SchedulersExecutor_ProbeTasksServer) error {
+	return status.Errorf(codes.Unimplemented, "method ProbeTasks not implemented")
+}
+func (UnimplementedSchedulersExecutorServer) ProbeResult(context.Context, *ProbeResultRequest) (*ProbeResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProbeResult not implemented")
+}
+func (UnimplementedSchedulersExecutorServer) mustEmbedUnimplementedSchedulersExecutorServer() {}
+
+// UnsafeSchedulersExecutorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SchedulersExecutorServer will
+// result in compilation errors.
+type UnsafeSchedulersExecutorServer interface {
+	mustEmbedUnimplementedSchedulersExecutorServer()
+}
+
+func RegisterSchedulersExecutorServer(s grpc.ServiceRegistrar, srv SchedulersExecutorServer) {
+	s.RegisterService(&SchedulersExecutor_ServiceDesc, srv)
+}
+
+func _SchedulersExecutor_GetSchedulerList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchedulerListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).GetSchedulerList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/GetSchedulerList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).GetSchedulerList(ctx, req.(*GetSchedulerListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulersExecutor_GetSchedulerById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchedulerByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).GetSchedulerById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/GetSchedulerById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).GetSchedulerById(ctx, req.(*GetSchedulerByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulersExecutor_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/Add",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).Add(ctx, req.(*AddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulersExecutor_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).Remove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/Remove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).Remove(ctx, req.(*RemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulersExecutor_Run_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).Run(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/Run",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).Run(ctx, req.(*RunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulersExecutor_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).Stop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/Stop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).Stop(ctx, req.(*StopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulersExecutor_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).Update(ctx, req.(*UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulersExecutor_BulkAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).BulkAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/BulkAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).BulkAction(ctx, req.(*BulkActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulersExecutor_ExportSchedulers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportSchedulersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).ExportSchedulers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/ExportSchedulers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).ExportSchedulers(ctx, req.(*ExportSchedulersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulersExecutor_ApplySchedulers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplySchedulersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).ApplySchedulers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/ApplySchedulers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).ApplySchedulers(ctx, req.(*ApplySchedulersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulersExecutor_AddMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).AddMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/AddMaintenanceWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).AddMaintenanceWindow(ctx, req.(*AddMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulersExecutor_RemoveMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).RemoveMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/RemoveMaintenanceWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).RemoveMaintenanceWindow(ctx, req.(*RemoveMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulersExecutor_GetMaintenanceWindowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaintenanceWindowListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).GetMaintenanceWindowList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/GetMaintenanceWindowList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).GetMaintenanceWindowList(ctx, req.(*GetMaintenanceWindowListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulersExecutor_TestScheduler_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).TestScheduler(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/TestScheduler",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).TestScheduler(ctx, req.(*AddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulersExecutor_ProbeTasks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProbeTasksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SchedulersExecutorServer).ProbeTasks(m, &schedulersExecutorProbeTasksServer{stream})
+}
+
+type SchedulersExecutor_ProbeTasksServer interface {
+	Send(*ProbeTask) error
+	grpc.ServerStream
+}
+
+type schedulersExecutorProbeTasksServer struct {
+	grpc.ServerStream
+}
+
+func (x *schedulersExecutorProbeTasksServer) Send(m *ProbeTask) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SchedulersExecutor_ProbeResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProbeResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).ProbeResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/ProbeResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).ProbeResult(ctx, req.(*ProbeResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SchedulersExecutor_ServiceDesc is the grpc.ServiceDesc for SchedulersExecutor service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SchedulersExecutor_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "squzy.v1.monitoring.SchedulersExecutor",
+	HandlerType: (*SchedulersExecutorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSchedulerList",
+			Handler:    _SchedulersExecutor_GetSchedulerList_Handler,
+		},
+		{
+			MethodName: "GetSchedulerById",
+			Handler:    _SchedulersExecutor_GetSchedulerById_Handler,
+		},
+		{
+			MethodName: "Add",
+			Handler:    _SchedulersExecutor_Add_Handler,
+		},
+		{
+			MethodName: "Remove",
+			Handler:    _SchedulersExecutor_Remove_Handler,
+		},
+		{
+			MethodName: "Run",
+			Handler:    _SchedulersExecutor_Run_Handler,
+		},
+		{
+			MethodName: "Stop",
+			Handler:    _SchedulersExecutor_Stop_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _SchedulersExecutor_Update_Handler,
+		},
+		{
+			MethodName: "BulkAction",
+			Handler:    _SchedulersExecutor_BulkAction_Handler,
+		},
+		{
+			MethodName: "ExportSchedulers",
+			Handler:    _SchedulersExecutor_ExportSchedulers_Handler,
+		},
+		{
+			MethodName: "ApplySchedulers",
+			Handler:    _SchedulersExecutor_ApplySchedulers_Handler,
+		},
+		{
+			MethodName: "AddMaintenanceWindow",
+			Handler:    _SchedulersExecutor_AddMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "RemoveMaintenanceWindow",
+			Handler:    _SchedulersExecutor_RemoveMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "GetMaintenanceWindowList",
+			Handler:    _SchedulersExecutor_GetMaintenanceWindowList_Handler,
+		},
+		{
+			MethodName: "TestScheduler",
+			Handler:    _SchedulersExecutor_TestScheduler_Handler,
+		},
+		{
+			MethodName: "ProbeResult",
+			Handler:    _SchedulersExecutor_ProbeResult_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ProbeTasks",
+			Handler:       _SchedulersExecutor_ProbeTasks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/v1/squzy_monitoring.proto",
+}